@@ -1,57 +1,114 @@
 package api
 
 import (
-	"log"
+	"context"
+	"net/http"
 
 	athletehandlers "olympy/api-gateway/api/handlers/athlete-handlers" // Import path for AthleteHandlers
 	authhandler "olympy/api-gateway/api/handlers/auth-handlers"        // Updated import path
+	calendarhandlers "olympy/api-gateway/api/handlers/calendar-handlers"
+	compositehandlers "olympy/api-gateway/api/handlers/composite-handlers"
 	countryhandlers "olympy/api-gateway/api/handlers/country-handlers" // Import path for CountryHandlers
 	eventhandlers "olympy/api-gateway/api/handlers/event-handlers"     // Updated import path
-	medalhandlers "olympy/api-gateway/api/handlers/medal-handlers"     // Import path for MedalHandlers
+	exporthandlers "olympy/api-gateway/api/handlers/export-handlers"
+	gameshandlers "olympy/api-gateway/api/handlers/games-handlers"
+	graphqlhandlers "olympy/api-gateway/api/handlers/graphql-handlers"
+	healthhandlers "olympy/api-gateway/api/handlers/health-handlers"
+	importhandlers "olympy/api-gateway/api/handlers/import-handlers"
+	medalhandlers "olympy/api-gateway/api/handlers/medal-handlers" // Import path for MedalHandlers
 	streamhandlers "olympy/api-gateway/api/handlers/stream-handlers"
+	translationhandlers "olympy/api-gateway/api/handlers/translation-handlers"
+	userhandlers "olympy/api-gateway/api/handlers/user-handlers"
+	venuehandlers "olympy/api-gateway/api/handlers/venue-handlers"
+	webhookhandlers "olympy/api-gateway/api/handlers/webhook-handlers"
 	"olympy/api-gateway/api/middleware/casbin"
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/idempotency"
+	"olympy/api-gateway/api/middleware/language"
+	"olympy/api-gateway/api/middleware/logging"
+	"olympy/api-gateway/api/middleware/stale"
+	"olympy/api-gateway/api/middleware/timezone"
 	"olympy/api-gateway/config"
 	_ "olympy/api-gateway/docs"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.uber.org/zap"
 )
 
 type API struct {
-	logger         *log.Logger
-	cfg            *config.Config
-	authhandler    *authhandler.AuthHandlers
-	eventhandler   *eventhandlers.EventHandlers
-	countryhandler *countryhandlers.CountryHandlers
-	medalhandler   *medalhandlers.MedalHandlers
-	athletehandler *athletehandlers.AthleteHandlers
-	streamhandlers *streamhandlers.StreamHandlers
+	logger             *zap.Logger
+	cfg                *config.Config
+	authhandler        *authhandler.AuthHandlers
+	eventhandler       *eventhandlers.EventHandlers
+	countryhandler     *countryhandlers.CountryHandlers
+	medalhandler       *medalhandlers.MedalHandlers
+	athletehandler     *athletehandlers.AthleteHandlers
+	streamhandlers     *streamhandlers.StreamHandlers
+	healthhandler      *healthhandlers.HealthHandlers
+	graphqlhandler     *graphqlhandlers.GraphQLHandlers
+	compositehandler   *compositehandlers.CompositeHandlers
+	importhandler      *importhandlers.ImportHandlers
+	exporthandler      *exporthandlers.ExportHandlers
+	webhookhandler     *webhookhandlers.WebhookHandlers
+	gameshandler       *gameshandlers.GamesHandlers
+	translationhandler *translationhandlers.TranslationHandlers
+	userhandler        *userhandlers.UserHandlers
+	venuehandler       *venuehandlers.VenueHandlers
+	calendarhandler    *calendarhandlers.CalendarHandlers
+	redis              *redis.Client
+	server             *http.Server
 }
 
 func New(
 	cfg *config.Config,
-	logger *log.Logger,
+	logger *zap.Logger,
 	authhandler *authhandler.AuthHandlers,
 	eventhandler *eventhandlers.EventHandlers,
 	countryhandler *countryhandlers.CountryHandlers,
 	medalhandler *medalhandlers.MedalHandlers,
 	athletehandler *athletehandlers.AthleteHandlers,
 	streamhandler *streamhandlers.StreamHandlers,
+	healthhandler *healthhandlers.HealthHandlers,
+	graphqlhandler *graphqlhandlers.GraphQLHandlers,
+	compositehandler *compositehandlers.CompositeHandlers,
+	importhandler *importhandlers.ImportHandlers,
+	exporthandler *exporthandlers.ExportHandlers,
+	webhookhandler *webhookhandlers.WebhookHandlers,
+	gameshandler *gameshandlers.GamesHandlers,
+	translationhandler *translationhandlers.TranslationHandlers,
+	userhandler *userhandlers.UserHandlers,
+	venuehandler *venuehandlers.VenueHandlers,
+	calendarhandler *calendarhandlers.CalendarHandlers,
+	redisClient *redis.Client,
 ) *API {
 	return &API{
-		logger:         logger,
-		cfg:            cfg,
-		authhandler:    authhandler,
-		eventhandler:   eventhandler,
-		countryhandler: countryhandler,
-		medalhandler:   medalhandler,
-		athletehandler: athletehandler,
-		streamhandlers: streamhandler,
+		logger:             logger,
+		cfg:                cfg,
+		authhandler:        authhandler,
+		eventhandler:       eventhandler,
+		countryhandler:     countryhandler,
+		medalhandler:       medalhandler,
+		athletehandler:     athletehandler,
+		streamhandlers:     streamhandler,
+		healthhandler:      healthhandler,
+		graphqlhandler:     graphqlhandler,
+		compositehandler:   compositehandler,
+		importhandler:      importhandler,
+		exporthandler:      exporthandler,
+		webhookhandler:     webhookhandler,
+		gameshandler:       gameshandler,
+		translationhandler: translationhandler,
+		userhandler:        userhandler,
+		venuehandler:       venuehandler,
+		calendarhandler:    calendarhandler,
+		redis:              redisClient,
+		server:             &http.Server{Addr: cfg.ServerAddress},
 	}
 }
 
-
 // NewRoute
 // @title API
 // @description TEST
@@ -61,10 +118,23 @@ func New(
 // @in header
 // @name Authorization
 func (a *API) RUN() error {
-	router := gin.Default()
+	router := gin.New()
+	// Lets handlers pass *gin.Context to the gRPC clients while the request
+	// context (request ID, cancellation) is still honoured.
+	router.ContextWithFallback = true
+	router.Use(gin.Recovery(), logging.NewRequestLogger(a.logger))
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	router.GET("/healthz", a.healthhandler.Healthz) // Liveness probe
+	router.GET("/readyz", a.healthhandler.Readyz)   // Readiness probe with per-dependency detail
 	router.Use(casbin.NewAuthorizer())
+	router.Use(stale.NewStaleMarker())
+	router.Use(games.NewSelector())
+	router.Use(language.New())
+	router.Use(timezone.New())
+	router.Use(idempotency.New(a.redis, a.cfg.IdempotencyTTL, a.logger))
+
+	router.POST("/graphql", a.graphqlhandler.Query) // GraphQL queries, authorized per field
 
 	api := router.Group("/api/v1")
 	{
@@ -72,34 +142,95 @@ func (a *API) RUN() error {
 		api.POST("/auth/login", a.authhandler.Login)          // Login user
 		api.POST("/auth/refresh", a.authhandler.RefreshToken) // Refresh access token
 
-		api.POST("/events/add", a.eventhandler.AddEvent)             // Add event
-		api.PUT("/events/edit", a.eventhandler.EditEvent)            // Edit event
-		api.DELETE("/events/delete", a.eventhandler.DeleteEvent) // Delete event by ID
-		api.GET("/events/get", a.eventhandler.GetEvent)          // Get event by ID
-		api.GET("/events/getall", a.eventhandler.GetAllEvents)       // Get all events
-		api.GET("/events/search", a.eventhandler.SearchEvents)       // Search events
-
-		api.POST("/countries/add", a.countryhandler.AddCountry)             // Add country
-		api.PUT("/countries/edit", a.countryhandler.EditCountry)            // Edit country
-		api.DELETE("/countries/delete", a.countryhandler.DeleteCountry) // Delete country by ID
-		api.GET("/countries/get", a.countryhandler.GetCountry)          // Get country by ID
-		api.GET("/countries/getall", a.countryhandler.ListCountries)        // List countries
-
-		api.POST("/medals/add", a.medalhandler.AddMedal)             // Add medal
-		api.PUT("/medals/edit", a.medalhandler.EditMedal)            // Edit medal
-		api.DELETE("/medals/delete", a.medalhandler.DeleteMedal) // Delete medal by ID
-		api.GET("/medals/get", a.medalhandler.GetMedal)          // Get medal by ID
-		api.GET("/medals/getall", a.medalhandler.ListMedals)         // List medals
-		api.GET("/medals/ranking", a.medalhandler.GetMedalRanking)   // Get country rankings sorted by the number of medals
-
-		api.POST("/athletes/add", a.athletehandler.AddAthlete)             // Add athlete
-		api.PUT("/athletes/edit", a.athletehandler.EditAthlete)            // Edit athlete
-		api.DELETE("/athletes/delete", a.athletehandler.DeleteAthlete) // Delete athlete by ID
-		api.GET("/athletes/get", a.athletehandler.GetAthlete)          // Get athlete by ID
-		api.GET("/athletes/getall", a.athletehandler.ListAthletes)         // List athletes
-		api.POST("/stream/send", a.streamhandlers.SendEvent)               // Send
+		api.GET("/users/getall", a.userhandler.ListUsers)     // List users with their roles
+		api.GET("/users/get", a.userhandler.GetUser)          // Get user by ID
+		api.PUT("/users/role", a.userhandler.SetUserRole)     // Change a user's role
+		api.DELETE("/users/delete", a.userhandler.DeleteUser) // Delete user by ID
+
+		api.POST("/games/add", a.gameshandler.AddGames)         // Add Olympic Games edition
+		api.PUT("/games/edit", a.gameshandler.EditGames)        // Edit edition
+		api.DELETE("/games/delete", a.gameshandler.DeleteGames) // Delete edition by ID
+		api.GET("/games/get", a.gameshandler.GetGames)          // Get edition by ID
+		api.GET("/games/getall", a.gameshandler.ListGames)      // List editions, newest first
+
+		api.POST("/venues/add", a.venuehandler.AddVenue)                // Add venue
+		api.PUT("/venues/edit", a.venuehandler.EditVenue)               // Edit venue
+		api.DELETE("/venues/delete", a.venuehandler.DeleteVenue)        // Delete venue by ID
+		api.GET("/venues/get", a.venuehandler.GetVenue)                 // Get venue by ID
+		api.GET("/venues/getall", a.venuehandler.ListVenues)            // List venues by city and name
+		api.GET("/venues/:id/events", a.eventhandler.ListEventsByVenue) // Events held at a venue by start time
+
+		api.PUT("/translations/set", a.translationhandler.SetTranslation)          // Set the name of a country, event or sport in a locale
+		api.DELETE("/translations/delete", a.translationhandler.DeleteTranslation) // Delete a translation
+		api.GET("/translations/getall", a.translationhandler.ListTranslations)     // List translations
+
+		api.POST("/events/add", a.eventhandler.AddEvent)                            // Add event
+		api.PUT("/events/edit", a.eventhandler.EditEvent)                           // Edit event
+		api.DELETE("/events/delete", a.eventhandler.DeleteEvent)                    // Delete event by ID
+		api.GET("/events/get", a.eventhandler.GetEvent)                             // Get event by ID
+		api.GET("/events/getall", a.eventhandler.GetAllEvents)                      // Get all events
+		api.GET("/events/search", a.eventhandler.SearchEvents)                      // Search events
+		api.GET("/events/schedule/validate", a.eventhandler.ValidateSchedule)       // Conflicts among the events of a day or edition
+		api.GET("/events/:id/detail", a.compositehandler.EventDetail)               // Event with medals, medalists and their countries
+		api.POST("/events/status", a.eventhandler.TransitionEventStatus)            // Move an event to another status
+		api.GET("/events/:id/status/history", a.eventhandler.ListStatusTransitions) // Status transitions of an event
+		api.POST("/events/import", a.importhandler.ImportEvents)                    // Bulk import events from CSV or NDJSON
+		api.GET("/events/export", a.exporthandler.ExportEvents)                     // Export events as CSV, NDJSON or Excel
+		api.GET("/events/calendar.ics", a.calendarhandler.Calendar)                 // Schedule as an iCalendar feed
+
+		api.POST("/events/calendar/subscriptions/add", a.calendarhandler.AddCalendarSubscription)         // Create a private calendar feed
+		api.GET("/events/calendar/subscriptions/getall", a.calendarhandler.ListCalendarSubscriptions)     // The caller's calendar feeds
+		api.DELETE("/events/calendar/subscriptions/delete", a.calendarhandler.DeleteCalendarSubscription) // Revoke a calendar feed
+
+		api.POST("/countries/add", a.countryhandler.AddCountry)              // Add country
+		api.PUT("/countries/edit", a.countryhandler.EditCountry)             // Edit country
+		api.DELETE("/countries/delete", a.countryhandler.DeleteCountry)      // Delete country by ID
+		api.GET("/countries/get", a.countryhandler.GetCountry)               // Get country by ID
+		api.GET("/countries/getall", a.countryhandler.ListCountries)         // List countries
+		api.GET("/countries/:id/profile", a.compositehandler.CountryProfile) // Country with athletes, medals and ranking
+		api.POST("/countries/import", a.importhandler.ImportCountries)       // Bulk import countries from CSV or NDJSON
+
+		api.POST("/medals/add", a.medalhandler.AddMedal)                      // Add medal
+		api.PUT("/medals/edit", a.medalhandler.EditMedal)                     // Edit medal
+		api.DELETE("/medals/delete", a.medalhandler.DeleteMedal)              // Delete medal by ID
+		api.GET("/medals/get", a.medalhandler.GetMedal)                       // Get medal by ID
+		api.GET("/medals/getall", a.medalhandler.ListMedals)                  // List medals
+		api.GET("/medals/ranking", a.medalhandler.GetMedalRanking)            // Get country rankings sorted by the number of medals
+		api.POST("/medals/import", a.importhandler.ImportMedals)              // Bulk import medals from CSV or NDJSON
+		api.GET("/medals/export", a.exporthandler.ExportMedals)               // Export medals as CSV, NDJSON or Excel
+		api.GET("/medals/ranking/export", a.exporthandler.ExportMedalRanking) // Export the medal ranking as CSV, NDJSON or Excel
+
+		api.POST("/athletes/add", a.athletehandler.AddAthlete)              // Add athlete
+		api.PUT("/athletes/edit", a.athletehandler.EditAthlete)             // Edit athlete
+		api.DELETE("/athletes/delete", a.athletehandler.DeleteAthlete)      // Delete athlete by ID
+		api.GET("/athletes/get", a.athletehandler.GetAthlete)               // Get athlete by ID
+		api.GET("/athletes/getall", a.athletehandler.ListAthletes)          // List athletes
+		api.GET("/athletes/:id/profile", a.compositehandler.AthleteProfile) // Athlete with country and medals
+		api.POST("/athletes/import", a.importhandler.ImportAthletes)        // Bulk import athletes from CSV or NDJSON
+		api.GET("/athletes/export", a.exporthandler.ExportAthletes)         // Export athletes as CSV, NDJSON or Excel
+		api.POST("/stream/send", a.streamhandlers.SendEvent)                // Send
+		api.GET("/stream/events/:event_id/sse", a.streamhandlers.EventSSE)  // Live commentary
+		api.GET("/stream/ws", a.streamhandlers.StreamWS)                    // Live commentary over WebSocket
+
+		api.POST("/webhooks/add", a.webhookhandler.AddWebhook)                 // Register a partner webhook
+		api.PUT("/webhooks/edit", a.webhookhandler.EditWebhook)                // Edit webhook
+		api.DELETE("/webhooks/delete", a.webhookhandler.DeleteWebhook)         // Delete webhook by ID
+		api.GET("/webhooks/get", a.webhookhandler.GetWebhook)                  // Get webhook by ID
+		api.GET("/webhooks/getall", a.webhookhandler.ListWebhooks)             // List webhooks
+		api.GET("/webhooks/deliveries", a.webhookhandler.ListDeliveries)       // Delivery log
+		api.GET("/webhooks/deliveries/get", a.webhookhandler.GetDelivery)      // Delivery with its attempts
+		api.POST("/webhooks/deliveries/redeliver", a.webhookhandler.Redeliver) // Queue a delivery again
 
 	}
 
-	return router.Run(a.cfg.ServerAddress)
+	a.server.Handler = games.Rewrite(router)
+	// SSE and WebSocket streams never finish on their own, so they are
+	// ended as soon as shutdown starts rather than waited for.
+	a.server.RegisterOnShutdown(func() { a.streamhandlers.Close() })
+	return a.server.ListenAndServe()
+}
+
+// Shutdown stops accepting new requests and waits for in-flight ones to finish.
+func (a *API) Shutdown(ctx context.Context) error {
+	return a.server.Shutdown(ctx)
 }
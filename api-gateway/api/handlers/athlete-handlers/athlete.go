@@ -2,10 +2,14 @@ package athletehandlers
 
 import (
 	"log"
+	"olympy/api-gateway/api/middleware/games"
 	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	"olympy/api-gateway/internal/pkg/fields"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AthleteHandlers struct {
@@ -20,6 +24,15 @@ func NewAthleteHandlers(client athleteservice.AthleteServiceClient, logger *log.
 	}
 }
 
+// respondError answers unknown fields and invalid page tokens with 400.
+func respondError(ctx *gin.Context, err error) {
+	if status.Code(err) == codes.InvalidArgument {
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+}
+
 // AddAthlete godoc
 // @Summary Add an athlete
 // @Description This endpoint adds a new athlete.
@@ -28,8 +41,10 @@ func NewAthleteHandlers(client athleteservice.AthleteServiceClient, logger *log.
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body athleteservice.Athlete true "Athlete details to add"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Success 200 {object} athleteservice.Athlete
 // @Failure 400 {object} athleteservice.Message
+// @Failure 422 {string} string "Idempotency-Key reused with a different payload"
 // @Failure 500 {object} athleteservice.Message
 // @Router /athletes/add [post]
 func (a *AthleteHandlers) AddAthlete(ctx *gin.Context) {
@@ -117,6 +132,8 @@ func (a *AthleteHandlers) DeleteAthlete(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "Athlete ID to get"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
 // @Success 200 {object} athleteservice.Athlete
 // @Failure 400 {object} athleteservice.Message
 // @Failure 500 {object} athleteservice.Message
@@ -130,15 +147,15 @@ func (a *AthleteHandlers) GetAthlete(ctx *gin.Context) {
 		return
 	}
 
-	req := &athleteservice.GetSingleRequest{Id: id}
+	req := &athleteservice.GetSingleRequest{Id: id, Fields: fields.Mask(ctx)}
 
 	resp, err := a.client.GetAthlete(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	fields.Respond(ctx, resp)
 }
 
 // ListAthletes godoc
@@ -151,6 +168,10 @@ func (a *AthleteHandlers) GetAthlete(ctx *gin.Context) {
 // @Param limit query int32 false "Number of items per page" default(10)
 // @Param country_id query int64 false "Country ID filter"
 // @Param sport_type query string false "Sport type filter"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
 // @Success 200 {object} athleteservice.ListResponse
 // @Failure 400 {object} athleteservice.Message
 // @Failure 500 {object} athleteservice.Message
@@ -187,13 +208,16 @@ func (a *AthleteHandlers) ListAthletes(ctx *gin.Context) {
 		Limit:     int32(limit),
 		CountryId: countryID,
 		SportType: sportType,
+		GamesId:   games.FromContext(ctx),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
 	}
 
 	resp, err := a.client.ListAthletes(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	fields.Respond(ctx, resp)
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	genprotos "olympy/api-gateway/genproto/auth_service"
+	"olympy/pkg/logger"
 	"github.com/streadway/amqp"
 
 	"github.com/gin-gonic/gin"
@@ -56,7 +57,10 @@ func (a *AuthHandlers) Register(ctx *gin.Context) {
 		false,
 		amqp.Publishing{
 			ContentType: "application/json",
-			Body:        reqBytes,
+			Headers: amqp.Table{
+				logger.RequestIDKey: logger.RequestID(ctx),
+			},
+			Body: reqBytes,
 		},
 	)
 	if err != nil {
@@ -0,0 +1,296 @@
+package calendarhandlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"olympy/api-gateway/api/middleware/games"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	venueservice "olympy/api-gateway/genproto/venue_service"
+	"olympy/api-gateway/internal/pkg/ical"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CalendarHandlers serve the event schedule as iCalendar feeds, and manage
+// the private feeds of users.
+type CalendarHandlers struct {
+	eventClient eventservice.EventServiceClient
+	venueClient venueservice.VenueServiceClient
+	logger      *log.Logger
+}
+
+func NewCalendarHandlers(eventClient eventservice.EventServiceClient, venueClient venueservice.VenueServiceClient, logger *log.Logger) *CalendarHandlers {
+	return &CalendarHandlers{
+		eventClient: eventClient,
+		venueClient: venueClient,
+		logger:      logger,
+	}
+}
+
+const (
+	feedPath = "/api/v1/events/calendar.ics"
+	prodID   = "-//Olympy//Schedule//EN"
+	// uidDomain qualifies the UIDs of events, which must be globally unique.
+	uidDomain = "olympy"
+	// refresh is how often calendar apps are asked to fetch feeds again.
+	refresh = time.Hour
+)
+
+// respondError answers invalid filters with 400, and unknown tokens and
+// subscriptions with 404.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		ctx.IndentedJSON(404, gin.H{"error": status.Convert(err).Message()})
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+// parseBound reads a from or to query parameter: an RFC 3339 time, or a
+// date, which is taken as the start of the day in UTC or, for to, its end.
+func parseBound(ctx *gin.Context, name string) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a date such as 2024-07-27 or an RFC 3339 time, got %q", name, value)
+	}
+	if name == "to" {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// Calendar godoc
+// @Summary Schedule as an iCalendar feed
+// @Description Returns the events as an iCalendar (.ics) file that calendar apps can subscribe to. Events keep their UID across changes, and their SEQUENCE grows when they are rescheduled or cancelled, so that calendar apps update them instead of adding copies.
+// @Description With the token of a calendar subscription, the feed has the subscription's filters and the filter parameters are ignored.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param token query string false "Private token of a calendar subscription"
+// @Param sport_type query string false "Sport type"
+// @Param query query string false "Matches the name or sport type"
+// @Param from query string false "Events ending after, a date such as 2024-07-27 or an RFC 3339 time"
+// @Param to query string false "Events starting before, a date (inclusive) or an RFC 3339 time"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Success 200 {file} file
+// @Failure 400 {object} eventservice.Message
+// @Failure 404 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/calendar.ics [get]
+func (h *CalendarHandlers) Calendar(ctx *gin.Context) {
+	req := &eventservice.CalendarRequest{Token: ctx.Query("token")}
+	name := "Olympy schedule"
+	if req.Token == "" {
+		from, err := parseBound(ctx, "from")
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		to, err := parseBound(ctx, "to")
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		req.Filter = &eventservice.CalendarFilter{
+			SportType: ctx.Query("sport_type"),
+			Query:     ctx.Query("query"),
+			From:      from,
+			To:        to,
+			GamesId:   games.FromContext(ctx),
+		}
+		if req.Filter.SportType != "" {
+			name += ": " + req.Filter.SportType
+		}
+	}
+
+	stream, err := h.eventClient.CalendarEvents(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+	// The first event is received before the response is started, so that
+	// unknown tokens and invalid filters are still answered with errors.
+	event, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", ical.ContentType)
+	ctx.Header("Content-Disposition", `inline; filename="calendar.ics"`)
+	// Private feeds must not be kept by shared caches.
+	ctx.Header("Cache-Control", "private, max-age=300")
+	ctx.Status(200)
+
+	w := ical.NewWriter(ctx.Writer, prodID, name, refresh)
+	venues := map[int64]*venueservice.Venue{}
+	for err == nil {
+		if err = w.Write(h.calendarEvent(ctx, event, venues)); err != nil {
+			break
+		}
+		event, err = stream.Recv()
+	}
+	if err == io.EOF {
+		err = w.Close()
+	}
+	if err != nil {
+		h.logger.Printf("calendar feed aborted: %v", err)
+		ctx.Abort()
+	}
+}
+
+// calendarEvent converts an event for the feed. Venues are looked up once
+// per feed; events whose venue cannot be read only have their location.
+func (h *CalendarHandlers) calendarEvent(ctx *gin.Context, e *eventservice.Event, venues map[int64]*venueservice.Venue) ical.Event {
+	var location []string
+	if e.Location != "" {
+		location = append(location, e.Location)
+	}
+	if e.VenueId != 0 {
+		venue, ok := venues[e.VenueId]
+		if !ok {
+			var err error
+			venue, err = h.venueClient.GetVenue(ctx, &venueservice.GetSingleRequest{Id: e.VenueId})
+			if err != nil {
+				h.logger.Printf("failed to get venue %d for the calendar: %v", e.VenueId, err)
+			}
+			venues[e.VenueId] = venue
+		}
+		if venue != nil {
+			location = append(location, venue.Name, venue.City)
+		}
+	}
+
+	status := ical.Confirmed
+	if e.Status == "cancelled" {
+		status = ical.Cancelled
+	}
+	event := ical.Event{
+		UID:        fmt.Sprintf("event-%d@%s", e.Id, uidDomain),
+		Sequence:   int(e.Sequence),
+		Summary:    e.Name,
+		Location:   strings.Join(location, ", "),
+		Categories: e.SportType,
+		Status:     status,
+	}
+	if e.StartTime != nil {
+		event.Start = *e.StartTime
+	}
+	if e.EndTime != nil {
+		event.End = *e.EndTime
+	}
+	return event
+}
+
+// feedURL returns the address of the feed of a subscription, on the host
+// the request was made to.
+func feedURL(ctx *gin.Context, token string) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := ctx.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	u := url.URL{Scheme: scheme, Host: ctx.Request.Host, Path: feedPath, RawQuery: url.Values{"token": {token}}.Encode()}
+	return u.String()
+}
+
+// AddCalendarSubscription godoc
+// @Summary Create a calendar subscription
+// @Description Creates a private feed of the events matching filter for the caller. The url, which holds the feed's token, is only returned by this endpoint; anyone with it can read the feed until the subscription is deleted.
+// @Tags Calendar
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body eventservice.CalendarSubscription true "Name and filter of the feed"
+// @Success 200 {object} eventservice.CalendarSubscription
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/calendar/subscriptions/add [post]
+func (h *CalendarHandlers) AddCalendarSubscription(ctx *gin.Context) {
+	var req eventservice.CalendarSubscription
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Filter == nil {
+		req.Filter = &eventservice.CalendarFilter{}
+	}
+	if req.Filter.GamesId == 0 {
+		req.Filter.GamesId = games.FromContext(ctx)
+	}
+
+	resp, err := h.eventClient.AddCalendarSubscription(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+	resp.Url = feedURL(ctx, resp.Token)
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListCalendarSubscriptions godoc
+// @Summary List calendar subscriptions
+// @Description Lists the caller's calendar subscriptions, newest first, without their tokens.
+// @Tags Calendar
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} eventservice.ListCalendarSubscriptionsResponse
+// @Failure 500 {object} eventservice.Message
+// @Router /events/calendar/subscriptions/getall [get]
+func (h *CalendarHandlers) ListCalendarSubscriptions(ctx *gin.Context) {
+	resp, err := h.eventClient.ListCalendarSubscriptions(ctx, &eventservice.ListCalendarSubscriptionsRequest{})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteCalendarSubscription godoc
+// @Summary Delete a calendar subscription
+// @Description Deletes one of the caller's calendar subscriptions, which revokes its feed URL.
+// @Tags Calendar
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Subscription ID"
+// @Success 200 {object} eventservice.Message
+// @Failure 400 {object} eventservice.Message
+// @Failure 404 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/calendar/subscriptions/delete [delete]
+func (h *CalendarHandlers) DeleteCalendarSubscription(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	resp, err := h.eventClient.DeleteCalendarSubscription(ctx, &eventservice.DeleteCalendarSubscriptionRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
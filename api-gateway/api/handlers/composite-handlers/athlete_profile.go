@@ -0,0 +1,86 @@
+package compositehandlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"olympy/api-gateway/api/middleware/timezone"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AthleteProfile struct {
+	Athlete *athleteservice.Athlete `json:"athlete"`
+	Country *countryservice.Country `json:"country"`
+	Medals  []*medalservice.Medal   `json:"medals"`
+	Errors  []PartialError          `json:"errors,omitempty"`
+}
+
+// AthleteProfile godoc
+// @Summary Get athlete profile
+// @Description This endpoint returns an athlete with their country and medals. Parts that could not be loaded are listed in "errors".
+// @Tags Athlete
+// @Accept json
+// @Produce json
+// @Param id path int64 true "Athlete ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} compositehandlers.AthleteProfile
+// @Failure 400 {object} athleteservice.Message
+// @Failure 500 {object} athleteservice.Message
+// @Router /athletes/{id}/profile [get]
+func (h *CompositeHandlers) AthleteProfile(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var profile AthleteProfile
+
+	f := h.fanOut(ctx)
+	f.Go("athlete", func(ctx context.Context) error {
+		resp, err := h.athleteClient.GetAthlete(ctx, &athleteservice.GetSingleRequest{Id: id})
+		if err != nil {
+			return err
+		}
+		profile.Athlete = resp
+		return nil
+	})
+	f.Go("medals", func(ctx context.Context) error {
+		resp, err := h.medalClient.ListMedals(ctx, &medalservice.ListRequest{
+			Page:      1,
+			Limit:     profileLimit,
+			AthleteId: strconv.FormatInt(id, 10),
+		})
+		if err != nil {
+			return err
+		}
+		profile.Medals = resp.Medals
+		return nil
+	})
+	f.Wait()
+
+	if failure := f.failed("athlete"); failure != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"error": failure.Error})
+		return
+	}
+
+	// The country is only known once the athlete has been loaded.
+	f.Go("country", func(ctx context.Context) error {
+		resp, err := h.countryClient.GetCountry(ctx, &countryservice.GetSingleRequest{Id: profile.Athlete.CountryId})
+		if err != nil {
+			return err
+		}
+		profile.Country = resp
+		return nil
+	})
+	profile.Errors = f.Wait()
+
+	timezone.Apply(ctx, profile)
+	ctx.IndentedJSON(http.StatusOK, profile)
+}
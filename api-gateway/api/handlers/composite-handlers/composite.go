@@ -0,0 +1,99 @@
+package compositehandlers
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+)
+
+// profileLimit caps the lists embedded in a profile.
+const profileLimit = 1000
+
+// CompositeHandlers serve views assembled from several backends. Independent
+// calls run concurrently, each with its own timeout; when a secondary call
+// fails the response is still returned, with the failure listed in "errors".
+type CompositeHandlers struct {
+	eventClient   eventservice.EventServiceClient
+	medalClient   medalservice.MedalServiceClient
+	athleteClient athleteservice.AthleteServiceClient
+	countryClient countryservice.CountryServiceClient
+	timeout       time.Duration
+	logger        *log.Logger
+}
+
+func NewCompositeHandlers(
+	eventClient eventservice.EventServiceClient,
+	medalClient medalservice.MedalServiceClient,
+	athleteClient athleteservice.AthleteServiceClient,
+	countryClient countryservice.CountryServiceClient,
+	timeout time.Duration,
+	logger *log.Logger,
+) *CompositeHandlers {
+	return &CompositeHandlers{
+		eventClient:   eventClient,
+		medalClient:   medalClient,
+		athleteClient: athleteClient,
+		countryClient: countryClient,
+		timeout:       timeout,
+		logger:        logger,
+	}
+}
+
+// PartialError describes a part of a composite response that could not be loaded.
+type PartialError struct {
+	Source string `json:"source"`
+	Error  string `json:"error"`
+}
+
+// fanOut runs calls concurrently, each under its own timeout, and collects
+// the failures of the ones that did not succeed.
+type fanOut struct {
+	h    *CompositeHandlers
+	ctx  context.Context
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	errs []PartialError
+}
+
+func (h *CompositeHandlers) fanOut(ctx context.Context) *fanOut {
+	return &fanOut{h: h, ctx: ctx}
+}
+
+func (f *fanOut) Go(source string, call func(ctx context.Context) error) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		ctx, cancel := context.WithTimeout(f.ctx, f.h.timeout)
+		defer cancel()
+
+		if err := call(ctx); err != nil {
+			f.h.logger.Printf("composite: failed to load %s: %v", source, err)
+			f.mu.Lock()
+			f.errs = append(f.errs, PartialError{Source: source, Error: err.Error()})
+			f.mu.Unlock()
+		}
+	}()
+}
+
+// Wait blocks until every call has returned and reports the failures so far.
+func (f *fanOut) Wait() []PartialError {
+	f.wg.Wait()
+	return f.errs
+}
+
+// failed reports whether source is among the recorded failures.
+func (f *fanOut) failed(source string) *PartialError {
+	for i := range f.errs {
+		if f.errs[i].Source == source {
+			return &f.errs[i]
+		}
+	}
+	return nil
+}
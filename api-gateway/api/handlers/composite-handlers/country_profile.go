@@ -0,0 +1,96 @@
+package compositehandlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/timezone"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CountryProfile struct {
+	Country  *countryservice.Country         `json:"country"`
+	Athletes []*athleteservice.Athlete       `json:"athletes"`
+	Medals   []*medalservice.Medal           `json:"medals"`
+	Ranking  *medalservice.CountryMedalCount `json:"ranking"`
+	Errors   []PartialError                  `json:"errors,omitempty"`
+}
+
+// CountryProfile godoc
+// @Summary Get country profile
+// @Description This endpoint returns a country with its athletes, its medals and its position in the medal ranking. Parts that could not be loaded are listed in "errors".
+// @Tags Country
+// @Accept json
+// @Produce json
+// @Param id path int64 true "Country ID"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} compositehandlers.CountryProfile
+// @Failure 400 {object} countryservice.Message
+// @Failure 500 {object} countryservice.Message
+// @Router /countries/{id}/profile [get]
+func (h *CompositeHandlers) CountryProfile(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var profile CountryProfile
+	gamesID := games.FromContext(ctx)
+
+	f := h.fanOut(ctx)
+	f.Go("country", func(ctx context.Context) error {
+		resp, err := h.countryClient.GetCountry(ctx, &countryservice.GetSingleRequest{Id: id})
+		if err != nil {
+			return err
+		}
+		profile.Country = resp
+		return nil
+	})
+	f.Go("athletes", func(ctx context.Context) error {
+		resp, err := h.athleteClient.ListAthletes(ctx, &athleteservice.ListRequest{Page: 1, Limit: profileLimit, CountryId: id, GamesId: gamesID})
+		if err != nil {
+			return err
+		}
+		profile.Athletes = resp.Athletes
+		return nil
+	})
+	f.Go("medals", func(ctx context.Context) error {
+		resp, err := h.medalClient.ListMedals(ctx, &medalservice.ListRequest{CountryIds: []int64{id}, GamesId: gamesID})
+		if err != nil {
+			return err
+		}
+		profile.Medals = resp.Medals
+		return nil
+	})
+	f.Go("ranking", func(ctx context.Context) error {
+		resp, err := h.medalClient.GetMedalRanking(ctx, &medalservice.RankingRequest{GamesId: gamesID})
+		if err != nil {
+			return err
+		}
+		for _, count := range resp.CountryMedalCounts {
+			if count.CountryId == id {
+				profile.Ranking = count
+				break
+			}
+		}
+		return nil
+	})
+	profile.Errors = f.Wait()
+
+	if failure := f.failed("country"); failure != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"error": failure.Error})
+		return
+	}
+
+	timezone.Apply(ctx, profile)
+	ctx.IndentedJSON(http.StatusOK, profile)
+}
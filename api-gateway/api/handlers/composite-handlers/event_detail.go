@@ -0,0 +1,126 @@
+package compositehandlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"olympy/api-gateway/api/middleware/timezone"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Medalist struct {
+	Medal   *medalservice.Medal     `json:"medal"`
+	Athlete *athleteservice.Athlete `json:"athlete"`
+	Country *countryservice.Country `json:"country"`
+}
+
+type EventDetail struct {
+	Event     *eventservice.Event `json:"event"`
+	Medalists []Medalist          `json:"medalists"`
+	Errors    []PartialError      `json:"errors,omitempty"`
+}
+
+// EventDetail godoc
+// @Summary Get event detail
+// @Description This endpoint returns an event with its medals, medalists and their countries. Parts that could not be loaded are listed in "errors".
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path int64 true "Event ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} compositehandlers.EventDetail
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/{id}/detail [get]
+func (h *CompositeHandlers) EventDetail(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var (
+		detail EventDetail
+		medals []*medalservice.Medal
+	)
+
+	f := h.fanOut(ctx)
+	f.Go("event", func(ctx context.Context) error {
+		resp, err := h.eventClient.GetEvent(ctx, &eventservice.GetEventRequest{Id: strconv.FormatInt(id, 10)})
+		if err != nil {
+			return err
+		}
+		detail.Event = resp.Event
+		return nil
+	})
+	f.Go("medals", func(ctx context.Context) error {
+		resp, err := h.medalClient.ListMedals(ctx, &medalservice.ListRequest{EventIds: []int64{id}})
+		if err != nil {
+			return err
+		}
+		medals = resp.Medals
+		return nil
+	})
+	f.Wait()
+
+	if failure := f.failed("event"); failure != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"error": failure.Error})
+		return
+	}
+
+	athletes := make(map[int64]*athleteservice.Athlete)
+	countries := make(map[int64]*countryservice.Country)
+
+	var athleteIDs, countryIDs []int64
+	for _, medal := range medals {
+		if athleteID, err := strconv.ParseInt(medal.AthleteId, 10, 64); err == nil {
+			athleteIDs = append(athleteIDs, athleteID)
+		}
+		countryIDs = append(countryIDs, medal.CountryId)
+	}
+
+	if len(athleteIDs) > 0 {
+		f.Go("athletes", func(ctx context.Context) error {
+			resp, err := h.athleteClient.ListAthletes(ctx, &athleteservice.ListRequest{Ids: athleteIDs})
+			if err != nil {
+				return err
+			}
+			for _, athlete := range resp.Athletes {
+				athletes[athlete.Id] = athlete
+			}
+			return nil
+		})
+	}
+	if len(countryIDs) > 0 {
+		f.Go("countries", func(ctx context.Context) error {
+			resp, err := h.countryClient.ListCountries(ctx, &countryservice.ListRequest{Ids: countryIDs})
+			if err != nil {
+				return err
+			}
+			for _, country := range resp.Countries {
+				countries[country.Id] = country
+			}
+			return nil
+		})
+	}
+	detail.Errors = f.Wait()
+
+	detail.Medalists = make([]Medalist, 0, len(medals))
+	for _, medal := range medals {
+		medalist := Medalist{Medal: medal, Country: countries[medal.CountryId]}
+		if athleteID, err := strconv.ParseInt(medal.AthleteId, 10, 64); err == nil {
+			medalist.Athlete = athletes[athleteID]
+		}
+		detail.Medalists = append(detail.Medalists, medalist)
+	}
+
+	timezone.Apply(ctx, detail)
+	ctx.IndentedJSON(http.StatusOK, detail)
+}
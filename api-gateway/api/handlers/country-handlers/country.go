@@ -3,9 +3,12 @@ package eventhandlers
 import (
 	"log"
 	countryservice "olympy/api-gateway/genproto/country_service"
+	"olympy/api-gateway/internal/pkg/fields"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CountryHandlers struct {
@@ -20,6 +23,15 @@ func NewCountryHandlers(client countryservice.CountryServiceClient, logger *log.
 	}
 }
 
+// respondError answers unknown fields and invalid page tokens with 400.
+func respondError(ctx *gin.Context, err error) {
+	if status.Code(err) == codes.InvalidArgument {
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+}
+
 // AddCountry godoc
 // @Summary Add a country
 // @Description This endpoint adds a new country.
@@ -114,6 +126,8 @@ func (c *CountryHandlers) DeleteCountry(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "Country ID to retrieve"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
 // @Success 200 {object} countryservice.Country
 // @Failure 400 {object} countryservice.Message
 // @Failure 500 {object} countryservice.Message
@@ -127,15 +141,15 @@ func (c *CountryHandlers) GetCountry(ctx *gin.Context) {
 		return
 	}
 
-	req := &countryservice.GetSingleRequest{Id: id}
+	req := &countryservice.GetSingleRequest{Id: id, Fields: fields.Mask(ctx)}
 
 	resp, err := c.client.GetCountry(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	fields.Respond(ctx, resp)
 }
 
 // ListCountries godoc
@@ -146,6 +160,9 @@ func (c *CountryHandlers) GetCountry(ctx *gin.Context) {
 // @Produce json
 // @Param page query int32 false "Page number" default(1)
 // @Param limit query int32 false "Number of items per page" default(10)
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
 // @Success 200 {object} countryservice.ListResponse
 // @Failure 400 {object} countryservice.Message
 // @Failure 500 {object} countryservice.Message
@@ -167,15 +184,17 @@ func (c *CountryHandlers) ListCountries(ctx *gin.Context) {
 	}
 
 	req := &countryservice.ListRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
 	}
 
 	resp, err := c.client.ListCountries(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	fields.Respond(ctx, resp)
 }
@@ -2,10 +2,17 @@ package eventhandlers
 
 import (
 	"log"
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/timezone"
 	eventservice "olympy/api-gateway/genproto/event_service"
+	"olympy/api-gateway/internal/pkg/fields"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type EventHandlers struct {
@@ -20,16 +27,54 @@ func NewEventHandlers(client eventservice.EventServiceClient, logger *log.Logger
 	}
 }
 
+// respondError answers unknown fields, invalid page tokens and unknown
+// venues with 400, and events at venues that cannot host their sport or
+// clashing with the schedule with 409, listing the conflicts.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		ctx.IndentedJSON(404, gin.H{"error": status.Convert(err).Message()})
+	case codes.FailedPrecondition:
+		body := gin.H{"error": status.Convert(err).Message()}
+		if conflicts := scheduleConflicts(err); conflicts != nil {
+			body["conflicts"] = conflicts
+		}
+		ctx.IndentedJSON(409, body)
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+// scheduleConflicts returns the conflicts attached to the details of err.
+// The details are decoded here as the event service messages are not in
+// the registry that status.Details consults.
+func scheduleConflicts(err error) []*eventservice.ScheduleConflict {
+	for _, detail := range status.Convert(err).Proto().GetDetails() {
+		if !strings.HasSuffix(detail.GetTypeUrl(), "/event_service.ScheduleConflicts") {
+			continue
+		}
+		var conflicts eventservice.ScheduleConflicts
+		if proto.Unmarshal(detail.GetValue(), &conflicts) == nil {
+			return conflicts.Conflicts
+		}
+	}
+	return nil
+}
+
 // AddEvent godoc
 // @Summary Add an event
-// @Description This endpoint adds a new event.
+// @Description This endpoint adds a new event. A venue_id assigns it to a venue, which must be able to host its sport, and location to a field of play within it. Events clashing with the schedule are answered with 409 and the conflicts.
 // @Tags Event
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body eventservice.AddEventRequest true "Event details to add"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} eventservice.AddEventResponse
 // @Failure 400 {object} eventservice.Message
+// @Failure 409 {object} eventservice.Message
 // @Failure 500 {object} eventservice.Message
 // @Router /events/add [post]
 func (e *EventHandlers) AddEvent(ctx *gin.Context) {
@@ -42,10 +87,11 @@ func (e *EventHandlers) AddEvent(ctx *gin.Context) {
 
 	resp, err := e.client.AddEvent(ctx, &req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
+	timezone.Apply(ctx, resp)
 	ctx.IndentedJSON(200, resp)
 }
 
@@ -57,8 +103,10 @@ func (e *EventHandlers) AddEvent(ctx *gin.Context) {
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body eventservice.EditEventRequest true "Event details to edit"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} eventservice.EditEventResponse
 // @Failure 400 {object} eventservice.Message
+// @Failure 409 {object} eventservice.Message
 // @Failure 500 {object} eventservice.Message
 // @Router /events/edit [put]
 func (e *EventHandlers) EditEvent(ctx *gin.Context) {
@@ -71,10 +119,11 @@ func (e *EventHandlers) EditEvent(ctx *gin.Context) {
 
 	resp, err := e.client.EditEvent(ctx, &req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
+	timezone.Apply(ctx, resp)
 	ctx.IndentedJSON(200, resp)
 }
 
@@ -111,6 +160,9 @@ func (e *EventHandlers) DeleteEvent(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "Event ID to retrieve"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} eventservice.GetEventResponse
 // @Failure 400 {object} eventservice.Message
 // @Failure 500 {object} eventservice.Message
@@ -118,15 +170,16 @@ func (e *EventHandlers) DeleteEvent(ctx *gin.Context) {
 func (e *EventHandlers) GetEvent(ctx *gin.Context) {
 	idStr := ctx.Query("id")
 
-	req := &eventservice.GetEventRequest{Id: idStr}
+	req := &eventservice.GetEventRequest{Id: idStr, Fields: fields.Mask(ctx)}
 
 	resp, err := e.client.GetEvent(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
 }
 
 // GetAllEvents godoc
@@ -137,6 +190,11 @@ func (e *EventHandlers) GetEvent(ctx *gin.Context) {
 // @Produce json
 // @Param page query int32 false "Page number" default(1)
 // @Param page_size query int32 false "Number of items per page" default(10)
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} eventservice.GetAllEventsResponse
 // @Failure 400 {object} eventservice.Message
 // @Failure 500 {object} eventservice.Message
@@ -158,17 +216,21 @@ func (e *EventHandlers) GetAllEvents(ctx *gin.Context) {
 	}
 
 	req := &eventservice.GetAllEventsRequest{
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		GamesId:   games.FromContext(ctx),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
 	}
 
 	resp, err := e.client.GetAllEvents(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
 }
 
 // SearchEvents godoc
@@ -180,6 +242,11 @@ func (e *EventHandlers) GetAllEvents(ctx *gin.Context) {
 // @Param query query string false "Search query"
 // @Param page query int32 false "Page number" default(1)
 // @Param page_size query int32 false "Number of items per page" default(10)
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} eventservice.GetAllEventsResponse
 // @Failure 400 {object} eventservice.Message
 // @Failure 500 {object} eventservice.Message
@@ -202,16 +269,168 @@ func (e *EventHandlers) SearchEvents(ctx *gin.Context) {
 	}
 
 	req := &eventservice.SearchEventsRequest{
-		Query:    query,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Query:     query,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		GamesId:   games.FromContext(ctx),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
 	}
 
 	resp, err := e.client.SearchEvents(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
+		return
+	}
+
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
+}
+
+// ListEventsByVenue godoc
+// @Summary List events at a venue
+// @Description This endpoint retrieves the events held at a venue by start time, with pagination.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path int64 true "Venue ID"
+// @Param page query int32 false "Page number" default(1)
+// @Param page_size query int32 false "Number of items per page" default(10)
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} eventservice.GetAllEventsResponse
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /venues/{id}/events [get]
+func (e *EventHandlers) ListEventsByVenue(ctx *gin.Context) {
+	venueID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	pageSize, err := strconv.ParseInt(ctx.DefaultQuery("page_size", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page size"})
+		return
+	}
+
+	req := &eventservice.ListEventsByVenueRequest{
+		VenueId:   venueID,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		GamesId:   games.FromContext(ctx),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
+	}
+
+	resp, err := e.client.ListEventsByVenue(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
+}
+
+// ValidateSchedule godoc
+// @Summary Validate the schedule
+// @Description This endpoint reports the conflicts among the events of a day or an edition: events at the same location without the changeover between them, and more events of a sport at once than its rules allow.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param date query string false "Day, e.g. 2024-07-27"
+// @Param games_id query int64 false "Olympic Games edition ID; one of date and games_id is required"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} eventservice.ValidateScheduleResponse
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/schedule/validate [get]
+func (e *EventHandlers) ValidateSchedule(ctx *gin.Context) {
+	req := &eventservice.ValidateScheduleRequest{
+		Date:    ctx.Query("date"),
+		GamesId: games.FromContext(ctx),
+	}
+
+	resp, err := e.client.ValidateSchedule(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	timezone.Apply(ctx, resp)
+	ctx.IndentedJSON(200, resp)
+}
+
+// TransitionEventStatus godoc
+// @Summary Change the status of an event
+// @Description This endpoint moves an event to another status: scheduled, live, delayed, suspended, completed or cancelled. Transitions the lifecycle does not allow, such as from a completed event, are answered with 409. The change is recorded with the reason and the caller, and published to the live commentary of the event.
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body eventservice.TransitionEventStatusRequest true "Event, status and reason"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} eventservice.TransitionEventStatusResponse
+// @Failure 400 {object} eventservice.Message
+// @Failure 404 {object} eventservice.Message
+// @Failure 409 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/status [post]
+func (e *EventHandlers) TransitionEventStatus(ctx *gin.Context) {
+	var req eventservice.TransitionEventStatusRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := e.client.TransitionEventStatus(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	timezone.Apply(ctx, resp)
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListStatusTransitions godoc
+// @Summary List the status transitions of an event
+// @Description This endpoint retrieves the status history of an event, oldest first. Automatic transitions have the actor "system".
+// @Tags Event
+// @Accept json
+// @Produce json
+// @Param id path int64 true "Event ID"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {object} eventservice.ListStatusTransitionsResponse
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/{id}/status/history [get]
+func (e *EventHandlers) ListStatusTransitions(ctx *gin.Context) {
+	eventID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	resp, err := e.client.ListStatusTransitions(ctx, &eventservice.ListStatusTransitionsRequest{EventId: eventID})
+	if err != nil {
+		respondError(ctx, err)
 		return
 	}
 
+	timezone.Apply(ctx, resp)
 	ctx.IndentedJSON(200, resp)
 }
@@ -0,0 +1,55 @@
+package exporthandlers
+
+import (
+	"strconv"
+
+	"olympy/api-gateway/api/middleware/games"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportAthletes godoc
+// @Summary Export athletes
+// @Description Downloads every athlete matching the filters of the athlete list as CSV, NDJSON or an Excel workbook.
+// @Tags Athlete
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default), ndjson or xlsx"
+// @Param country_id query int64 false "Country ID filter"
+// @Param sport_type query string false "Sport type filter"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Success 200 {file} file
+// @Failure 400 {object} athleteservice.Message
+// @Failure 500 {object} athleteservice.Message
+// @Router /athletes/export [get]
+func (h *ExportHandlers) ExportAthletes(ctx *gin.Context) {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &athleteservice.ListRequest{SportType: ctx.Query("sport_type"), GamesId: games.FromContext(ctx)}
+	if countryIDStr := ctx.Query("country_id"); countryIDStr != "" {
+		req.CountryId, err = strconv.ParseInt(countryIDStr, 10, 64)
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": "Invalid country ID"})
+			return
+		}
+	}
+
+	stream, err := h.athleteClient.ExportAthletes(ctx, req)
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	columns := []string{"id", "name", "country_id", "sport_type", "created_at", "updated_at"}
+	h.export(ctx, format, "athletes", columns, func() (interface{}, []interface{}, error) {
+		a, err := stream.Recv()
+		if err != nil {
+			return nil, nil, err
+		}
+		return a, []interface{}{a.Id, a.Name, a.CountryId, a.SportType, a.CreatedAt, a.UpdatedAt}, nil
+	})
+}
@@ -0,0 +1,47 @@
+package exporthandlers
+
+import (
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/timezone"
+	eventservice "olympy/api-gateway/genproto/event_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportEvents godoc
+// @Summary Export events
+// @Description Downloads all events, or those whose name or sport type matches query, as CSV, NDJSON or an Excel workbook.
+// @Tags Event
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default), ndjson or xlsx"
+// @Param query query string false "Search query"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param tz query string false "Time zone of the times, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {file} file
+// @Failure 400 {object} eventservice.Message
+// @Failure 500 {object} eventservice.Message
+// @Router /events/export [get]
+func (h *ExportHandlers) ExportEvents(ctx *gin.Context) {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream, err := h.eventClient.ExportEvents(ctx, &eventservice.SearchEventsRequest{Query: ctx.Query("query"), GamesId: games.FromContext(ctx)})
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	zone := timezone.FromContext(ctx)
+	columns := []string{"id", "name", "sport_type", "start_time", "end_time", "timezone"}
+	h.export(ctx, format, "events", columns, func() (interface{}, []interface{}, error) {
+		e, err := stream.Recv()
+		if err != nil {
+			return nil, nil, err
+		}
+		timezone.Apply(ctx, e)
+		return e, []interface{}{e.Id, e.Name, e.SportType, zone.Format(e.StartTime, e.Timezone), zone.Format(e.EndTime, e.Timezone), e.Timezone}, nil
+	})
+}
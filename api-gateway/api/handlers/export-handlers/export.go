@@ -0,0 +1,183 @@
+package exporthandlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportHandlers stream the backends' Export RPCs to the client as CSV,
+// NDJSON or Excel files.
+type ExportHandlers struct {
+	eventClient   eventservice.EventServiceClient
+	medalClient   medalservice.MedalServiceClient
+	athleteClient athleteservice.AthleteServiceClient
+	logger        *log.Logger
+}
+
+func NewExportHandlers(
+	eventClient eventservice.EventServiceClient,
+	medalClient medalservice.MedalServiceClient,
+	athleteClient athleteservice.AthleteServiceClient,
+	logger *log.Logger,
+) *ExportHandlers {
+	return &ExportHandlers{
+		eventClient:   eventClient,
+		medalClient:   medalClient,
+		athleteClient: athleteClient,
+		logger:        logger,
+	}
+}
+
+var contentTypes = map[string]string{
+	"csv":    "text/csv; charset=utf-8",
+	"ndjson": "application/x-ndjson",
+	"xlsx":   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// writer encodes exported records. msg is the record as received from the
+// backend and values are its columns in header order.
+type writer interface {
+	Write(msg interface{}, values []interface{}) error
+	Close() error
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	return cw, cw.w.Write(columns)
+}
+
+func (c *csvWriter) Write(_ interface{}, values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = fmt.Sprint(v)
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(msg interface{}, _ []interface{}) error {
+	return n.enc.Encode(msg)
+}
+
+func (n *ndjsonWriter) Close() error { return nil }
+
+// xlsxWriter builds a single-sheet workbook with excelize's stream writer,
+// which keeps large sheets out of memory; the file is written on Close.
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXWriter(w io.Writer, sheet string, columns []string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName("Sheet1", sheet); err != nil {
+		return nil, err
+	}
+	sw, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, err
+	}
+	x := &xlsxWriter{out: w, file: file, sw: sw}
+	header := make([]interface{}, len(columns))
+	for i, c := range columns {
+		header[i] = c
+	}
+	return x, x.Write(nil, header)
+}
+
+func (x *xlsxWriter) Write(_ interface{}, values []interface{}) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+func newWriter(format string, w io.Writer, name string, columns []string) (writer, error) {
+	switch format {
+	case "csv":
+		return newCSVWriter(w, columns)
+	case "ndjson":
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	default:
+		return newXLSXWriter(w, name, columns)
+	}
+}
+
+// parseFormat reads the format query parameter, which defaults to csv.
+func parseFormat(ctx *gin.Context) (string, error) {
+	format := strings.ToLower(ctx.DefaultQuery("format", "csv"))
+	if _, ok := contentTypes[format]; !ok {
+		return "", errors.New("format must be csv, ndjson or xlsx")
+	}
+	return format, nil
+}
+
+// export writes the records returned by recv as an attachment named after
+// name until recv reports io.EOF. The first record is received before the
+// response is started, so failures to open the stream are still reported
+// as JSON errors; a failure later on can only cut the file short.
+func (h *ExportHandlers) export(ctx *gin.Context, format, name string, columns []string, recv func() (interface{}, []interface{}, error)) {
+	msg, values, err := recv()
+	if err != nil && err != io.EOF {
+		h.logger.Println(err)
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102"), format)
+	ctx.Header("Content-Type", contentTypes[format])
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Status(200)
+
+	w, err := newWriter(format, ctx.Writer, name, columns)
+	for err == nil && msg != nil {
+		if err = w.Write(msg, values); err != nil {
+			break
+		}
+		msg, values, err = recv()
+	}
+	if err == nil || err == io.EOF {
+		err = w.Close()
+	}
+	if err != nil && err != io.EOF {
+		h.logger.Printf("export of %s aborted: %v", name, err)
+		ctx.Abort()
+	}
+}
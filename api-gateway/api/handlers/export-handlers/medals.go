@@ -0,0 +1,101 @@
+package exporthandlers
+
+import (
+	"strconv"
+
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/timezone"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportMedals godoc
+// @Summary Export medals
+// @Description Downloads every medal matching the filters of the medal list as CSV, NDJSON or an Excel workbook.
+// @Tags Medal
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default), ndjson or xlsx"
+// @Param country query int64 false "Country ID"
+// @Param event_id query int64 false "Event ID"
+// @Param athlete_id query string false "Athlete ID"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param tz query string false "Time zone of the times, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
+// @Success 200 {file} file
+// @Failure 400 {object} medalservice.Message
+// @Failure 500 {object} medalservice.Message
+// @Router /medals/export [get]
+func (h *ExportHandlers) ExportMedals(ctx *gin.Context) {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &medalservice.ListRequest{AthleteId: ctx.Query("athlete_id"), GamesId: games.FromContext(ctx)}
+	if countryStr := ctx.Query("country"); countryStr != "" {
+		req.Country, err = strconv.ParseInt(countryStr, 10, 64)
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": "Invalid country ID"})
+			return
+		}
+	}
+	if eventIdStr := ctx.Query("event_id"); eventIdStr != "" {
+		req.EventId, err = strconv.ParseInt(eventIdStr, 10, 64)
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": "Invalid event ID"})
+			return
+		}
+	}
+
+	stream, err := h.medalClient.ExportMedals(ctx, req)
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	zone := timezone.FromContext(ctx)
+	columns := []string{"id", "country_id", "type", "event_id", "athlete_id", "created_at", "updated_at"}
+	h.export(ctx, format, "medals", columns, func() (interface{}, []interface{}, error) {
+		m, err := stream.Recv()
+		if err != nil {
+			return nil, nil, err
+		}
+		timezone.Apply(ctx, m)
+		return m, []interface{}{m.Id, m.CountryId, m.Type, m.EventId, m.AthleteId, zone.Format(m.CreatedAt, ""), zone.Format(m.UpdatedAt, "")}, nil
+	})
+}
+
+// ExportMedalRanking godoc
+// @Summary Export the medal ranking
+// @Description Downloads the ranking of countries based on medals as CSV, NDJSON or an Excel workbook.
+// @Tags Medal
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default), ndjson or xlsx"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Success 200 {file} file
+// @Failure 400 {object} medalservice.Message
+// @Failure 500 {object} medalservice.Message
+// @Router /medals/ranking/export [get]
+func (h *ExportHandlers) ExportMedalRanking(ctx *gin.Context) {
+	format, err := parseFormat(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream, err := h.medalClient.ExportMedalRanking(ctx, &medalservice.RankingRequest{GamesId: games.FromContext(ctx)})
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	columns := []string{"ranking", "country_id", "country_name", "gold", "silver", "bronze"}
+	h.export(ctx, format, "medal-ranking", columns, func() (interface{}, []interface{}, error) {
+		c, err := stream.Recv()
+		if err != nil {
+			return nil, nil, err
+		}
+		return c, []interface{}{c.Ranking, c.CountryId, c.CountryName, c.Gold, c.Silver, c.Bronze}, nil
+	})
+}
@@ -0,0 +1,204 @@
+package gameshandlers
+
+import (
+	"log"
+	gamesservice "olympy/api-gateway/genproto/games_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GamesHandlers struct {
+	client gamesservice.GamesServiceClient
+	logger *log.Logger
+}
+
+func NewGamesHandlers(client gamesservice.GamesServiceClient, logger *log.Logger) *GamesHandlers {
+	return &GamesHandlers{
+		client: client,
+		logger: logger,
+	}
+}
+
+// respondError answers validation errors with 400, and duplicate editions
+// or editions still in use with 409.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		ctx.IndentedJSON(409, gin.H{"error": status.Convert(err).Message()})
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+func queryID(ctx *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return 0, false
+	}
+	return id, true
+}
+
+// AddGames godoc
+// @Summary Add an Olympic Games edition
+// @Description Adds an edition. Dates are YYYY-MM-DD; status defaults to planned. Only one edition may exist per year and season.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body gamesservice.Games true "Edition details to add"
+// @Success 200 {object} gamesservice.Games
+// @Failure 400 {object} gamesservice.Message
+// @Failure 409 {object} gamesservice.Message
+// @Failure 500 {object} gamesservice.Message
+// @Router /games/add [post]
+func (g *GamesHandlers) AddGames(ctx *gin.Context) {
+	var req gamesservice.Games
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := g.client.AddGames(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// EditGames godoc
+// @Summary Edit an Olympic Games edition
+// @Description Replaces an edition's details.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body gamesservice.Games true "Edition details to edit"
+// @Success 200 {object} gamesservice.Games
+// @Failure 400 {object} gamesservice.Message
+// @Failure 409 {object} gamesservice.Message
+// @Failure 500 {object} gamesservice.Message
+// @Router /games/edit [put]
+func (g *GamesHandlers) EditGames(ctx *gin.Context) {
+	var req gamesservice.Games
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := g.client.EditGames(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteGames godoc
+// @Summary Delete an Olympic Games edition
+// @Description Deletes an edition. Editions that events, athletes or medals still refer to cannot be deleted.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Edition ID to delete"
+// @Success 200 {object} gamesservice.Message
+// @Failure 400 {object} gamesservice.Message
+// @Failure 409 {object} gamesservice.Message
+// @Failure 500 {object} gamesservice.Message
+// @Router /games/delete [delete]
+func (g *GamesHandlers) DeleteGames(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := g.client.DeleteGames(ctx, &gamesservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// GetGames godoc
+// @Summary Get an Olympic Games edition
+// @Description Retrieves an edition by its ID.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param id query string true "Edition ID"
+// @Success 200 {object} gamesservice.Games
+// @Failure 400 {object} gamesservice.Message
+// @Failure 500 {object} gamesservice.Message
+// @Router /games/get [get]
+func (g *GamesHandlers) GetGames(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := g.client.GetGames(ctx, &gamesservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListGames godoc
+// @Summary List Olympic Games editions
+// @Description Retrieves the editions newest first, with pagination.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param page query int32 false "Page number" default(1)
+// @Param limit query int32 false "Number of items per page" default(10)
+// @Param season query string false "summer or winter"
+// @Param status query string false "planned, ongoing or completed"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} gamesservice.ListResponse
+// @Failure 400 {object} gamesservice.Message
+// @Failure 500 {object} gamesservice.Message
+// @Router /games/getall [get]
+func (g *GamesHandlers) ListGames(ctx *gin.Context) {
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid limit number"})
+		return
+	}
+
+	req := &gamesservice.ListRequest{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Season:    ctx.Query("season"),
+		Status:    ctx.Query("status"),
+		PageToken: ctx.Query("page_token"),
+	}
+
+	resp, err := g.client.ListGames(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
@@ -0,0 +1,83 @@
+package graphqlhandlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/casbin/casbin/v2"
+)
+
+const unauthorized = "unauthorized"
+
+// authorizer applies the REST policy from auth.csv to GraphQL fields: every
+// field backed by a gRPC call is checked against the route that exposes the
+// same data, e.g. Query.athletes against GET /api/v1/athletes/getall.
+type authorizer struct {
+	enforcer *casbin.Enforcer
+	sub      string
+
+	mu        sync.Mutex
+	decisions map[string]bool
+}
+
+func newAuthorizer(sub string) (*authorizer, error) {
+	e, err := casbin.NewEnforcer(`auth.conf`, `auth.csv`)
+	if err != nil {
+		return nil, err
+	}
+	return &authorizer{
+		enforcer:  e,
+		sub:       sub,
+		decisions: make(map[string]bool),
+	}, nil
+}
+
+func (a *authorizer) allowed(obj, act string) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := obj + " " + act
+	if ok, cached := a.decisions[key]; cached {
+		return ok, nil
+	}
+
+	ok, err := a.enforcer.Enforce(a.sub, obj, act)
+	if err != nil {
+		return false, err
+	}
+	// Routes open to anonymous callers stay open to authenticated ones.
+	if !ok && a.sub != unauthorized {
+		if ok, err = a.enforcer.Enforce(unauthorized, obj, act); err != nil {
+			return false, err
+		}
+	}
+	a.decisions[key] = ok
+	return ok, nil
+}
+
+type contextKey int
+
+const (
+	loadersKey contextKey = iota
+	authorizerKey
+)
+
+func authorize(ctx context.Context, obj, act string) error {
+	a, ok := ctx.Value(authorizerKey).(*authorizer)
+	if !ok {
+		return fmt.Errorf("permission denied")
+	}
+	allowed, err := a.allowed(obj, act)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("permission denied: %s %s", act, obj)
+	}
+	return nil
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey).(*loaders)
+}
@@ -0,0 +1,97 @@
+package graphqlhandlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+	jwt "olympy/api-gateway/internal/pkg/tokens"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// maxDepth bounds the nesting of a query, since every level of the
+	// cyclic schema (events, medals, athletes, countries) costs a backend
+	// call; maxParallelism bounds the fields resolved at once.
+	maxDepth       = 8
+	maxParallelism = 10
+)
+
+type GraphQLHandlers struct {
+	eventClient   eventservice.EventServiceClient
+	medalClient   medalservice.MedalServiceClient
+	athleteClient athleteservice.AthleteServiceClient
+	countryClient countryservice.CountryServiceClient
+	logger        *log.Logger
+	schema        *graphql.Schema
+}
+
+func NewGraphQLHandlers(
+	eventClient eventservice.EventServiceClient,
+	medalClient medalservice.MedalServiceClient,
+	athleteClient athleteservice.AthleteServiceClient,
+	countryClient countryservice.CountryServiceClient,
+	logger *log.Logger,
+) *GraphQLHandlers {
+	h := &GraphQLHandlers{
+		eventClient:   eventClient,
+		medalClient:   medalClient,
+		athleteClient: athleteClient,
+		countryClient: countryClient,
+		logger:        logger,
+	}
+	h.schema = graphql.MustParseSchema(schema, &resolver{h: h},
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	return h
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Query executes a GraphQL query. Every field is authorized against the
+// casbin policy of the REST route serving the same data.
+func (h *GraphQLHandlers) Query(ctx *gin.Context) {
+	var req request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sub := unauthorized
+	if token := ctx.GetHeader("Authorization"); token != "" {
+		claims, err := jwt.ExtractClaim(token)
+		if err != nil {
+			ctx.IndentedJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if role, ok := claims["role"].(string); ok {
+			sub = role
+		}
+	}
+
+	authz, err := newAuthorizer(sub)
+	if err != nil {
+		h.logger.Printf("failed to load casbin policy: %v", err)
+		ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"error": "failed to load authorization policy"})
+		return
+	}
+
+	// The gin context is the parent so that gateway middleware values
+	// (such as the stale-response marker) still reach the gRPC clients.
+	c := context.WithValue(ctx, authorizerKey, authz)
+	c = context.WithValue(c, loadersKey, newLoaders(h))
+
+	resp := h.schema.Exec(c, req.Query, req.OperationName, req.Variables)
+	ctx.JSON(http.StatusOK, resp)
+}
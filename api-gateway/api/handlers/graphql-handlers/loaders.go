@@ -0,0 +1,150 @@
+package graphqlhandlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"olympy/api-gateway/api/middleware/games"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+type idKey int64
+
+func (k idKey) String() string   { return strconv.FormatInt(int64(k), 10) }
+func (k idKey) Raw() interface{} { return int64(k) }
+
+// loader batches every load issued while a query is being resolved into a
+// single call to fetch, and caches the results for the rest of the request.
+type loader[T any] struct {
+	l *dataloader.Loader
+}
+
+func newLoader[T any](fetch func(ctx context.Context, ids []int64) (map[int64]T, error)) *loader[T] {
+	batch := func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ids := make([]int64, len(keys))
+		for i, key := range keys {
+			ids[i] = key.Raw().(int64)
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		values, err := fetch(ctx, ids)
+		for i, id := range ids {
+			if err != nil {
+				results[i] = &dataloader.Result{Error: err}
+				continue
+			}
+			results[i] = &dataloader.Result{Data: values[id]}
+		}
+		return results
+	}
+	return &loader[T]{l: dataloader.NewBatchedLoader(batch)}
+}
+
+func (l *loader[T]) load(ctx context.Context, id int64) (T, error) {
+	var zero T
+	data, err := l.l.Load(ctx, idKey(id))()
+	if err != nil {
+		return zero, err
+	}
+	value, ok := data.(T)
+	if !ok {
+		return zero, nil
+	}
+	return value, nil
+}
+
+// loaders are created per request so cached results never outlive it.
+type loaders struct {
+	events          *loader[*eventservice.Event]
+	athletes        *loader[*athleteservice.Athlete]
+	countries       *loader[*countryservice.Country]
+	medalsByCountry *loader[[]*medalservice.Medal]
+	medalsByEvent   *loader[[]*medalservice.Medal]
+}
+
+func newLoaders(h *GraphQLHandlers) *loaders {
+	return &loaders{
+		events: newLoader(func(ctx context.Context, ids []int64) (map[int64]*eventservice.Event, error) {
+			// EventService has no batch lookup, so the batch is fanned out concurrently.
+			var (
+				mu     sync.Mutex
+				wg     sync.WaitGroup
+				events = make(map[int64]*eventservice.Event, len(ids))
+				errs   []error
+			)
+			for _, id := range ids {
+				wg.Add(1)
+				go func(id int64) {
+					defer wg.Done()
+					resp, err := h.eventClient.GetEvent(ctx, &eventservice.GetEventRequest{Id: strconv.FormatInt(id, 10)})
+					mu.Lock()
+					defer mu.Unlock()
+					if err != nil {
+						errs = append(errs, fmt.Errorf("event %d: %v", id, err))
+						return
+					}
+					events[id] = resp.Event
+				}(id)
+			}
+			wg.Wait()
+			if len(events) == 0 && len(errs) > 0 {
+				return nil, errs[0]
+			}
+			for _, err := range errs {
+				h.logger.Printf("failed to load event: %v", err)
+			}
+			return events, nil
+		}),
+		athletes: newLoader(func(ctx context.Context, ids []int64) (map[int64]*athleteservice.Athlete, error) {
+			resp, err := h.athleteClient.ListAthletes(ctx, &athleteservice.ListRequest{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			athletes := make(map[int64]*athleteservice.Athlete, len(resp.Athletes))
+			for _, athlete := range resp.Athletes {
+				athletes[athlete.Id] = athlete
+			}
+			return athletes, nil
+		}),
+		countries: newLoader(func(ctx context.Context, ids []int64) (map[int64]*countryservice.Country, error) {
+			resp, err := h.countryClient.ListCountries(ctx, &countryservice.ListRequest{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			countries := make(map[int64]*countryservice.Country, len(resp.Countries))
+			for _, country := range resp.Countries {
+				countries[country.Id] = country
+			}
+			return countries, nil
+		}),
+		medalsByCountry: newLoader(func(ctx context.Context, ids []int64) (map[int64][]*medalservice.Medal, error) {
+			resp, err := h.medalClient.ListMedals(ctx, &medalservice.ListRequest{CountryIds: ids, GamesId: games.FromContext(ctx)})
+			if err != nil {
+				return nil, err
+			}
+			medals := make(map[int64][]*medalservice.Medal, len(ids))
+			for _, medal := range resp.Medals {
+				medals[medal.CountryId] = append(medals[medal.CountryId], medal)
+			}
+			return medals, nil
+		}),
+		medalsByEvent: newLoader(func(ctx context.Context, ids []int64) (map[int64][]*medalservice.Medal, error) {
+			resp, err := h.medalClient.ListMedals(ctx, &medalservice.ListRequest{EventIds: ids, GamesId: games.FromContext(ctx)})
+			if err != nil {
+				return nil, err
+			}
+			medals := make(map[int64][]*medalservice.Medal, len(ids))
+			for _, medal := range resp.Medals {
+				medals[medal.EventId] = append(medals[medal.EventId], medal)
+			}
+			return medals, nil
+		}),
+	}
+}
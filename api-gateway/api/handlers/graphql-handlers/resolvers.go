@@ -0,0 +1,424 @@
+package graphqlhandlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"olympy/api-gateway/api/middleware/games"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	eventsGet      = "/api/v1/events/get"
+	eventsGetAll   = "/api/v1/events/getall"
+	eventsSearch   = "/api/v1/events/search"
+	medalsGet      = "/api/v1/medals/get"
+	medalsGetAll   = "/api/v1/medals/getall"
+	medalsRanking  = "/api/v1/medals/ranking"
+	athletesGet    = "/api/v1/athletes/get"
+	athletesGetAll = "/api/v1/athletes/getall"
+	countriesGet   = "/api/v1/countries/get"
+	countriesAll   = "/api/v1/countries/getall"
+)
+
+func toID(id int64) graphql.ID {
+	return graphql.ID(strconv.FormatInt(id, 10))
+}
+
+func parseID(id graphql.ID) (int64, error) {
+	n, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", id)
+	}
+	return n, nil
+}
+
+func parseOptionalID(id *graphql.ID) (int64, error) {
+	if id == nil {
+		return 0, nil
+	}
+	return parseID(*id)
+}
+
+// selectedGames returns the edition named by a gamesId argument, falling back to
+// the one the request was scoped to.
+func selectedGames(ctx context.Context, id *graphql.ID) (int64, error) {
+	if id == nil {
+		return games.FromContext(ctx), nil
+	}
+	return parseID(*id)
+}
+
+// optionalID exposes an unset reference as null.
+func optionalID(id int64) *graphql.ID {
+	if id == 0 {
+		return nil
+	}
+	gid := toID(id)
+	return &gid
+}
+
+// rfc3339 formats t in UTC, or returns "" when it is unset.
+func rfc3339(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+type resolver struct {
+	h *GraphQLHandlers
+}
+
+type pageArgs struct {
+	Page  int32
+	Limit int32
+}
+
+func (r *resolver) Event(ctx context.Context, args struct{ ID graphql.ID }) (*eventResolver, error) {
+	if err := authorize(ctx, eventsGet, "GET"); err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := loadersFrom(ctx).events.load(ctx, id)
+	if err != nil || event == nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (r *resolver) Events(ctx context.Context, args struct {
+	pageArgs
+	Search  *string
+	GamesID *graphql.ID
+}) ([]*eventResolver, error) {
+	gamesID, err := selectedGames(ctx, args.GamesID)
+	if err != nil {
+		return nil, err
+	}
+	var resp *eventservice.GetAllEventsResponse
+	if args.Search != nil && *args.Search != "" {
+		if err := authorize(ctx, eventsSearch, "GET"); err != nil {
+			return nil, err
+		}
+		resp, err = r.h.eventClient.SearchEvents(ctx, &eventservice.SearchEventsRequest{
+			Query:    *args.Search,
+			Page:     args.Page,
+			PageSize: args.Limit,
+			GamesId:  gamesID,
+		})
+	} else {
+		if err := authorize(ctx, eventsGetAll, "GET"); err != nil {
+			return nil, err
+		}
+		resp, err = r.h.eventClient.GetAllEvents(ctx, &eventservice.GetAllEventsRequest{
+			Page:     args.Page,
+			PageSize: args.Limit,
+			GamesId:  gamesID,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*eventResolver, len(resp.Events))
+	for i, event := range resp.Events {
+		events[i] = &eventResolver{event}
+	}
+	return events, nil
+}
+
+func (r *resolver) Medal(ctx context.Context, args struct{ ID graphql.ID }) (*medalResolver, error) {
+	if err := authorize(ctx, medalsGet, "GET"); err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	medal, err := r.h.medalClient.GetMedal(ctx, &medalservice.GetSingleRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return &medalResolver{medal}, nil
+}
+
+func (r *resolver) Medals(ctx context.Context, args struct {
+	pageArgs
+	CountryID *graphql.ID
+	EventID   *graphql.ID
+	AthleteID *graphql.ID
+	GamesID   *graphql.ID
+}) ([]*medalResolver, error) {
+	if err := authorize(ctx, medalsGetAll, "GET"); err != nil {
+		return nil, err
+	}
+	countryID, err := parseOptionalID(args.CountryID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := parseOptionalID(args.EventID)
+	if err != nil {
+		return nil, err
+	}
+	gamesID, err := selectedGames(ctx, args.GamesID)
+	if err != nil {
+		return nil, err
+	}
+	var athleteID string
+	if args.AthleteID != nil {
+		athleteID = string(*args.AthleteID)
+	}
+
+	resp, err := r.h.medalClient.ListMedals(ctx, &medalservice.ListRequest{
+		Page:      args.Page,
+		Limit:     args.Limit,
+		Country:   countryID,
+		EventId:   eventID,
+		AthleteId: athleteID,
+		GamesId:   gamesID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return medalResolvers(resp.Medals), nil
+}
+
+func (r *resolver) Athlete(ctx context.Context, args struct{ ID graphql.ID }) (*athleteResolver, error) {
+	if err := authorize(ctx, athletesGet, "GET"); err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	athlete, err := loadersFrom(ctx).athletes.load(ctx, id)
+	if err != nil || athlete == nil {
+		return nil, err
+	}
+	return &athleteResolver{athlete}, nil
+}
+
+func (r *resolver) Athletes(ctx context.Context, args struct {
+	pageArgs
+	CountryID *graphql.ID
+	SportType *string
+	GamesID   *graphql.ID
+}) ([]*athleteResolver, error) {
+	if err := authorize(ctx, athletesGetAll, "GET"); err != nil {
+		return nil, err
+	}
+	countryID, err := parseOptionalID(args.CountryID)
+	if err != nil {
+		return nil, err
+	}
+	req := &athleteservice.ListRequest{
+		Page:      args.Page,
+		Limit:     args.Limit,
+		CountryId: countryID,
+	}
+	if req.GamesId, err = selectedGames(ctx, args.GamesID); err != nil {
+		return nil, err
+	}
+	if args.SportType != nil {
+		req.SportType = *args.SportType
+	}
+
+	resp, err := r.h.athleteClient.ListAthletes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	athletes := make([]*athleteResolver, len(resp.Athletes))
+	for i, athlete := range resp.Athletes {
+		athletes[i] = &athleteResolver{athlete}
+	}
+	return athletes, nil
+}
+
+func (r *resolver) Country(ctx context.Context, args struct{ ID graphql.ID }) (*countryResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return loadCountry(ctx, id)
+}
+
+func (r *resolver) Countries(ctx context.Context, args pageArgs) ([]*countryResolver, error) {
+	if err := authorize(ctx, countriesAll, "GET"); err != nil {
+		return nil, err
+	}
+	resp, err := r.h.countryClient.ListCountries(ctx, &countryservice.ListRequest{
+		Page:  args.Page,
+		Limit: args.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	countries := make([]*countryResolver, len(resp.Countries))
+	for i, country := range resp.Countries {
+		countries[i] = &countryResolver{country}
+	}
+	return countries, nil
+}
+
+func (r *resolver) MedalRanking(ctx context.Context, args struct{ GamesID *graphql.ID }) ([]*medalRankingResolver, error) {
+	if err := authorize(ctx, medalsRanking, "GET"); err != nil {
+		return nil, err
+	}
+	gamesID, err := selectedGames(ctx, args.GamesID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.h.medalClient.GetMedalRanking(ctx, &medalservice.RankingRequest{GamesId: gamesID})
+	if err != nil {
+		return nil, err
+	}
+	ranking := make([]*medalRankingResolver, len(resp.CountryMedalCounts))
+	for i, count := range resp.CountryMedalCounts {
+		ranking[i] = &medalRankingResolver{count}
+	}
+	return ranking, nil
+}
+
+func loadCountry(ctx context.Context, id int64) (*countryResolver, error) {
+	if err := authorize(ctx, countriesGet, "GET"); err != nil {
+		return nil, err
+	}
+	country, err := loadersFrom(ctx).countries.load(ctx, id)
+	if err != nil || country == nil {
+		return nil, err
+	}
+	return &countryResolver{country}, nil
+}
+
+func medalResolvers(medals []*medalservice.Medal) []*medalResolver {
+	resolvers := make([]*medalResolver, len(medals))
+	for i, medal := range medals {
+		resolvers[i] = &medalResolver{medal}
+	}
+	return resolvers
+}
+
+type eventResolver struct {
+	e *eventservice.Event
+}
+
+func (r *eventResolver) ID() graphql.ID       { return toID(r.e.Id) }
+func (r *eventResolver) Name() string         { return r.e.Name }
+func (r *eventResolver) SportType() string    { return r.e.SportType }
+func (r *eventResolver) StartTime() string    { return rfc3339(r.e.StartTime) }
+func (r *eventResolver) EndTime() string      { return rfc3339(r.e.EndTime) }
+func (r *eventResolver) GamesID() *graphql.ID { return optionalID(r.e.GamesId) }
+
+func (r *eventResolver) Medals(ctx context.Context) ([]*medalResolver, error) {
+	if err := authorize(ctx, medalsGetAll, "GET"); err != nil {
+		return nil, err
+	}
+	medals, err := loadersFrom(ctx).medalsByEvent.load(ctx, r.e.Id)
+	if err != nil {
+		return nil, err
+	}
+	return medalResolvers(medals), nil
+}
+
+type medalResolver struct {
+	m *medalservice.Medal
+}
+
+func (r *medalResolver) ID() graphql.ID       { return toID(r.m.Id) }
+func (r *medalResolver) Type() string         { return r.m.Type }
+func (r *medalResolver) CreatedAt() string    { return rfc3339(r.m.CreatedAt) }
+func (r *medalResolver) UpdatedAt() string    { return rfc3339(r.m.UpdatedAt) }
+func (r *medalResolver) GamesID() *graphql.ID { return optionalID(r.m.GamesId) }
+
+func (r *medalResolver) Event(ctx context.Context) (*eventResolver, error) {
+	if err := authorize(ctx, eventsGet, "GET"); err != nil {
+		return nil, err
+	}
+	event, err := loadersFrom(ctx).events.load(ctx, r.m.EventId)
+	if err != nil || event == nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (r *medalResolver) Athlete(ctx context.Context) (*athleteResolver, error) {
+	if err := authorize(ctx, athletesGet, "GET"); err != nil {
+		return nil, err
+	}
+	// Medals reference athletes by a string id; anything that is not an
+	// athlete-service id resolves to null.
+	id, err := strconv.ParseInt(r.m.AthleteId, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	athlete, err := loadersFrom(ctx).athletes.load(ctx, id)
+	if err != nil || athlete == nil {
+		return nil, err
+	}
+	return &athleteResolver{athlete}, nil
+}
+
+func (r *medalResolver) Country(ctx context.Context) (*countryResolver, error) {
+	return loadCountry(ctx, r.m.CountryId)
+}
+
+type athleteResolver struct {
+	a *athleteservice.Athlete
+}
+
+func (r *athleteResolver) ID() graphql.ID       { return toID(r.a.Id) }
+func (r *athleteResolver) Name() string         { return r.a.Name }
+func (r *athleteResolver) SportType() string    { return r.a.SportType }
+func (r *athleteResolver) CreatedAt() string    { return r.a.CreatedAt }
+func (r *athleteResolver) UpdatedAt() string    { return r.a.UpdatedAt }
+func (r *athleteResolver) GamesID() *graphql.ID { return optionalID(r.a.GamesId) }
+
+func (r *athleteResolver) Country(ctx context.Context) (*countryResolver, error) {
+	return loadCountry(ctx, r.a.CountryId)
+}
+
+type countryResolver struct {
+	c *countryservice.Country
+}
+
+func (r *countryResolver) ID() graphql.ID    { return toID(r.c.Id) }
+func (r *countryResolver) Name() string      { return r.c.Name }
+func (r *countryResolver) Flag() string      { return r.c.Flag }
+func (r *countryResolver) CreatedAt() string { return r.c.CreatedAt }
+func (r *countryResolver) UpdatedAt() string { return r.c.UpdatedAt }
+
+func (r *countryResolver) Medals(ctx context.Context) ([]*medalResolver, error) {
+	if err := authorize(ctx, medalsGetAll, "GET"); err != nil {
+		return nil, err
+	}
+	medals, err := loadersFrom(ctx).medalsByCountry.load(ctx, r.c.Id)
+	if err != nil {
+		return nil, err
+	}
+	return medalResolvers(medals), nil
+}
+
+type medalRankingResolver struct {
+	c *medalservice.CountryMedalCount
+}
+
+func (r *medalRankingResolver) Ranking() int32      { return r.c.Ranking }
+func (r *medalRankingResolver) CountryName() string { return r.c.CountryName }
+func (r *medalRankingResolver) Gold() int32         { return r.c.Gold }
+func (r *medalRankingResolver) Silver() int32       { return r.c.Silver }
+func (r *medalRankingResolver) Bronze() int32       { return r.c.Bronze }
+
+func (r *medalRankingResolver) Country(ctx context.Context) (*countryResolver, error) {
+	return loadCountry(ctx, r.c.CountryId)
+}
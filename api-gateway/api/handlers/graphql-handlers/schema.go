@@ -0,0 +1,68 @@
+package graphqlhandlers
+
+const schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	event(id: ID!): Event
+	events(page: Int = 1, limit: Int = 10, search: String, gamesId: ID): [Event!]!
+	medal(id: ID!): Medal
+	medals(page: Int = 1, limit: Int = 10, countryId: ID, eventId: ID, athleteId: ID, gamesId: ID): [Medal!]!
+	athlete(id: ID!): Athlete
+	athletes(page: Int = 1, limit: Int = 10, countryId: ID, sportType: String, gamesId: ID): [Athlete!]!
+	country(id: ID!): Country
+	countries(page: Int = 1, limit: Int = 10): [Country!]!
+	medalRanking(gamesId: ID): [MedalRanking!]!
+}
+
+type Event {
+	id: ID!
+	name: String!
+	sportType: String!
+	startTime: String!
+	endTime: String!
+	gamesId: ID
+	medals: [Medal!]!
+}
+
+type Medal {
+	id: ID!
+	type: String!
+	gamesId: ID
+	createdAt: String!
+	updatedAt: String!
+	event: Event
+	athlete: Athlete
+	country: Country
+}
+
+type Athlete {
+	id: ID!
+	name: String!
+	sportType: String!
+	gamesId: ID
+	createdAt: String!
+	updatedAt: String!
+	country: Country
+}
+
+type Country {
+	id: ID!
+	name: String!
+	flag: String!
+	createdAt: String!
+	updatedAt: String!
+	medals: [Medal!]!
+}
+
+type MedalRanking {
+	ranking: Int!
+	countryName: String!
+	gold: Int!
+	silver: Int!
+	bronze: Int!
+	country: Country
+}
+`
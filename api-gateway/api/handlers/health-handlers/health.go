@@ -0,0 +1,134 @@
+package healthhandlers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/streadway/amqp"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const checkTimeout = 2 * time.Second
+
+// Backend is a downstream gRPC service together with the dependency checks it exposes
+// through grpc.health.v1 (e.g. "postgres", "redis").
+type Backend struct {
+	Name         string
+	Client       healthpb.HealthClient
+	Dependencies []string
+}
+
+type DependencyStatus struct {
+	Status       string            `json:"status"`
+	Error        string            `json:"error,omitempty"`
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+}
+
+type HealthHandlers struct {
+	backends []Backend
+	amqpConn *amqp.Connection
+	logger   *log.Logger
+}
+
+func NewHealthHandlers(backends []Backend, amqpConn *amqp.Connection, logger *log.Logger) *HealthHandlers {
+	return &HealthHandlers{
+		backends: backends,
+		amqpConn: amqpConn,
+		logger:   logger,
+	}
+}
+
+// Healthz is the liveness probe: it only reports that the gateway process is up.
+func (h *HealthHandlers) Healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz is the readiness probe: it aggregates the grpc.health.v1 status of every
+// backend (and of the dependencies they report on) together with RabbitMQ.
+// It answers 503 as soon as one of them is not serving.
+func (h *HealthHandlers) Readyz(ctx *gin.Context) {
+	resp := ReadinessResponse{
+		Status:       "ready",
+		Dependencies: make(map[string]DependencyStatus, len(h.backends)+1),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, backend := range h.backends {
+		wg.Add(1)
+		go func(backend Backend) {
+			defer wg.Done()
+			status := h.checkBackend(ctx.Request.Context(), backend)
+
+			mu.Lock()
+			resp.Dependencies[backend.Name] = status
+			mu.Unlock()
+		}(backend)
+	}
+	wg.Wait()
+
+	resp.Dependencies["rabbitmq"] = h.checkRabbitMQ()
+
+	for _, dependency := range resp.Dependencies {
+		if dependency.Status != healthpb.HealthCheckResponse_SERVING.String() {
+			resp.Status = "not_ready"
+		}
+	}
+
+	if resp.Status != "ready" {
+		ctx.JSON(http.StatusServiceUnavailable, resp)
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *HealthHandlers) checkBackend(ctx context.Context, backend Backend) DependencyStatus {
+	var status DependencyStatus
+
+	serving, err := h.check(ctx, backend.Client, "")
+	status.Status = serving
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	if len(backend.Dependencies) > 0 {
+		status.Dependencies = make(map[string]string, len(backend.Dependencies))
+		for _, dependency := range backend.Dependencies {
+			status.Dependencies[dependency], _ = h.check(ctx, backend.Client, dependency)
+		}
+	}
+	return status
+}
+
+func (h *HealthHandlers) check(ctx context.Context, client healthpb.HealthClient, service string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN.String(), err
+	}
+	return resp.Status.String(), nil
+}
+
+func (h *HealthHandlers) checkRabbitMQ() DependencyStatus {
+	if h.amqpConn == nil || h.amqpConn.IsClosed() {
+		return DependencyStatus{
+			Status: healthpb.HealthCheckResponse_NOT_SERVING.String(),
+			Error:  "connection to RabbitMQ is closed",
+		}
+	}
+	return DependencyStatus{Status: healthpb.HealthCheckResponse_SERVING.String()}
+}
@@ -0,0 +1,66 @@
+package importhandlers
+
+import (
+	"context"
+
+	"olympy/api-gateway/api/middleware/games"
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImportAthletes godoc
+// @Summary Import athletes
+// @Description Imports athletes with the columns name, country and sport_type. country may be the country ID, name or NOC code.
+// @Description The body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.
+// @Tags Athlete
+// @Accept plain
+// @Produce json
+// @Security ApiKeyAuth
+// @Param format query string false "csv or ndjson; defaults to the Content-Type"
+// @Param dry_run query bool false "Check the rows without importing them"
+// @Param mode query string false "atomic (default) or chunked"
+// @Param chunk_size query int false "Rows per transaction in chunked mode"
+// @Param file body string true "CSV or NDJSON data"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Success 200 {object} athleteservice.ImportReport
+// @Failure 400 {object} athleteservice.Message
+// @Failure 422 {object} athleteservice.ImportReport
+// @Failure 500 {object} athleteservice.Message
+// @Router /athletes/import [post]
+func (h *ImportHandlers) ImportAthletes(ctx *gin.Context) {
+	opts, err := parseOptions(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := h.athleteClient.ImportAthletes(c)
+	if err != nil {
+		h.finish(ctx, opts, nil, err)
+		return
+	}
+	err = upload(ctx, func(data []byte, first bool) error {
+		req := &athleteservice.ImportRequest{Data: data}
+		if first {
+			req.Options = &athleteservice.ImportOptions{
+				Format:    opts.format,
+				DryRun:    opts.dryRun,
+				Chunked:   opts.chunked,
+				ChunkSize: opts.chunkSize,
+				GamesId:   games.FromContext(ctx),
+			}
+		}
+		return stream.Send(req)
+	})
+	if err != nil {
+		h.finish(ctx, opts, nil, err)
+		return
+	}
+
+	report, err := stream.CloseAndRecv()
+	h.finish(ctx, opts, report, err)
+}
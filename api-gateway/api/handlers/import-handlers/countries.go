@@ -0,0 +1,63 @@
+package importhandlers
+
+import (
+	"context"
+
+	countryservice "olympy/api-gateway/genproto/country_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImportCountries godoc
+// @Summary Import countries
+// @Description Imports countries with the columns name, flag and noc_code (optional).
+// @Description The body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.
+// @Tags Country
+// @Accept plain
+// @Produce json
+// @Security ApiKeyAuth
+// @Param format query string false "csv or ndjson; defaults to the Content-Type"
+// @Param dry_run query bool false "Check the rows without importing them"
+// @Param mode query string false "atomic (default) or chunked"
+// @Param chunk_size query int false "Rows per transaction in chunked mode"
+// @Param file body string true "CSV or NDJSON data"
+// @Success 200 {object} countryservice.ImportReport
+// @Failure 400 {object} countryservice.Message
+// @Failure 422 {object} countryservice.ImportReport
+// @Failure 500 {object} countryservice.Message
+// @Router /countries/import [post]
+func (h *ImportHandlers) ImportCountries(ctx *gin.Context) {
+	opts, err := parseOptions(ctx)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	c, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := h.countryClient.ImportCountries(c)
+	if err != nil {
+		h.finish(ctx, opts, nil, err)
+		return
+	}
+	err = upload(ctx, func(data []byte, first bool) error {
+		req := &countryservice.ImportRequest{Data: data}
+		if first {
+			req.Options = &countryservice.ImportOptions{
+				Format:    opts.format,
+				DryRun:    opts.dryRun,
+				Chunked:   opts.chunked,
+				ChunkSize: opts.chunkSize,
+			}
+		}
+		return stream.Send(req)
+	})
+	if err != nil {
+		h.finish(ctx, opts, nil, err)
+		return
+	}
+
+	report, err := stream.CloseAndRecv()
+	h.finish(ctx, opts, report, err)
+}
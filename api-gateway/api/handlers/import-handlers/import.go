@@ -0,0 +1,153 @@
+package importhandlers
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBodySize caps an uploaded file; chunkSize is the amount of data
+	// sent per stream message.
+	maxBodySize = 64 << 20
+	chunkSize   = 64 << 10
+)
+
+// ImportHandlers stream CSV or NDJSON uploads to the backends' Import RPCs.
+type ImportHandlers struct {
+	eventClient   eventservice.EventServiceClient
+	medalClient   medalservice.MedalServiceClient
+	athleteClient athleteservice.AthleteServiceClient
+	countryClient countryservice.CountryServiceClient
+	logger        *log.Logger
+}
+
+func NewImportHandlers(
+	eventClient eventservice.EventServiceClient,
+	medalClient medalservice.MedalServiceClient,
+	athleteClient athleteservice.AthleteServiceClient,
+	countryClient countryservice.CountryServiceClient,
+	logger *log.Logger,
+) *ImportHandlers {
+	return &ImportHandlers{
+		eventClient:   eventClient,
+		medalClient:   medalClient,
+		athleteClient: athleteClient,
+		countryClient: countryClient,
+		logger:        logger,
+	}
+}
+
+type options struct {
+	format    string
+	dryRun    bool
+	chunked   bool
+	chunkSize int32
+}
+
+// parseOptions reads the import options from the query string. The format
+// defaults to the one implied by the Content-Type header.
+func parseOptions(ctx *gin.Context) (options, error) {
+	opts := options{format: strings.ToLower(ctx.Query("format"))}
+	if opts.format == "" {
+		switch ctx.ContentType() {
+		case "application/x-ndjson", "application/jsonl", "application/json":
+			opts.format = "ndjson"
+		default:
+			opts.format = "csv"
+		}
+	}
+	if opts.format != "csv" && opts.format != "ndjson" {
+		return opts, errors.New("format must be csv or ndjson")
+	}
+
+	if v := ctx.Query("dry_run"); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, errors.New("dry_run must be true or false")
+		}
+		opts.dryRun = dryRun
+	}
+
+	switch mode := ctx.DefaultQuery("mode", "atomic"); mode {
+	case "atomic":
+	case "chunked":
+		opts.chunked = true
+	default:
+		return opts, errors.New("mode must be atomic or chunked")
+	}
+
+	if v := ctx.Query("chunk_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			return opts, errors.New("chunk_size must be a positive integer")
+		}
+		opts.chunkSize = int32(size)
+	}
+	return opts, nil
+}
+
+// upload sends the request body in chunks through send. The first call
+// carries the options; it is made even for an empty body. Sending stops
+// early when the server ends the stream (send returns io.EOF); the outcome
+// is then reported by CloseAndRecv.
+func upload(ctx *gin.Context, send func(data []byte, first bool) error) error {
+	body := http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxBodySize)
+	buf := make([]byte, chunkSize)
+	first := true
+	for {
+		n, err := io.ReadFull(body, buf)
+		if n > 0 || first {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			if sendErr := send(data, first); sendErr == io.EOF {
+				return nil
+			} else if sendErr != nil {
+				return sendErr
+			}
+			first = false
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// finish sends the result of an import. An all-or-nothing import that was
+// rejected because of invalid rows is answered with 422.
+func (h *ImportHandlers) finish(ctx *gin.Context, opts options, report interface{ GetFailed() int32 }, err error) {
+	if err != nil {
+		h.logger.Println(err)
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			ctx.IndentedJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "import file is too large"})
+		case status.Code(err) == codes.InvalidArgument:
+			ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		default:
+			ctx.IndentedJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	code := http.StatusOK
+	if !opts.dryRun && !opts.chunked && report.GetFailed() > 0 {
+		code = http.StatusUnprocessableEntity
+	}
+	ctx.IndentedJSON(code, report)
+}
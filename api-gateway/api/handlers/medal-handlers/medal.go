@@ -2,10 +2,15 @@ package eventhandlers
 
 import (
 	"log"
+	"olympy/api-gateway/api/middleware/games"
+	"olympy/api-gateway/api/middleware/timezone"
 	medalservice "olympy/api-gateway/genproto/medal_service"
+	"olympy/api-gateway/internal/pkg/fields"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type MedalHandlers struct {
@@ -20,6 +25,15 @@ func NewMedalHandlers(client medalservice.MedalServiceClient, logger *log.Logger
 	}
 }
 
+// respondError answers unknown fields and invalid page tokens with 400.
+func respondError(ctx *gin.Context, err error) {
+	if status.Code(err) == codes.InvalidArgument {
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+}
+
 // AddMedal godoc
 // @Summary Add a medal
 // @Description This endpoint adds a new medal.
@@ -28,8 +42,11 @@ func NewMedalHandlers(client medalservice.MedalServiceClient, logger *log.Logger
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body medalservice.Medal true "Medal details to add"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} medalservice.Medal
 // @Failure 400 {object} medalservice.Message
+// @Failure 422 {string} string "Idempotency-Key reused with a different payload"
 // @Failure 500 {object} medalservice.Message
 // @Router /medals/add [post]
 func (m *MedalHandlers) AddMedal(ctx *gin.Context) {
@@ -46,6 +63,7 @@ func (m *MedalHandlers) AddMedal(ctx *gin.Context) {
 		return
 	}
 
+	timezone.Apply(ctx, resp)
 	ctx.IndentedJSON(200, resp)
 }
 
@@ -57,6 +75,7 @@ func (m *MedalHandlers) AddMedal(ctx *gin.Context) {
 // @Produce json
 // @Security ApiKeyAuth
 // @Param request body medalservice.Medal true "Medal details to edit"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} medalservice.Medal
 // @Failure 400 {object} medalservice.Message
 // @Failure 500 {object} medalservice.Message
@@ -75,6 +94,7 @@ func (m *MedalHandlers) EditMedal(ctx *gin.Context) {
 		return
 	}
 
+	timezone.Apply(ctx, resp)
 	ctx.IndentedJSON(200, resp)
 }
 
@@ -117,6 +137,8 @@ func (m *MedalHandlers) DeleteMedal(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id query string true "Medal ID to retrieve"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} medalservice.Medal
 // @Failure 400 {object} medalservice.Message
 // @Failure 500 {object} medalservice.Message
@@ -130,15 +152,16 @@ func (m *MedalHandlers) GetMedal(ctx *gin.Context) {
 		return
 	}
 
-	req := &medalservice.GetSingleRequest{Id: id}
+	req := &medalservice.GetSingleRequest{Id: id, Fields: fields.Mask(ctx)}
 
 	resp, err := m.client.GetMedal(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
 }
 
 // ListMedals godoc
@@ -152,6 +175,10 @@ func (m *MedalHandlers) GetMedal(ctx *gin.Context) {
 // @Param country query int64 false "Country ID"
 // @Param event_id query int64 false "Event ID"
 // @Param athlete_id query string false "Athlete ID"
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Param fields query string false "Fields to return, comma separated; all when empty"
+// @Param tz query string false "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty"
 // @Success 200 {object} medalservice.ListResponse
 // @Failure 400 {object} medalservice.Message
 // @Failure 500 {object} medalservice.Message
@@ -199,15 +226,19 @@ func (m *MedalHandlers) ListMedals(ctx *gin.Context) {
 		Country:   country,
 		EventId:   eventId,
 		AthleteId: athleteId,
+		GamesId:   games.FromContext(ctx),
+		Fields:    fields.Mask(ctx),
+		PageToken: ctx.Query("page_token"),
 	}
 
 	resp, err := m.client.ListMedals(ctx, req)
 	if err != nil {
-		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		respondError(ctx, err)
 		return
 	}
 
-	ctx.IndentedJSON(200, resp)
+	timezone.Apply(ctx, resp)
+	fields.Respond(ctx, resp)
 }
 
 // GetMedalRanking godoc
@@ -216,11 +247,13 @@ func (m *MedalHandlers) ListMedals(ctx *gin.Context) {
 // @Tags Medal
 // @Accept json
 // @Produce json
+// @Param games_id query int64 false "Olympic Games edition ID"
+// @Param Accept-Language header string false "Preferred languages of names, e.g. fr-CA, ar;q=0.5"
 // @Success 200 {object} medalservice.MedalRankingResponse
 // @Failure 500 {object} medalservice.Message
 // @Router /medals/ranking [get]
 func (m *MedalHandlers) GetMedalRanking(ctx *gin.Context) {
-	resp, err := m.client.GetMedalRanking(ctx, &medalservice.Empty{})
+	resp, err := m.client.GetMedalRanking(ctx, &medalservice.RankingRequest{GamesId: games.FromContext(ctx)})
 	if err != nil {
 		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
 		return
@@ -0,0 +1,123 @@
+package streamhandlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	streamingservice "olympy/api-gateway/genproto/stream_service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EventSSE godoc
+// @Summary Follow an event's live commentary
+// @Description Relays the commentary of an event as Server-Sent Events. Each message is a "commentary" event whose id is the commentary ID and whose data is the commentary as JSON.
+// @Description Reconnecting clients resume after the Last-Event-ID header (or the last_event_id query parameter) from the stored history. Keep-alive comments are sent while the event is quiet.
+// @Description EventSource cannot set headers, so the token may also be passed as the access_token query parameter.
+// @Tags Live Streaming
+// @Produce text/event-stream
+// @Security ApiKeyAuth
+// @Param event_id path string true "Event ID"
+// @Param Last-Event-ID header string false "Commentary ID to resume after"
+// @Param last_event_id query string false "Commentary ID to resume after"
+// @Param access_token query string false "Token, for clients that cannot set the Authorization header"
+// @Success 200 {object} streamingservice.Commentary
+// @Failure 400 {object} streamingservice.StreamEventResponse
+// @Failure 500 {object} streamingservice.StreamEventResponse
+// @Router /stream/events/{event_id}/sse [get]
+func (s *StreamHandlers) EventSSE(ctx *gin.Context) {
+	lastID := ctx.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = ctx.Query("last_event_id")
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.client.SubscribeEvent(subCtx, &streamingservice.SubscribeEventRequest{
+		EventId: ctx.Param("event_id"),
+		LastId:  lastID,
+	})
+	if err == nil {
+		// The service sends its headers once the subscription is live;
+		// without them the call failed and Recv returns the status.
+		var md map[string][]string
+		md, err = stream.Header()
+		if err == nil && md == nil {
+			_, err = stream.Recv()
+		}
+	}
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+			return
+		}
+		s.logger.Println("Error calling SubscribeEvent:", err)
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(200)
+	fmt.Fprint(ctx.Writer, "retry: 3000\n\n")
+	ctx.Writer.Flush()
+
+	messages := make(chan *streamingservice.Commentary)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case messages <- msg:
+			case <-subCtx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(s.keepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case <-s.closing:
+			// EventSource reconnects after the retry delay, to another
+			// gateway or to this one once it is back.
+			return
+		case msg := <-messages:
+			data, err := json.Marshal(msg)
+			if err != nil {
+				s.logger.Println("Error encoding commentary:", err)
+				return
+			}
+			if _, err := fmt.Fprintf(ctx.Writer, "id: %s\nevent: commentary\ndata: %s\n\n", msg.Id, data); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		case err := <-errs:
+			if err != io.EOF && status.Code(err) != codes.Canceled {
+				s.logger.Println("Commentary stream ended:", err)
+			}
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(ctx.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		}
+	}
+}
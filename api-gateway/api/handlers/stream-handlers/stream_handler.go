@@ -1,32 +1,49 @@
 package streamhandlers
 
 import (
-    "log"
-    "net/http"
+	"crypto/tls"
+	"log"
+	"net/http"
+	"sync"
+	"time"
 
-    streamingservice "olympy/api-gateway/genproto/stream_service"
+	streamingservice "olympy/api-gateway/genproto/stream_service"
+	"olympy/pkg/identity"
 
-    "github.com/gin-gonic/gin"
-    "github.com/k0kubun/pp"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 )
 
 type StreamHandlers struct {
-    client streamingservice.StreamingServiceClient
-    logger *log.Logger
+	client       streamingservice.StreamingServiceClient
+	wsURL        string
+	dialer       *websocket.Dialer
+	signer       *identity.Signer
+	keepAlive    time.Duration
+	pingInterval time.Duration
+	closing      chan struct{}
+	closeOnce    sync.Once
+	logger       *log.Logger
 }
 
-func NewStreamHandlers(client streamingservice.StreamingServiceClient, logger *log.Logger) *StreamHandlers {
-    return &StreamHandlers{
-        client: client,
-        logger: logger,
-    }
+// NewStreamHandlers proxies WebSockets to wsURL, a wss:// URL, presenting the
+// client certificate of wsTLS.
+func NewStreamHandlers(client streamingservice.StreamingServiceClient, wsURL string, wsTLS *tls.Config, signer *identity.Signer, keepAlive, pingInterval time.Duration, logger *log.Logger) *StreamHandlers {
 	if client == nil {
 		logger.Fatal("Client is nil during initialization") // Use Fatal to terminate the application if the client is nil
 	}
 	return &StreamHandlers{
-		client: client,
-		logger: logger,
+		client:       client,
+		wsURL:        wsURL,
+		signer:       signer,
+		keepAlive:    keepAlive,
+		pingInterval: pingInterval,
+		closing:      make(chan struct{}),
+		logger:       logger,
+		dialer: &websocket.Dialer{
+			HandshakeTimeout: websocket.DefaultDialer.HandshakeTimeout,
+			TLSClientConfig:  wsTLS,
+		},
 	}
 }
 
@@ -43,35 +60,29 @@ func NewStreamHandlers(client streamingservice.StreamingServiceClient, logger *l
 // @Failure 500 {object} streamingservice.StreamEventResponse
 // @Router /stream/send [post]
 func (s *StreamHandlers) SendEvent(ctx *gin.Context) {
-    var req streamingservice.StreamEventRequest
-    if err := ctx.ShouldBindJSON(&req); err != nil {
-        ctx.IndentedJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-        return
-    }
 	var req streamingservice.StreamEventRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-    // Debugging logs
-    s.logger.Println("StreamHandlers struct:", s)
-    s.logger.Printf("StreamHandlers.client is nil: %v\n", s.client == nil)
+	// Debugging logs
+	s.logger.Println("StreamHandlers struct:", s)
+	s.logger.Printf("StreamHandlers.client is nil: %v\n", s.client == nil)
 
-    if s.client == nil {
-        s.logger.Println("StreamHandlers.client is nil, aborting.")
-        ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error: client is not initialized"})
-        return
-    }
+	if s.client == nil {
+		s.logger.Println("StreamHandlers.client is nil, aborting.")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error: client is not initialized"})
+		return
+	}
 
-    pp.Println(req)
-    resp, err := s.client.StreamEvent(ctx, &req)
-    if err != nil {
-        s.logger.Println("Error calling StreamEvent:", err)
-        ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-        return
-    }
+	resp, err := s.client.StreamEvent(ctx, &req)
+	if err != nil {
+		s.logger.Println("Error calling StreamEvent:", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
-    s.logger.Println("StreamEvent successful")
-    ctx.JSON(http.StatusOK, resp)
+	s.logger.Println("StreamEvent successful")
+	ctx.JSON(http.StatusOK, resp)
 }
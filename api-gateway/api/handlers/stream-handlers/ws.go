@@ -0,0 +1,181 @@
+package streamhandlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"olympy/api-gateway/api/middleware/casbin"
+	"olympy/pkg/identity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// Protocol is the WebSocket subprotocol the gateway speaks. Browsers that
+// authenticate with a "bearer.<token>" subprotocol must offer it too, since
+// the token itself is never echoed back.
+const Protocol = "olympy.v1"
+
+const writeWait = 10 * time.Second
+
+var upgrader = websocket.Upgrader{Subprotocols: []string{Protocol}}
+
+// Objects checked with casbin for every subscription, with the action
+// SUBSCRIBE: one event, or all of them when no event is named.
+const (
+	allEventsObject = "/api/v1/stream/ws/events"
+	eventObject     = "/api/v1/stream/ws/events/"
+)
+
+// StreamWS godoc
+// @Summary Follow live commentary over WebSocket
+// @Description Proxies the streaming service's WebSocket. Each message is a commentary as JSON: {"id", "event_id", "text", "timestamp", "status_change"}.
+// @Description Subscribe to events with repeated event_id parameters; without any, the connection receives every event, which only admins may do. Each event is authorized separately.
+// @Description Browsers cannot set headers on the handshake, so the token may instead be offered as a "bearer.<token>" subprotocol, alongside "olympy.v1".
+// @Tags Live Streaming
+// @Security ApiKeyAuth
+// @Param event_id query []string false "Event IDs to follow" collectionFormat(multi)
+// @Success 101 "Switching Protocols"
+// @Failure 403 {object} map[string]string
+// @Failure 502 {object} map[string]string
+// @Router /stream/ws [get]
+func (s *StreamHandlers) StreamWS(ctx *gin.Context) {
+	eventIDs := ctx.QueryArray("event_id")
+	objects := []string{allEventsObject}
+	if len(eventIDs) > 0 {
+		objects = objects[:0]
+		for _, id := range eventIDs {
+			objects = append(objects, eventObject+url.PathEscape(id))
+		}
+	}
+	role := ctx.GetString(casbin.RoleKey)
+	for _, obj := range objects {
+		ok, err := casbin.Enforce(role, obj, "SUBSCRIBE")
+		if err != nil {
+			s.logger.Println("Error enforcing stream policy:", err)
+			ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		if !ok {
+			ctx.IndentedJSON(403, gin.H{"error": "permission denied for " + obj})
+			return
+		}
+	}
+
+	target, err := url.Parse(s.wsURL)
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	target.RawQuery = url.Values{"event_id": eventIDs}.Encode()
+
+	// The streaming service only trusts the identity the gateway signed.
+	id, _ := identity.FromContext(ctx.Request.Context())
+	signed, err := s.signer.Sign(id)
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	header := http.Header{}
+	header.Set(identity.Key, signed)
+
+	backend, resp, err := s.dialer.DialContext(ctx, target.String(), header)
+	if err != nil {
+		if resp != nil {
+			err = errors.New("streaming service responded with " + resp.Status)
+		}
+		s.logger.Println("Error dialing streaming service:", err)
+		ctx.IndentedJSON(502, gin.H{"error": err.Error()})
+		return
+	}
+	defer backend.Close()
+
+	client, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// The upgrader has already answered the client.
+		return
+	}
+	defer client.Close()
+
+	s.proxy(client, backend)
+}
+
+// proxy relays messages both ways until either side closes, then passes the
+// close code on to the other side. The client is pinged every pingInterval
+// and dropped when it stops answering; pings from either side are answered
+// by the connection's default handler.
+func (s *StreamHandlers) proxy(client, backend *websocket.Conn) {
+	client.SetReadDeadline(time.Now().Add(2 * s.pingInterval))
+	client.SetPongHandler(func(string) error {
+		return client.SetReadDeadline(time.Now().Add(2 * s.pingInterval))
+	})
+
+	fromBackend := make(chan error, 1)
+	fromClient := make(chan error, 1)
+	go func() { fromBackend <- relay(client, backend) }()
+	go func() { fromClient <- relay(backend, client) }()
+
+	ticker := time.NewTicker(s.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-fromBackend:
+			client.WriteControl(websocket.CloseMessage, closeMessage(err), time.Now().Add(writeWait))
+			return
+		case err := <-fromClient:
+			backend.WriteControl(websocket.CloseMessage, closeMessage(err), time.Now().Add(writeWait))
+			return
+		case <-s.closing:
+			msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "gateway shutting down")
+			client.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait))
+			backend.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(writeWait))
+			return
+		case <-ticker.C:
+			if err := client.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// relay copies messages from src to dst until reading or writing fails.
+func relay(dst, src *websocket.Conn) error {
+	for {
+		messageType, data, err := src.ReadMessage()
+		if err != nil {
+			return err
+		}
+		dst.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := dst.WriteMessage(messageType, data); err != nil {
+			return err
+		}
+	}
+}
+
+// closeMessage forwards the close code a peer sent. Codes that must not be
+// sent on the wire, and plain connection errors, become "going away".
+func closeMessage(err error) []byte {
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		switch closeErr.Code {
+		case websocket.CloseNoStatusReceived:
+			return websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		case websocket.CloseAbnormalClosure, websocket.CloseTLSHandshake:
+		default:
+			return websocket.FormatCloseMessage(closeErr.Code, closeErr.Text)
+		}
+	}
+	return websocket.FormatCloseMessage(websocket.CloseGoingAway, "")
+}
+
+// Close ends the open streams: WebSocket proxies get a going-away close
+// frame and SSE streams return. Hijacked connections are not tracked by
+// http.Server and SSE requests never finish, so neither is covered by its
+// graceful shutdown.
+func (s *StreamHandlers) Close() error {
+	s.closeOnce.Do(func() { close(s.closing) })
+	return nil
+}
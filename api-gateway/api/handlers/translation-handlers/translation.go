@@ -0,0 +1,143 @@
+package translationhandlers
+
+import (
+	"log"
+	translationservice "olympy/api-gateway/genproto/translation_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TranslationHandlers struct {
+	client translationservice.TranslationServiceClient
+	logger *log.Logger
+}
+
+func NewTranslationHandlers(client translationservice.TranslationServiceClient, logger *log.Logger) *TranslationHandlers {
+	return &TranslationHandlers{
+		client: client,
+		logger: logger,
+	}
+}
+
+// respondError answers validation errors with 400 and translations of
+// missing countries or events with 404.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		ctx.IndentedJSON(404, gin.H{"error": status.Convert(err).Message()})
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+// SetTranslation godoc
+// @Summary Set a translation
+// @Description Adds or replaces the name of a country, event or sport in a locale. The key is the country or event ID, or the English sport type. Locales are BCP 47 tags such as "fr" or "fr-CA" and are stored lower-case.
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body translationservice.Translation true "Translation to set"
+// @Success 200 {object} translationservice.Translation
+// @Failure 400 {object} translationservice.Message
+// @Failure 404 {object} translationservice.Message
+// @Failure 500 {object} translationservice.Message
+// @Router /translations/set [put]
+func (t *TranslationHandlers) SetTranslation(ctx *gin.Context) {
+	var req translationservice.Translation
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := t.client.SetTranslation(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteTranslation godoc
+// @Summary Delete a translation
+// @Description Deletes the name of a country, event or sport in a locale; responses fall back to the next locale.
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param entity query string true "country, event or sport"
+// @Param key query string true "Country or event ID, or sport type"
+// @Param locale query string true "Locale, e.g. fr"
+// @Success 200 {object} translationservice.Message
+// @Failure 400 {object} translationservice.Message
+// @Failure 500 {object} translationservice.Message
+// @Router /translations/delete [delete]
+func (t *TranslationHandlers) DeleteTranslation(ctx *gin.Context) {
+	req := &translationservice.TranslationKey{
+		Entity: ctx.Query("entity"),
+		Key:    ctx.Query("key"),
+		Locale: ctx.Query("locale"),
+	}
+
+	resp, err := t.client.DeleteTranslation(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListTranslations godoc
+// @Summary List translations
+// @Description Retrieves the translations of countries, events or sports with pagination.
+// @Tags Translation
+// @Accept json
+// @Produce json
+// @Param entity query string true "country, event or sport"
+// @Param key query string false "Country or event ID, or sport type"
+// @Param locale query string false "Locale, e.g. fr"
+// @Param page query int32 false "Page number" default(1)
+// @Param limit query int32 false "Number of items per page" default(10)
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} translationservice.ListResponse
+// @Failure 400 {object} translationservice.Message
+// @Failure 500 {object} translationservice.Message
+// @Router /translations/getall [get]
+func (t *TranslationHandlers) ListTranslations(ctx *gin.Context) {
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid limit number"})
+		return
+	}
+
+	req := &translationservice.ListRequest{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Entity:    ctx.Query("entity"),
+		Key:       ctx.Query("key"),
+		Locale:    ctx.Query("locale"),
+		PageToken: ctx.Query("page_token"),
+	}
+
+	resp, err := t.client.ListTranslations(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
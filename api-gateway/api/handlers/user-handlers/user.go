@@ -0,0 +1,154 @@
+package userhandlers
+
+import (
+	"log"
+	authservice "olympy/api-gateway/genproto/auth_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserHandlers struct {
+	client authservice.AuthServiceClient
+	logger *log.Logger
+}
+
+func NewUserHandlers(client authservice.AuthServiceClient, logger *log.Logger) *UserHandlers {
+	return &UserHandlers{
+		client: client,
+		logger: logger,
+	}
+}
+
+// respondError answers validation errors of the auth service with 400 and
+// unknown users with 404.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.NotFound:
+		ctx.IndentedJSON(404, gin.H{"error": status.Convert(err).Message()})
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description Lists the registered users with their roles, ordered by ID.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int false "Page number"
+// @Param limit query int false "Number of users per page"
+// @Param role query string false "Only users with this role (user or admin)"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} authservice.ListUsersResponse
+// @Failure 400 {object} authservice.DeleteUserResponse
+// @Failure 500 {object} authservice.DeleteUserResponse
+// @Router /users/getall [get]
+func (u *UserHandlers) ListUsers(ctx *gin.Context) {
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid limit number"})
+		return
+	}
+
+	resp, err := u.client.ListUsers(ctx, &authservice.ListUsersRequest{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Role:      ctx.Query("role"),
+		PageToken: ctx.Query("page_token"),
+	})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// GetUser godoc
+// @Summary Get a user
+// @Description Retrieves a user and their role by ID.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "User ID"
+// @Success 200 {object} authservice.User
+// @Failure 400 {object} authservice.DeleteUserResponse
+// @Failure 404 {object} authservice.DeleteUserResponse
+// @Failure 500 {object} authservice.DeleteUserResponse
+// @Router /users/get [get]
+func (u *UserHandlers) GetUser(ctx *gin.Context) {
+	resp, err := u.client.GetUser(ctx, &authservice.GetUserRequest{Id: ctx.Query("id")})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// SetUserRole godoc
+// @Summary Change a user's role
+// @Description Sets the role of a user to user or admin. Tokens issued before keep the old role until they expire.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body authservice.SetUserRoleRequest true "User ID and new role"
+// @Success 200 {object} authservice.User
+// @Failure 400 {object} authservice.DeleteUserResponse
+// @Failure 404 {object} authservice.DeleteUserResponse
+// @Failure 500 {object} authservice.DeleteUserResponse
+// @Router /users/role [put]
+func (u *UserHandlers) SetUserRole(ctx *gin.Context) {
+	var req authservice.SetUserRoleRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := u.client.SetUserRole(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteUser godoc
+// @Summary Delete a user
+// @Description Deletes a user by ID.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "User ID to delete"
+// @Success 200 {object} authservice.DeleteUserResponse
+// @Failure 400 {object} authservice.DeleteUserResponse
+// @Failure 404 {object} authservice.DeleteUserResponse
+// @Failure 500 {object} authservice.DeleteUserResponse
+// @Router /users/delete [delete]
+func (u *UserHandlers) DeleteUser(ctx *gin.Context) {
+	resp, err := u.client.DeleteUser(ctx, &authservice.GetUserRequest{Id: ctx.Query("id")})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
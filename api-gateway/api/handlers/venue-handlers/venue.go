@@ -0,0 +1,204 @@
+package venuehandlers
+
+import (
+	"log"
+	venueservice "olympy/api-gateway/genproto/venue_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type VenueHandlers struct {
+	client venueservice.VenueServiceClient
+	logger *log.Logger
+}
+
+func NewVenueHandlers(client venueservice.VenueServiceClient, logger *log.Logger) *VenueHandlers {
+	return &VenueHandlers{
+		client: client,
+		logger: logger,
+	}
+}
+
+// respondError answers validation errors with 400, and duplicate venues or
+// venues still in use with 409.
+func respondError(ctx *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		ctx.IndentedJSON(409, gin.H{"error": status.Convert(err).Message()})
+	default:
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+	}
+}
+
+func queryID(ctx *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return 0, false
+	}
+	return id, true
+}
+
+// AddVenue godoc
+// @Summary Add a venue
+// @Description Adds a venue. The timezone is an IANA time zone such as Europe/Paris; sports lists the sport types the venue can host, any when empty. Only one venue of a name may exist per city.
+// @Tags Venue
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body venueservice.Venue true "Venue details to add"
+// @Success 200 {object} venueservice.Venue
+// @Failure 400 {object} venueservice.Message
+// @Failure 409 {object} venueservice.Message
+// @Failure 500 {object} venueservice.Message
+// @Router /venues/add [post]
+func (v *VenueHandlers) AddVenue(ctx *gin.Context) {
+	var req venueservice.Venue
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := v.client.AddVenue(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// EditVenue godoc
+// @Summary Edit a venue
+// @Description Replaces a venue's details.
+// @Tags Venue
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body venueservice.Venue true "Venue details to edit"
+// @Success 200 {object} venueservice.Venue
+// @Failure 400 {object} venueservice.Message
+// @Failure 409 {object} venueservice.Message
+// @Failure 500 {object} venueservice.Message
+// @Router /venues/edit [put]
+func (v *VenueHandlers) EditVenue(ctx *gin.Context) {
+	var req venueservice.Venue
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := v.client.EditVenue(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteVenue godoc
+// @Summary Delete a venue
+// @Description Deletes a venue. Venues that events are held at cannot be deleted.
+// @Tags Venue
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Venue ID to delete"
+// @Success 200 {object} venueservice.Message
+// @Failure 400 {object} venueservice.Message
+// @Failure 409 {object} venueservice.Message
+// @Failure 500 {object} venueservice.Message
+// @Router /venues/delete [delete]
+func (v *VenueHandlers) DeleteVenue(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := v.client.DeleteVenue(ctx, &venueservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// GetVenue godoc
+// @Summary Get a venue
+// @Description Retrieves a venue by its ID.
+// @Tags Venue
+// @Accept json
+// @Produce json
+// @Param id query string true "Venue ID"
+// @Success 200 {object} venueservice.Venue
+// @Failure 400 {object} venueservice.Message
+// @Failure 500 {object} venueservice.Message
+// @Router /venues/get [get]
+func (v *VenueHandlers) GetVenue(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := v.client.GetVenue(ctx, &venueservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListVenues godoc
+// @Summary List venues
+// @Description Retrieves the venues by name, with pagination.
+// @Tags Venue
+// @Accept json
+// @Produce json
+// @Param page query int32 false "Page number" default(1)
+// @Param limit query int32 false "Number of items per page" default(10)
+// @Param city query string false "City"
+// @Param sport query string false "Sport type the venues can host"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} venueservice.ListResponse
+// @Failure 400 {object} venueservice.Message
+// @Failure 500 {object} venueservice.Message
+// @Router /venues/getall [get]
+func (v *VenueHandlers) ListVenues(ctx *gin.Context) {
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid limit number"})
+		return
+	}
+
+	req := &venueservice.ListRequest{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		City:      ctx.Query("city"),
+		Sport:     ctx.Query("sport"),
+		PageToken: ctx.Query("page_token"),
+	}
+
+	resp, err := v.client.ListVenues(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
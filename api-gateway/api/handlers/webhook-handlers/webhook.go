@@ -0,0 +1,306 @@
+package webhookhandlers
+
+import (
+	"log"
+	webhookservice "olympy/api-gateway/genproto/webhook_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WebhookHandlers struct {
+	client webhookservice.WebhookServiceClient
+	logger *log.Logger
+}
+
+func NewWebhookHandlers(client webhookservice.WebhookServiceClient, logger *log.Logger) *WebhookHandlers {
+	return &WebhookHandlers{
+		client: client,
+		logger: logger,
+	}
+}
+
+// respondError answers validation errors of the webhook service with 400.
+func respondError(ctx *gin.Context, err error) {
+	if status.Code(err) == codes.InvalidArgument {
+		ctx.IndentedJSON(400, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+}
+
+func queryID(ctx *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(ctx.Query("id"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid ID format"})
+		return 0, false
+	}
+	return id, true
+}
+
+// AddWebhook godoc
+// @Summary Register a webhook
+// @Description Registers a partner endpoint. event_types filters the notifications (medal.created, medal.updated, medal.deleted, event.updated, event.rescheduled, event.status_changed); an empty list subscribes to all of them.
+// @Description The signing secret is generated when empty. It is only returned by this endpoint and by edits that set a new one.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body webhookservice.Webhook true "Webhook details to add"
+// @Success 200 {object} webhookservice.Webhook
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/add [post]
+func (w *WebhookHandlers) AddWebhook(ctx *gin.Context) {
+	var req webhookservice.Webhook
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.client.AddWebhook(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// EditWebhook godoc
+// @Summary Edit a webhook
+// @Description Replaces a webhook's settings. The secret is kept when empty.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body webhookservice.Webhook true "Webhook details to edit"
+// @Success 200 {object} webhookservice.Webhook
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/edit [put]
+func (w *WebhookHandlers) EditWebhook(ctx *gin.Context) {
+	var req webhookservice.Webhook
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.client.EditWebhook(ctx, &req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Deletes a webhook together with its deliveries.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Webhook ID to delete"
+// @Success 200 {object} webhookservice.Message
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/delete [delete]
+func (w *WebhookHandlers) DeleteWebhook(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := w.client.DeleteWebhook(ctx, &webhookservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook
+// @Description Retrieves a webhook by its ID, without its secret.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Webhook ID"
+// @Success 200 {object} webhookservice.Webhook
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/get [get]
+func (w *WebhookHandlers) GetWebhook(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := w.client.GetWebhook(ctx, &webhookservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description Retrieves all webhooks with pagination, without their secrets.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int32 false "Page number" default(1)
+// @Param limit query int32 false "Number of items per page" default(10)
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} webhookservice.ListResponse
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/getall [get]
+func (w *WebhookHandlers) ListWebhooks(ctx *gin.Context) {
+	page, limit, ok := pagination(ctx)
+	if !ok {
+		return
+	}
+
+	req := &webhookservice.ListRequest{
+		Page:      page,
+		Limit:     limit,
+		PageToken: ctx.Query("page_token"),
+	}
+
+	resp, err := w.client.ListWebhooks(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Retrieves the delivery log, newest first.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int32 false "Page number" default(1)
+// @Param limit query int32 false "Number of items per page" default(10)
+// @Param webhook_id query int64 false "Webhook ID"
+// @Param status query string false "pending, succeeded or failed"
+// @Param event_type query string false "Event type, e.g. medal.created"
+// @Param page_token query string false "Token from next_page_token of the previous page; overrides page"
+// @Success 200 {object} webhookservice.ListDeliveriesResponse
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/deliveries [get]
+func (w *WebhookHandlers) ListDeliveries(ctx *gin.Context) {
+	page, limit, ok := pagination(ctx)
+	if !ok {
+		return
+	}
+
+	req := &webhookservice.ListDeliveriesRequest{
+		Page:      page,
+		Limit:     limit,
+		Status:    ctx.Query("status"),
+		EventType: ctx.Query("event_type"),
+		PageToken: ctx.Query("page_token"),
+	}
+	if webhookIdStr := ctx.Query("webhook_id"); webhookIdStr != "" {
+		webhookId, err := strconv.ParseInt(webhookIdStr, 10, 64)
+		if err != nil {
+			ctx.IndentedJSON(400, gin.H{"error": "Invalid webhook ID"})
+			return
+		}
+		req.WebhookId = webhookId
+	}
+
+	resp, err := w.client.ListDeliveries(ctx, req)
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// GetDelivery godoc
+// @Summary Get a webhook delivery
+// @Description Retrieves a delivery with its payload and the log of every attempt.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Delivery ID"
+// @Success 200 {object} webhookservice.Delivery
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/deliveries/get [get]
+func (w *WebhookHandlers) GetDelivery(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := w.client.GetDelivery(ctx, &webhookservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook
+// @Description Queues a delivery again right away, with a fresh set of retries, whatever its status.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id query string true "Delivery ID"
+// @Success 200 {object} webhookservice.Delivery
+// @Failure 400 {object} webhookservice.Message
+// @Failure 500 {object} webhookservice.Message
+// @Router /webhooks/deliveries/redeliver [post]
+func (w *WebhookHandlers) Redeliver(ctx *gin.Context) {
+	id, ok := queryID(ctx)
+	if !ok {
+		return
+	}
+
+	resp, err := w.client.Redeliver(ctx, &webhookservice.GetSingleRequest{Id: id})
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(200, resp)
+}
+
+func pagination(ctx *gin.Context) (int32, int32, bool) {
+	page, err := strconv.ParseInt(ctx.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid page number"})
+		return 0, 0, false
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		ctx.IndentedJSON(400, gin.H{"error": "Invalid limit number"})
+		return 0, 0, false
+	}
+	return int32(page), int32(limit), true
+}
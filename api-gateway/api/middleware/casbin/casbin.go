@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"olympy/api-gateway/api/models/model_common"
 	"olympy/api-gateway/config"
-	"olympy/api-gateway/internal/pkg/logger"
 	jwt "olympy/api-gateway/internal/pkg/tokens"
+	"olympy/pkg/identity"
+	"olympy/pkg/logger"
+	"strings"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
@@ -21,7 +23,7 @@ type CasbinHandler struct {
 
 func NewAuthorizer() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		token1 := ctx.GetHeader("Authorization")
+		token1 := Token(ctx)
 		if token1 == "" {
 
 			sub := "unauthorized"
@@ -30,10 +32,9 @@ func NewAuthorizer() gin.HandlerFunc {
 			e, _ := casbin.NewEnforcer(`auth.conf`, `auth.csv`)
 			t, _ := e.Enforce(sub, obj, etc)
 			if t {
-				ctx.Next()
+				allow(ctx, sub, "")
 				return
 			}
-			fmt.Println(sub, obj, etc, t)
 		}
 
 		claims, err := jwt.ExtractClaim(token1)
@@ -63,9 +64,9 @@ func NewAuthorizer() gin.HandlerFunc {
 			log.Fatal(err)
 			return
 		}
-		fmt.Println(sub, obj, etc)
 		if t {
-			ctx.Next()
+			userID, _ := claims["id"].(string)
+			allow(ctx, fmt.Sprint(sub), userID)
 			return
 		}
 		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
@@ -73,3 +74,52 @@ func NewAuthorizer() gin.HandlerFunc {
 		})
 	}
 }
+
+// allow lets the request through as role. The identity is stored in the
+// request context, from where the gRPC clients forward it to the backends.
+func allow(ctx *gin.Context, role, userID string) {
+	ctx.Set(RoleKey, role)
+	ctx.Request = ctx.Request.WithContext(identity.WithIdentity(ctx.Request.Context(), identity.Identity{
+		UserID: userID,
+		Role:   role,
+		Scopes: Scopes(role),
+	}))
+	ctx.Next()
+}
+
+// RoleKey is the context key under which NewAuthorizer stores the caller's
+// role, for handlers that authorize further actions with Enforce.
+const RoleKey = "role"
+
+// BearerProtocol prefixes the token in a WebSocket subprotocol, since
+// browsers cannot set headers on WebSocket handshakes either.
+const BearerProtocol = "bearer."
+
+// Token returns the caller's token from the Authorization header, the
+// access_token query parameter (EventSource cannot set headers) or a
+// "bearer.<token>" WebSocket subprotocol.
+func Token(ctx *gin.Context) string {
+	if token := ctx.GetHeader("Authorization"); token != "" {
+		return token
+	}
+	if token := ctx.Query("access_token"); token != "" {
+		return token
+	}
+	for _, header := range ctx.Request.Header.Values("Sec-WebSocket-Protocol") {
+		for _, protocol := range strings.Split(header, ",") {
+			if token, ok := strings.CutPrefix(strings.TrimSpace(protocol), BearerProtocol); ok {
+				return token
+			}
+		}
+	}
+	return ""
+}
+
+// Enforce reports whether role may perform act on obj.
+func Enforce(role, obj, act string) (bool, error) {
+	e, err := casbin.NewEnforcer(`auth.conf`, `auth.csv`)
+	if err != nil {
+		return false, err
+	}
+	return e.Enforce(role, obj, act)
+}
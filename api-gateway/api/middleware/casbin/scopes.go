@@ -0,0 +1,45 @@
+package casbin
+
+// Scopes granted to each role, which the backends check in addition to the
+// routes allowed by auth.csv. Reads need no scope.
+const (
+	ScopeAthletesWrite      = "athletes:write"
+	ScopeCountriesWrite     = "countries:write"
+	ScopeEventsWrite        = "events:write"
+	ScopeGamesWrite         = "games:write"
+	ScopeMedalsWrite        = "medals:write"
+	ScopeTranslationsWrite  = "translations:write"
+	ScopeVenuesWrite        = "venues:write"
+	ScopeWebhooksManage     = "webhooks:manage"
+	ScopeUsersManage        = "users:manage"
+	ScopeStreamPublish      = "stream:publish"
+	ScopeStreamSubscribe    = "stream:subscribe"
+	ScopeStreamSubscribeAll = "stream:subscribe_all"
+	ScopeCalendarSubscribe  = "calendar:subscribe"
+)
+
+var roleScopes = map[string][]string{
+	"admin": {
+		ScopeAthletesWrite,
+		ScopeCountriesWrite,
+		ScopeEventsWrite,
+		ScopeGamesWrite,
+		ScopeMedalsWrite,
+		ScopeTranslationsWrite,
+		ScopeVenuesWrite,
+		ScopeWebhooksManage,
+		ScopeUsersManage,
+		ScopeStreamPublish,
+		ScopeStreamSubscribe,
+		ScopeStreamSubscribeAll,
+		ScopeCalendarSubscribe,
+	},
+	"user": {ScopeStreamSubscribe, ScopeCalendarSubscribe},
+	// auth.csv lets anyone edit countries.
+	"unauthorized": {ScopeCountriesWrite},
+}
+
+// Scopes returns the scopes of role.
+func Scopes(role string) []string {
+	return roleScopes[role]
+}
@@ -0,0 +1,63 @@
+package games
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// Header names the Olympic Games edition a request is scoped to.
+	Header = "X-Games-ID"
+	// Key holds the selected edition's ID on the gin context; 0 means all
+	// editions.
+	Key = "games_id"
+
+	prefix = "/api/v1/games/"
+)
+
+// Rewrite serves /api/v1/games/{id}/<path> as /api/v1/<path> scoped to the
+// edition, so every endpoint can also be reached per edition.
+func Rewrite(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rest, ok := strings.CutPrefix(r.URL.Path, prefix); ok {
+			if id, path, ok := strings.Cut(rest, "/"); ok && path != "" {
+				if _, err := strconv.ParseInt(id, 10, 64); err == nil {
+					r.Header.Set(Header, id)
+					r.URL.Path = "/api/v1/" + path
+					r.URL.RawPath = ""
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// NewSelector reads the edition from the X-Games-ID header or the games_id
+// query parameter.
+func NewSelector() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		idStr := ctx.GetHeader(Header)
+		if idStr == "" {
+			idStr = ctx.Query(Key)
+		}
+		if idStr != "" {
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil || id < 0 {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid games ID"})
+				return
+			}
+			ctx.Set(Key, id)
+		}
+		ctx.Next()
+	}
+}
+
+// FromContext returns the edition selected for the request, or 0.
+func FromContext(ctx context.Context) int64 {
+	id, _ := ctx.Value(Key).(int64)
+	return id
+}
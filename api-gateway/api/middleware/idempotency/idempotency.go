@@ -0,0 +1,239 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"olympy/api-gateway/api/models/model_common"
+	"olympy/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	// Header is the request header carrying the client's idempotency key.
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set on responses replayed from the store.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	keyPrefix    = "idempotency:"
+
+	// maxBodySize caps the bodies held in memory to fingerprint them. Bulk
+	// imports above it are sent without a key.
+	maxBodySize = 1 << 20
+
+	// lockTTL bounds how long a crashed gateway can hold a key; waitTimeout
+	// bounds how long a duplicate waits for the original request to finish.
+	lockTTL      = 30 * time.Second
+	waitTimeout  = 15 * time.Second
+	pollInterval = 50 * time.Millisecond
+)
+
+// unlock deletes the lock only while it still holds our token, so a
+// request that outlived lockTTL cannot release a lock taken over since.
+var unlock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// record is a completed response stored under an idempotency key.
+type record struct {
+	Fingerprint string `json:"fingerprint"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// New makes write requests carrying an Idempotency-Key safe to retry. The
+// first request with a key runs normally and its response is kept for ttl;
+// repeats with the same payload get that response replayed, repeats with a
+// different payload are rejected with 422, and concurrent duplicates wait
+// for the first one to finish. Server errors are not stored, so they can be
+// retried. Keys are scoped per user, method and path.
+func New(client *redis.Client, ttl time.Duration, l *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(Header)
+		if key == "" || !isWrite(ctx.Request.Method) {
+			ctx.Next()
+			return
+		}
+		if len(key) > maxKeyLength {
+			abort(ctx, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxBodySize))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			abort(ctx, http.StatusRequestEntityTooLarge, "requests with an Idempotency-Key must be at most 1 MiB")
+			return
+		}
+		if err != nil {
+			abort(ctx, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		c := ctx.Request.Context()
+		storeKey := keyPrefix + logger.UserID(c) + ":" + ctx.Request.Method + ":" + ctx.Request.URL.Path + ":" + key
+		lockKey := storeKey + ":lock"
+		fingerprint := fingerprint(ctx.Request, body)
+		// The lock holds the fingerprint, for duplicates to compare with,
+		// and a token unique to this request.
+		token, err := newToken()
+		if err != nil {
+			abort(ctx, http.StatusInternalServerError, "failed to generate lock token")
+			return
+		}
+		lock := fingerprint + ":" + token
+
+		deadline := time.Now().Add(waitTimeout)
+		for {
+			rec, err := load(ctx, client, storeKey)
+			if err != nil {
+				// Without Redis the request still goes through; a possible
+				// duplicate is preferable to rejecting every write.
+				l.Warn("idempotency store unavailable", append(logger.Fields(c), zap.Error(err))...)
+				ctx.Next()
+				return
+			}
+			if rec != nil {
+				replay(ctx, rec, fingerprint)
+				return
+			}
+
+			acquired, err := client.SetNX(ctx, lockKey, lock, lockTTL).Result()
+			if err != nil {
+				l.Warn("idempotency store unavailable", append(logger.Fields(c), zap.Error(err))...)
+				ctx.Next()
+				return
+			}
+			if acquired {
+				break
+			}
+
+			if owner, err := client.Get(ctx, lockKey).Result(); err == nil && !strings.HasPrefix(owner, fingerprint+":") {
+				abort(ctx, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request payload")
+				return
+			}
+			if time.Now().After(deadline) {
+				abort(ctx, http.StatusConflict, "a request with this Idempotency-Key is still in progress")
+				return
+			}
+			select {
+			case <-c.Done():
+				ctx.Abort()
+				return
+			case <-time.After(pollInterval):
+			}
+		}
+		// The outcome is recorded even if the client has gone away meanwhile.
+		bg := context.WithoutCancel(c)
+		defer unlock.Run(bg, client, []string{lockKey}, lock)
+
+		writer := &bodyWriter{ResponseWriter: ctx.Writer}
+		ctx.Writer = writer
+		ctx.Next()
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+		data, err := json.Marshal(record{
+			Fingerprint: fingerprint,
+			Status:      status,
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		})
+		if err == nil {
+			err = client.Set(bg, storeKey, data, ttl).Err()
+		}
+		if err != nil {
+			l.Warn("failed to store idempotent response", append(logger.Fields(c), zap.Error(err))...)
+		}
+	}
+}
+
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func load(ctx *gin.Context, client *redis.Client, key string) (*record, error) {
+	data, err := client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rec record
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func replay(ctx *gin.Context, rec *record, fingerprint string) {
+	if rec.Fingerprint != fingerprint {
+		abort(ctx, http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request payload")
+		return
+	}
+	ctx.Header(ReplayedHeader, "true")
+	ctx.Data(rec.Status, rec.ContentType, rec.Body)
+	ctx.Abort()
+}
+
+func abort(ctx *gin.Context, status int, message string) {
+	ctx.AbortWithStatusJSON(status, model_common.ResponseError{
+		Code:    http.StatusText(status),
+		Message: message,
+	})
+}
+
+// bodyWriter keeps a copy of the response body so it can be stored.
+type bodyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
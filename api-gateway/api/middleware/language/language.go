@@ -0,0 +1,20 @@
+package language
+
+import (
+	"olympy/api-gateway/internal/pkg/locale"
+
+	"github.com/gin-gonic/gin"
+)
+
+// New negotiates the locales of country, event and sport names from the
+// Accept-Language header. They are stored in the request context, from where
+// the gRPC clients forward them to the backends.
+func New() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Writer.Header().Add("Vary", locale.Header)
+		if locales := locale.Parse(ctx.GetHeader(locale.Header)); len(locales) > 0 {
+			ctx.Request = ctx.Request.WithContext(locale.WithLocales(ctx.Request.Context(), locales))
+		}
+		ctx.Next()
+	}
+}
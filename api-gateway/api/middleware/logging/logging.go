@@ -0,0 +1,65 @@
+package logging
+
+import (
+	"time"
+
+	jwt "olympy/api-gateway/internal/pkg/tokens"
+	"olympy/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const maxRequestIDLength = 128
+
+// NewRequestLogger assigns every request an ID, taken from the X-Request-ID
+// header when the client sends one, and logs the request once it completes.
+// The request and user IDs are stored in the request context, from where the
+// gRPC clients forward them to the backends.
+func NewRequestLogger(l *zap.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		requestID := ctx.GetHeader(logger.RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = logger.NewRequestID()
+		}
+		ctx.Header(logger.RequestIDHeader, requestID)
+
+		c := logger.WithRequestID(ctx.Request.Context(), requestID)
+		if token := ctx.GetHeader("Authorization"); token != "" {
+			if claims, err := jwt.ExtractClaim(token); err == nil {
+				if userID, ok := claims["id"].(string); ok {
+					c = logger.WithUserID(c, userID)
+				}
+			}
+		}
+		ctx.Request = ctx.Request.WithContext(c)
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = ctx.Request.URL.Path
+		}
+		fields := append(logger.Fields(c),
+			zap.String("method", ctx.Request.Method),
+			zap.String("route", route),
+			zap.Int("status", ctx.Writer.Status()),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("client_ip", ctx.ClientIP()),
+		)
+		if errs := ctx.Errors.String(); errs != "" {
+			fields = append(fields, zap.String("errors", errs))
+		}
+
+		switch status := ctx.Writer.Status(); {
+		case status >= 500:
+			l.Error("request", fields...)
+		case status >= 400:
+			l.Warn("request", fields...)
+		default:
+			l.Info("request", fields...)
+		}
+	}
+}
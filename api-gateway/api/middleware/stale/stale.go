@@ -0,0 +1,19 @@
+package stale
+
+import (
+	"olympy/api-gateway/internal/pkg/grpcclient"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewStaleMarker flags responses that were served from the gateway's stale
+// cache because the backend was unavailable.
+func NewStaleMarker() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set(grpcclient.StaleKey, grpcclient.StaleFunc(func() {
+			ctx.Header("Warning", `110 - "Response is Stale"`)
+			ctx.Header("X-Degraded", "true")
+		}))
+		ctx.Next()
+	}
+}
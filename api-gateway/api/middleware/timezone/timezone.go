@@ -0,0 +1,129 @@
+// Package timezone renders the times of responses in the time zone asked for
+// with the tz query parameter: an IANA name such as Europe/Paris for the
+// viewer's local time, or "venue" for the local time of each event's venue.
+// Times are in UTC otherwise.
+package timezone
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// Param is the query parameter naming the time zone.
+	Param = "tz"
+	// Venue renders the times of events in the time zone of their venue,
+	// and other times in UTC.
+	Venue = "venue"
+
+	key = "timezone"
+)
+
+// Zone is the time zone times are rendered in. The zero Zone is UTC.
+type Zone struct {
+	loc   *time.Location
+	venue bool
+}
+
+// New reads the time zone from the tz query parameter and rejects unknown
+// zones.
+func New() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		name := ctx.Query(Param)
+		switch name {
+		case "":
+		case Venue:
+			ctx.Set(key, Zone{venue: true})
+		default:
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid time zone " + name})
+				return
+			}
+			ctx.Set(key, Zone{loc: loc})
+		}
+		ctx.Next()
+	}
+}
+
+// FromContext returns the zone requested for the request.
+func FromContext(ctx context.Context) Zone {
+	zone, _ := ctx.Value(key).(Zone)
+	return zone
+}
+
+// venueLocations caches the locations of venue time zones by name.
+var venueLocations sync.Map
+
+// location returns the location of times of an entity whose venue is in
+// venueTimezone, empty when it has none.
+func (z Zone) location(venueTimezone string) *time.Location {
+	if z.venue {
+		if venueTimezone == "" {
+			return time.UTC
+		}
+		if loc, ok := venueLocations.Load(venueTimezone); ok {
+			return loc.(*time.Location)
+		}
+		loc, err := time.LoadLocation(venueTimezone)
+		if err != nil {
+			return time.UTC
+		}
+		venueLocations.Store(venueTimezone, loc)
+		return loc
+	}
+	if z.loc != nil {
+		return z.loc
+	}
+	return time.UTC
+}
+
+// Format renders t as RFC 3339 in z, or returns "" when t is unset.
+func (z Zone) Format(t *time.Time, venueTimezone string) string {
+	if t == nil {
+		return ""
+	}
+	return t.In(z.location(venueTimezone)).Format(time.RFC3339)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Apply moves the times in v, a response message, to the zone requested by
+// ctx. Messages with a Timezone field, events, are moved to that zone when
+// the venue's time is requested.
+func Apply(ctx context.Context, v interface{}) {
+	FromContext(ctx).apply(reflect.ValueOf(v), "")
+}
+
+func (z Zone) apply(v reflect.Value, venueTimezone string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			z.apply(v.Elem(), venueTimezone)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			z.apply(v.Index(i), venueTimezone)
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(v.Interface().(time.Time).In(z.location(venueTimezone))))
+			}
+			return
+		}
+		if tz := v.FieldByName("Timezone"); tz.IsValid() && tz.Kind() == reflect.String {
+			venueTimezone = tz.String()
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				z.apply(v.Field(i), venueTimezone)
+			}
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	authservice "olympy/api-gateway/genproto/auth_service"
+	streamservice "olympy/api-gateway/genproto/stream_service"
+)
+
+// gateway sends calls to the REST API.
+type gateway struct {
+	base    string
+	server  string
+	gamesID int64
+	session *session
+	client  *http.Client
+}
+
+func newGateway(opts options, sess *session) *gateway {
+	server := strings.TrimSuffix(opts.server, "/")
+	return &gateway{
+		base:    server + "/api/v1",
+		server:  server,
+		gamesID: opts.gamesID,
+		session: sess,
+		client:  &http.Client{},
+	}
+}
+
+func (g *gateway) invoke(ctx context.Context, c call, resp interface{}) error {
+	var body io.Reader
+	if c.method == http.MethodPost || c.method == http.MethodPut {
+		v := c.body
+		if v == nil {
+			v = c.request
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	res, err := g.do(ctx, c.method, c.path, c.query, body, "application/json")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return decodeResponse(res, resp)
+}
+
+func (g *gateway) upload(ctx context.Context, c call, data io.Reader, resp interface{}) error {
+	res, err := g.do(ctx, http.MethodPost, c.path, c.query, data, "application/octet-stream")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return decodeResponse(res, resp)
+}
+
+func (g *gateway) download(ctx context.Context, c call, w io.Writer) error {
+	res, err := g.do(ctx, http.MethodGet, c.path, c.query, nil, "")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return decodeResponse(res, nil)
+	}
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+// tail follows the event's Server-Sent Events, reconnecting after the last
+// commentary received when the connection drops.
+func (g *gateway) tail(ctx context.Context, eventID, lastID string, each func(*streamservice.Commentary) error) error {
+	for {
+		err := g.follow(ctx, eventID, &lastID, each)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if _, ok := err.(*statusError); ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
+
+func (g *gateway) follow(ctx context.Context, eventID string, lastID *string, each func(*streamservice.Commentary) error) error {
+	req, err := g.request(ctx, http.MethodGet, "/stream/events/"+url.PathEscape(eventID)+"/sse", nil, nil, "")
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastID != "" {
+		req.Header.Set("Last-Event-ID", *lastID)
+	}
+	res, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return decodeResponse(res, nil)
+	}
+
+	var id, event string
+	var data strings.Builder
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			id = value
+		case "event":
+			event = value
+		case "data":
+			data.WriteString(value)
+		case "":
+			if line != "" {
+				continue // comment
+			}
+			if event == "commentary" {
+				var msg streamservice.Commentary
+				if err := json.Unmarshal([]byte(data.String()), &msg); err != nil {
+					return err
+				}
+				*lastID = id
+				if err := each(&msg); err != nil {
+					return err
+				}
+			}
+			id, event = "", ""
+			data.Reset()
+		}
+	}
+	return scanner.Err()
+}
+
+func (g *gateway) do(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	req, err := g.request(ctx, method, path, query, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return g.client.Do(req)
+}
+
+func (g *gateway) request(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Request, error) {
+	u := g.base + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" && body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if g.gamesID != 0 {
+		req.Header.Set("X-Games-ID", strconv.FormatInt(g.gamesID, 10))
+	}
+
+	token, err := g.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	return req, nil
+}
+
+// token returns the cached access token for this gateway, refreshing it
+// first when it has expired.
+func (g *gateway) token(ctx context.Context) (string, error) {
+	s := g.session
+	if s.AccessToken == "" || s.Server != g.server {
+		return "", nil
+	}
+	if !s.expired() || s.RefreshToken == "" {
+		return s.AccessToken, nil
+	}
+
+	data, err := json.Marshal(&authservice.RefreshTokenRequest{RefreshToken: s.RefreshToken})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.base+"/auth/refresh", bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := g.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	var refreshed authservice.RefreshTokenResponse
+	if err := decodeResponse(res, &refreshed); err != nil {
+		return "", fmt.Errorf("session expired, log in again: %v", err)
+	}
+	s.AccessToken = refreshed.AccessToken
+	return s.AccessToken, s.save()
+}
+
+// statusError is an error answered by the gateway.
+type statusError struct {
+	status  string
+	message string
+}
+
+func (e *statusError) Error() string {
+	return e.status + ": " + e.message
+}
+
+// decodeResponse decodes the JSON body of res into v. Errors are answered
+// as {"error": ...} or {"message": ...}; other bodies are results even with
+// an error status, like the report of a rejected import.
+func decodeResponse(res *http.Response, v interface{}) error {
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+		var body map[string]interface{}
+		if json.Unmarshal(data, &body) != nil {
+			return &statusError{status: res.Status, message: strings.TrimSpace(string(data))}
+		}
+		for _, key := range []string{"error", "message"} {
+			if msg, ok := body[key].(string); ok {
+				return &statusError{status: res.Status, message: msg}
+			}
+		}
+		if v == nil {
+			return &statusError{status: res.Status, message: strings.TrimSpace(string(data))}
+		}
+	}
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,187 @@
+// Command olympyctl administers the platform from the command line. It talks
+// to the gateway's REST API, or with -grpc directly to the services over
+// mutual TLS:
+//
+//	go run ./cmd/olympyctl login -u admin1
+//	go run ./cmd/olympyctl medals list -country 3
+//	go run ./cmd/olympyctl -o yaml events get -id 12
+//	go run ./cmd/olympyctl medals ranking
+//	go run ./cmd/olympyctl athletes import -f athletes.csv -dry-run
+//	go run ./cmd/olympyctl tail -event 12
+//	go run ./cmd/olympyctl -grpc users set-role -id 7 -role admin
+//
+// Run it without arguments for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"olympy/pkg/mtls"
+)
+
+// options are the global flags, given before the command.
+type options struct {
+	server         string
+	output         string
+	gamesID        int64
+	sessionFile    string
+	direct         bool
+	certs          mtls.Files
+	identitySecret string
+	addrs          map[string]string
+}
+
+type app struct {
+	opts      options
+	out       *printer
+	session   *session
+	transport transport
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, a *app, args []string) error
+}
+
+// errUsage makes main print the usage of the command that returned it.
+var errUsage = errors.New("usage")
+
+func main() {
+	opts := options{addrs: make(map[string]string)}
+	global := flag.NewFlagSet("olympyctl", flag.ExitOnError)
+	global.StringVar(&opts.server, "server", envOr("OLYMPYCTL_SERVER", "http://localhost:9090"), "gateway URL")
+	global.StringVar(&opts.output, "o", "table", "output format: table, json or yaml")
+	global.Int64Var(&opts.gamesID, "games", 0, "Olympic Games edition to scope requests to")
+	global.StringVar(&opts.sessionFile, "session", envOr("OLYMPYCTL_SESSION", defaultSessionFile()), "file caching the login tokens")
+	global.BoolVar(&opts.direct, "grpc", false, "call the services directly over gRPC instead of the gateway")
+	global.StringVar(&opts.certs.Cert, "cert", "certs/olympyctl.pem", "client certificate for -grpc")
+	global.StringVar(&opts.certs.Key, "key", "certs/olympyctl-key.pem", "client key for -grpc")
+	global.StringVar(&opts.certs.CA, "ca", "certs/ca.pem", "CA of the services for -grpc")
+	global.StringVar(&opts.identitySecret, "identity-secret", os.Getenv("INTERNAL_IDENTITY_SECRET"), "key the services verify caller identities with, for -grpc")
+	for _, b := range backends {
+		addr := b.addr
+		opts.addrs[b.name] = addr
+		global.Func(b.name+"-addr", "address of "+b.name+"-service for -grpc (default "+addr+")", func(v string) error {
+			opts.addrs[b.name] = v
+			return nil
+		})
+	}
+	global.Usage = func() { usage(global) }
+	global.Parse(os.Args[1:])
+
+	cmd, args, ok := lookup(global.Args())
+	if !ok {
+		usage(global)
+		os.Exit(2)
+	}
+
+	if err := run(opts, cmd, args); err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "usage: olympyctl [flags] %s %s\n", cmd.name, cmd.usage)
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, "olympyctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options, cmd command, args []string) error {
+	out, err := newPrinter(opts.output, os.Stdout)
+	if err != nil {
+		return err
+	}
+	sess, err := loadSession(opts.sessionFile)
+	if err != nil {
+		return err
+	}
+
+	a := &app{opts: opts, out: out, session: sess}
+	if opts.direct {
+		d, err := newDirect(opts, sess)
+		if err != nil {
+			return err
+		}
+		defer d.Close()
+		a.transport = d
+	} else {
+		a.transport = newGateway(opts, sess)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return cmd.run(ctx, a, args)
+}
+
+// lookup finds the command named by the first one or two arguments.
+func lookup(args []string) (command, []string, bool) {
+	if len(args) >= 2 {
+		if cmd, ok := commands()[args[0]+" "+args[1]]; ok {
+			return cmd, args[2:], true
+		}
+	}
+	if len(args) >= 1 {
+		if cmd, ok := commands()[args[0]]; ok {
+			return cmd, args[1:], true
+		}
+	}
+	return command{}, nil, false
+}
+
+func commands() map[string]command {
+	all := make(map[string]command)
+	add := func(cmds ...command) {
+		for _, cmd := range cmds {
+			all[cmd.name] = cmd
+		}
+	}
+	add(authCommands()...)
+	add(userCommands()...)
+	for _, r := range resources {
+		add(r.commands()...)
+	}
+	add(rankingCommand(), tailCommand())
+	return all
+}
+
+func usage(global *flag.FlagSet) {
+	fmt.Fprintln(os.Stderr, "usage: olympyctl [flags] <command> [command flags]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	cmds := commands()
+	var names []string
+	for name := range cmds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", name, cmds[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	global.PrintDefaults()
+}
+
+// parse parses the flags of a command, which takes no positional arguments.
+func parse(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(os.Stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// printer writes results in the format chosen with -o.
+type printer struct {
+	format string
+	w      io.Writer
+	// fields restricts table columns to the fields a command asked for.
+	fields []string
+}
+
+func newPrinter(format string, w io.Writer) (*printer, error) {
+	switch format {
+	case "table", "json", "yaml":
+		return &printer{format: format, w: w}, nil
+	}
+	return nil, fmt.Errorf("output format must be table, json or yaml, not %q", format)
+}
+
+func (p *printer) print(v interface{}) error {
+	switch p.format {
+	case "json":
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "yaml":
+		doc, err := plain(v)
+		if err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(p.w)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return p.table(v)
+}
+
+// plain converts v to maps and slices through its JSON encoding, so that
+// YAML uses the same field names. Numbers stay integers where they are.
+func plain(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return numbers(doc), nil
+}
+
+func numbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = numbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = numbers(e)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+// table prints the list in v as rows, one column per scalar field, followed
+// by the scalar fields of v itself, like the count of a page. A response
+// wrapping a single message, like GetEventResponse, prints that message.
+func (p *printer) table(v interface{}) error {
+	val := reflect.Indirect(reflect.ValueOf(v))
+	if val.Kind() != reflect.Struct {
+		_, err := fmt.Fprintln(p.w, v)
+		return err
+	}
+	if inner, ok := wrapped(val); ok {
+		val = inner
+	}
+
+	rows := []reflect.Value{val}
+	rowType := val.Type()
+	var summary []reflect.StructField
+	if list, ok := listField(val); ok {
+		rows = rows[:0]
+		for i := 0; i < list.Len(); i++ {
+			rows = append(rows, reflect.Indirect(list.Index(i)))
+		}
+		rowType = list.Type().Elem()
+		if rowType.Kind() == reflect.Ptr {
+			rowType = rowType.Elem()
+		}
+		summary = columns(val.Type(), nil)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	cols := columns(rowType, p.fields)
+
+	var header []string
+	for _, col := range cols {
+		header = append(header, strings.ToUpper(jsonName(col)))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		var cells []string
+		for _, col := range cols {
+			cells = append(cells, cell(row.FieldByIndex(col.Index)))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, field := range summary {
+		value := val.FieldByIndex(field.Index)
+		if value.IsZero() && value.Kind() == reflect.String {
+			continue
+		}
+		if _, err := fmt.Fprintf(p.w, "%s: %v\n", jsonName(field), value.Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wrapped returns the message of a response whose only field is a message.
+func wrapped(val reflect.Value) (reflect.Value, bool) {
+	var inner reflect.Value
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if jsonName(field) == "" {
+			continue
+		}
+		if field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct || inner.IsValid() {
+			return val, false
+		}
+		inner = val.Field(i)
+	}
+	if !inner.IsValid() || inner.IsNil() {
+		return val, false
+	}
+	return inner.Elem(), true
+}
+
+// listField returns the list of messages in val, if any.
+func listField(val reflect.Value) (reflect.Value, bool) {
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if jsonName(field) == "" || field.Type.Kind() != reflect.Slice {
+			continue
+		}
+		elem := field.Type.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct {
+			return val.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// columns returns the scalar fields of t, limited to only and the id when
+// only is not empty.
+func columns(t reflect.Type, only []string) []reflect.StructField {
+	var cols []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := jsonName(field)
+		if name == "" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.Ptr:
+			if field.Type.Elem() != timeType {
+				continue
+			}
+		case reflect.Slice, reflect.Map, reflect.Struct, reflect.Interface:
+			continue
+		}
+		if len(only) > 0 && name != "id" && !slices.Contains(only, name) {
+			continue
+		}
+		cols = append(cols, field)
+	}
+	return cols
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// cell renders a column value; times as RFC 3339, blank when unset.
+func cell(v reflect.Value) string {
+	if t, ok := v.Interface().(*time.Time); ok {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// jsonName returns the JSON name of a generated message field, or "" for
+// the XXX_ bookkeeping fields.
+func jsonName(field reflect.StructField) string {
+	if strings.HasPrefix(field.Name, "XXX_") || field.PkgPath != "" {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,829 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	athleteservice "olympy/api-gateway/genproto/athlete_service"
+	countryservice "olympy/api-gateway/genproto/country_service"
+	eventservice "olympy/api-gateway/genproto/event_service"
+	medalservice "olympy/api-gateway/genproto/medal_service"
+	venueservice "olympy/api-gateway/genproto/venue_service"
+
+	"github.com/gogo/protobuf/types"
+	"gopkg.in/yaml.v3"
+)
+
+// listQuery is what list and export commands ask for. filters holds the
+// resource's filter flags under the names of their query parameters.
+type listQuery struct {
+	filters   url.Values
+	page      int32
+	limit     int32
+	pageToken string
+	fields    *types.FieldMask
+	gamesID   int64
+}
+
+// importOptions are the options of an import, as the import routes take
+// them.
+type importOptions struct {
+	format    string
+	dryRun    bool
+	chunked   bool
+	chunkSize int32
+	gamesID   int64
+}
+
+// importReport is implemented by the ImportReport of every service.
+type importReport interface {
+	GetFailed() int32
+}
+
+// resource describes the commands of an entity: the gateway routes under
+// its name and the service methods behind them. Functions building gRPC
+// requests are also used for the gateway, whose routes take the same
+// fields.
+type resource struct {
+	name    string // command and route prefix, e.g. "athletes"
+	backend string
+	service string // gRPC service name, e.g. "athlete_service.AthleteService"
+	single  string // method name suffix, e.g. "Athlete"
+	plural  string // method name suffix of imports and exports
+	listRPC string // method listing a page
+
+	filters  []string // list filters, named after their query parameters
+	pageSize string   // query parameter of the page size
+
+	list         func(q listQuery) (interface{}, error)
+	listResponse func() interface{}
+	get          func(id string, fields *types.FieldMask) (interface{}, error)
+	getResponse  func() interface{}
+	item         func() interface{} // message decoded from add and edit files
+	// wrap returns the add or edit request carrying item, when it is not
+	// the item itself.
+	wrap    func(item interface{}, edit bool) interface{}
+	written func() interface{} // response of add and edit
+	remove  func(id string) (interface{}, error)
+	message func() interface{}
+
+	// imports returns the request of an import chunk; nil when the
+	// resource has no import.
+	imports func(o importOptions) func(data []byte, first bool) interface{}
+	report  func() importReport
+
+	// export returns the request of the export method and a new message
+	// of its stream; nil when the resource has no export.
+	export func(q listQuery) (interface{}, func() interface{}, error)
+}
+
+func (r resource) rpc(method string) string {
+	return "/" + r.service + "/" + method
+}
+
+var resources = []resource{
+	{
+		name:     "countries",
+		backend:  "medal",
+		service:  "service_service.CountryService",
+		single:   "Country",
+		plural:   "Countries",
+		listRPC:  "ListCountries",
+		pageSize: "limit",
+		list: func(q listQuery) (interface{}, error) {
+			return &countryservice.ListRequest{Page: q.page, Limit: q.limit, PageToken: q.pageToken, Fields: q.fields}, nil
+		},
+		listResponse: func() interface{} { return &countryservice.ListResponse{} },
+		get: func(id string, fields *types.FieldMask) (interface{}, error) {
+			n, err := parseID(id)
+			return &countryservice.GetSingleRequest{Id: n, Fields: fields}, err
+		},
+		getResponse: func() interface{} { return &countryservice.Country{} },
+		item:        func() interface{} { return &countryservice.Country{} },
+		written:     func() interface{} { return &countryservice.Country{} },
+		remove: func(id string) (interface{}, error) {
+			n, err := parseID(id)
+			return &countryservice.GetSingleRequest{Id: n}, err
+		},
+		message: func() interface{} { return &countryservice.Message{} },
+		imports: func(o importOptions) func([]byte, bool) interface{} {
+			return func(data []byte, first bool) interface{} {
+				req := &countryservice.ImportRequest{Data: data}
+				if first {
+					req.Options = &countryservice.ImportOptions{Format: o.format, DryRun: o.dryRun, Chunked: o.chunked, ChunkSize: o.chunkSize}
+				}
+				return req
+			}
+		},
+		report: func() importReport { return &countryservice.ImportReport{} },
+	},
+	{
+		name:     "athletes",
+		backend:  "athlete",
+		service:  "athlete_service.AthleteService",
+		single:   "Athlete",
+		plural:   "Athletes",
+		listRPC:  "ListAthletes",
+		filters:  []string{"country_id", "sport_type"},
+		pageSize: "limit",
+		list: func(q listQuery) (interface{}, error) {
+			countryID, err := int64Filter(q.filters, "country_id")
+			return &athleteservice.ListRequest{
+				Page:      q.page,
+				Limit:     q.limit,
+				CountryId: countryID,
+				SportType: q.filters.Get("sport_type"),
+				GamesId:   q.gamesID,
+				Fields:    q.fields,
+				PageToken: q.pageToken,
+			}, err
+		},
+		listResponse: func() interface{} { return &athleteservice.ListResponse{} },
+		get: func(id string, fields *types.FieldMask) (interface{}, error) {
+			n, err := parseID(id)
+			return &athleteservice.GetSingleRequest{Id: n, Fields: fields}, err
+		},
+		getResponse: func() interface{} { return &athleteservice.Athlete{} },
+		item:        func() interface{} { return &athleteservice.Athlete{} },
+		written:     func() interface{} { return &athleteservice.Athlete{} },
+		remove: func(id string) (interface{}, error) {
+			n, err := parseID(id)
+			return &athleteservice.GetSingleRequest{Id: n}, err
+		},
+		message: func() interface{} { return &athleteservice.Message{} },
+		imports: func(o importOptions) func([]byte, bool) interface{} {
+			return func(data []byte, first bool) interface{} {
+				req := &athleteservice.ImportRequest{Data: data}
+				if first {
+					req.Options = &athleteservice.ImportOptions{Format: o.format, DryRun: o.dryRun, Chunked: o.chunked, ChunkSize: o.chunkSize, GamesId: o.gamesID}
+				}
+				return req
+			}
+		},
+		report: func() importReport { return &athleteservice.ImportReport{} },
+		export: func(q listQuery) (interface{}, func() interface{}, error) {
+			countryID, err := int64Filter(q.filters, "country_id")
+			req := &athleteservice.ListRequest{CountryId: countryID, SportType: q.filters.Get("sport_type"), GamesId: q.gamesID}
+			return req, func() interface{} { return &athleteservice.Athlete{} }, err
+		},
+	},
+	{
+		name:     "events",
+		backend:  "event",
+		service:  "event_service.EventService",
+		single:   "Event",
+		plural:   "Events",
+		listRPC:  "GetAllEvents",
+		pageSize: "page_size",
+		list: func(q listQuery) (interface{}, error) {
+			return &eventservice.GetAllEventsRequest{Page: q.page, PageSize: q.limit, GamesId: q.gamesID, Fields: q.fields, PageToken: q.pageToken}, nil
+		},
+		listResponse: func() interface{} { return &eventservice.GetAllEventsResponse{} },
+		get: func(id string, fields *types.FieldMask) (interface{}, error) {
+			return &eventservice.GetEventRequest{Id: id, Fields: fields}, nil
+		},
+		getResponse: func() interface{} { return &eventservice.GetEventResponse{} },
+		item:        func() interface{} { return &eventservice.Event{} },
+		wrap: func(item interface{}, edit bool) interface{} {
+			if edit {
+				return &eventservice.EditEventRequest{Event: item.(*eventservice.Event)}
+			}
+			return &eventservice.AddEventRequest{Event: item.(*eventservice.Event)}
+		},
+		written: func() interface{} { return &eventservice.AddEventResponse{} },
+		remove: func(id string) (interface{}, error) {
+			return &eventservice.DeleteEventRequest{Id: id}, nil
+		},
+		message: func() interface{} { return &eventservice.Message{} },
+		imports: func(o importOptions) func([]byte, bool) interface{} {
+			return func(data []byte, first bool) interface{} {
+				req := &eventservice.ImportRequest{Data: data}
+				if first {
+					req.Options = &eventservice.ImportOptions{Format: o.format, DryRun: o.dryRun, Chunked: o.chunked, ChunkSize: o.chunkSize, GamesId: o.gamesID}
+				}
+				return req
+			}
+		},
+		report: func() importReport { return &eventservice.ImportReport{} },
+		export: func(q listQuery) (interface{}, func() interface{}, error) {
+			req := &eventservice.SearchEventsRequest{Query: q.filters.Get("query"), GamesId: q.gamesID}
+			return req, func() interface{} { return &eventservice.Event{} }, nil
+		},
+	},
+	{
+		name:     "medals",
+		backend:  "medal",
+		service:  "medal_service.MedalService",
+		single:   "Medal",
+		plural:   "Medals",
+		listRPC:  "ListMedals",
+		filters:  []string{"country", "event_id", "athlete_id"},
+		pageSize: "limit",
+		list: func(q listQuery) (interface{}, error) {
+			req, err := medalFilters(q)
+			req.Page, req.Limit, req.Fields, req.PageToken = q.page, q.limit, q.fields, q.pageToken
+			return req, err
+		},
+		listResponse: func() interface{} { return &medalservice.ListResponse{} },
+		get: func(id string, fields *types.FieldMask) (interface{}, error) {
+			n, err := parseID(id)
+			return &medalservice.GetSingleRequest{Id: n, Fields: fields}, err
+		},
+		getResponse: func() interface{} { return &medalservice.Medal{} },
+		item:        func() interface{} { return &medalservice.Medal{} },
+		written:     func() interface{} { return &medalservice.Medal{} },
+		remove: func(id string) (interface{}, error) {
+			n, err := parseID(id)
+			return &medalservice.GetSingleRequest{Id: n}, err
+		},
+		message: func() interface{} { return &medalservice.Message{} },
+		imports: func(o importOptions) func([]byte, bool) interface{} {
+			return func(data []byte, first bool) interface{} {
+				req := &medalservice.ImportRequest{Data: data}
+				if first {
+					req.Options = &medalservice.ImportOptions{Format: o.format, DryRun: o.dryRun, Chunked: o.chunked, ChunkSize: o.chunkSize, GamesId: o.gamesID}
+				}
+				return req
+			}
+		},
+		report: func() importReport { return &medalservice.ImportReport{} },
+		export: func(q listQuery) (interface{}, func() interface{}, error) {
+			req, err := medalFilters(q)
+			return req, func() interface{} { return &medalservice.Medal{} }, err
+		},
+	},
+	{
+		name:     "venues",
+		backend:  "event",
+		service:  "venue_service.VenueService",
+		single:   "Venue",
+		listRPC:  "ListVenues",
+		filters:  []string{"city", "sport"},
+		pageSize: "limit",
+		list: func(q listQuery) (interface{}, error) {
+			return &venueservice.ListRequest{Page: q.page, Limit: q.limit, City: q.filters.Get("city"), Sport: q.filters.Get("sport")}, nil
+		},
+		listResponse: func() interface{} { return &venueservice.ListResponse{} },
+		get: func(id string, _ *types.FieldMask) (interface{}, error) {
+			n, err := parseID(id)
+			return &venueservice.GetSingleRequest{Id: n}, err
+		},
+		getResponse: func() interface{} { return &venueservice.Venue{} },
+		item:        func() interface{} { return &venueservice.Venue{} },
+		written:     func() interface{} { return &venueservice.Venue{} },
+		remove: func(id string) (interface{}, error) {
+			n, err := parseID(id)
+			return &venueservice.GetSingleRequest{Id: n}, err
+		},
+		message: func() interface{} { return &venueservice.Message{} },
+	},
+}
+
+func medalFilters(q listQuery) (*medalservice.ListRequest, error) {
+	country, err := int64Filter(q.filters, "country")
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := int64Filter(q.filters, "event_id")
+	if err != nil {
+		return nil, err
+	}
+	return &medalservice.ListRequest{Country: country, EventId: eventID, AthleteId: q.filters.Get("athlete_id"), GamesId: q.gamesID}, nil
+}
+
+func (r resource) commands() []command {
+	cmds := []command{
+		{name: r.name + " list", usage: r.listUsage(), run: r.runList},
+		{name: r.name + " get", usage: "-id ID [-fields a,b]", run: r.runGet},
+		{name: r.name + " add", usage: "-f FILE (JSON or YAML)", run: r.runWrite(false)},
+		{name: r.name + " edit", usage: "-f FILE (JSON or YAML)", run: r.runWrite(true)},
+		{name: r.name + " delete", usage: "-id ID", run: r.runDelete},
+	}
+	if r.imports != nil {
+		cmds = append(cmds, command{name: r.name + " import", usage: "-f FILE [-format csv|ndjson] [-dry-run] [-mode atomic|chunked] [-chunk-size N]", run: r.runImport})
+	}
+	if r.export != nil {
+		usage := "[-format csv|ndjson|xlsx] [-out FILE]"
+		for _, name := range r.exportFilters() {
+			usage += " [-" + flagName(name) + " V]"
+		}
+		cmds = append(cmds, command{name: r.name + " export", usage: usage, run: r.runExport})
+	}
+	if r.name == "events" {
+		cmds = append(cmds, command{name: "events search", usage: "-q QUERY [-page N] [-limit N] [-page-token T] [-fields a,b]", run: runSearchEvents})
+		cmds = append(cmds, command{name: "events validate", usage: "[-date YYYY-MM-DD] (schedule conflicts of a day, or of the -games edition)", run: runValidateSchedule})
+		cmds = append(cmds, command{name: "events status", usage: "-id ID -status scheduled|live|delayed|suspended|completed|cancelled [-reason R]", run: runTransitionEventStatus})
+		cmds = append(cmds, command{name: "events history", usage: "-id ID (status transitions, oldest first)", run: runStatusTransitions})
+	}
+	if r.name == "venues" {
+		cmds = append(cmds, command{name: "venues events", usage: "-id ID [-page N] [-limit N] [-page-token T] [-fields a,b] (events at the venue by start time)", run: runVenueEvents})
+	}
+	return cmds
+}
+
+func (r resource) listUsage() string {
+	usage := "[-page N] [-limit N] [-page-token T] [-fields a,b]"
+	for _, name := range r.filters {
+		usage += " [-" + flagName(name) + " V]"
+	}
+	return usage
+}
+
+// exportFilters are the filters of the export route, which filters events
+// by a search query instead of paging them.
+func (r resource) exportFilters() []string {
+	if r.name == "events" {
+		return []string{"query"}
+	}
+	return r.filters
+}
+
+// flagName turns a query parameter into a flag name, e.g. country_id into
+// country-id.
+func flagName(param string) string {
+	return strings.ReplaceAll(param, "_", "-")
+}
+
+// pageFlags registers the paging flags of list commands.
+type pageFlags struct {
+	page      int
+	limit     int
+	pageToken string
+	fields    string
+}
+
+func (p *pageFlags) register(fs *flag.FlagSet) {
+	fs.IntVar(&p.page, "page", 1, "page number")
+	fs.IntVar(&p.limit, "limit", 10, "number of items per page")
+	fs.StringVar(&p.pageToken, "page-token", "", "next_page_token of the previous page; overrides -page")
+	fs.StringVar(&p.fields, "fields", "", "fields to return, comma separated")
+}
+
+func (p *pageFlags) query(a *app, filters url.Values) listQuery {
+	q := listQuery{
+		filters:   filters,
+		page:      int32(p.page),
+		limit:     int32(p.limit),
+		pageToken: p.pageToken,
+		fields:    fieldMask(p.fields),
+		gamesID:   a.opts.gamesID,
+	}
+	if q.fields != nil {
+		a.out.fields = q.fields.Paths
+	}
+	return q
+}
+
+// values returns the query string of q for a list route.
+func (q listQuery) values(pageSize string) url.Values {
+	v := url.Values{}
+	for name, values := range q.filters {
+		v[name] = values
+	}
+	v.Set("page", strconv.Itoa(int(q.page)))
+	v.Set(pageSize, strconv.Itoa(int(q.limit)))
+	if q.pageToken != "" {
+		v.Set("page_token", q.pageToken)
+	}
+	if q.fields != nil {
+		v.Set("fields", strings.Join(q.fields.Paths, ","))
+	}
+	return v
+}
+
+// filterFlags registers one flag per filter and returns the values given.
+func filterFlags(fs *flag.FlagSet, names []string) func() url.Values {
+	values := make(map[string]*string)
+	for _, name := range names {
+		values[name] = fs.String(flagName(name), "", "filter by "+strings.ReplaceAll(name, "_", " "))
+	}
+	return func() url.Values {
+		v := url.Values{}
+		for name, value := range values {
+			if *value != "" {
+				v.Set(name, *value)
+			}
+		}
+		return v
+	}
+}
+
+func (r resource) runList(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet(r.name+" list", flag.ContinueOnError)
+	var p pageFlags
+	p.register(fs)
+	filters := filterFlags(fs, r.filters)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	q := p.query(a, filters())
+	req, err := r.list(q)
+	if err != nil {
+		return err
+	}
+	resp := r.listResponse()
+	c := call{method: http.MethodGet, path: "/" + r.name + "/getall", query: q.values(r.pageSize), backend: r.backend, rpc: r.rpc(r.listRPC), request: req}
+	if err := a.transport.invoke(ctx, c, resp); err != nil {
+		return err
+	}
+	return a.out.print(resp)
+}
+
+func runSearchEvents(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("events search", flag.ContinueOnError)
+	var p pageFlags
+	p.register(fs)
+	query := fs.String("q", "", "search query")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *query == "" {
+		return errUsage
+	}
+
+	q := p.query(a, url.Values{"query": {*query}})
+	req := &eventservice.SearchEventsRequest{Query: *query, Page: q.page, PageSize: q.limit, GamesId: q.gamesID, Fields: q.fields, PageToken: q.pageToken}
+	var resp eventservice.GetAllEventsResponse
+	c := call{method: http.MethodGet, path: "/events/search", query: q.values("page_size"), backend: "event", rpc: "/event_service.EventService/SearchEvents", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runValidateSchedule(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("events validate", flag.ContinueOnError)
+	date := fs.String("date", "", "day to check, e.g. 2024-07-27")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *date == "" && a.opts.gamesID == 0 {
+		return errUsage
+	}
+
+	query := url.Values{}
+	if *date != "" {
+		query.Set("date", *date)
+	}
+	req := &eventservice.ValidateScheduleRequest{Date: *date, GamesId: a.opts.gamesID}
+	var resp eventservice.ValidateScheduleResponse
+	c := call{method: http.MethodGet, path: "/events/schedule/validate", query: query, backend: "event", rpc: "/event_service.EventService/ValidateSchedule", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runTransitionEventStatus(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("events status", flag.ContinueOnError)
+	id := fs.String("id", "", "ID of the event")
+	status := fs.String("status", "", "status to move the event to")
+	reason := fs.String("reason", "", "reason for the change")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" || *status == "" {
+		return errUsage
+	}
+	eventID, err := parseID(*id)
+	if err != nil {
+		return err
+	}
+
+	req := &eventservice.TransitionEventStatusRequest{EventId: eventID, Status: *status, Reason: *reason}
+	var resp eventservice.TransitionEventStatusResponse
+	c := call{method: http.MethodPost, path: "/events/status", backend: "event", rpc: "/event_service.EventService/TransitionEventStatus", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runStatusTransitions(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("events history", flag.ContinueOnError)
+	id := fs.String("id", "", "ID of the event")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+	eventID, err := parseID(*id)
+	if err != nil {
+		return err
+	}
+
+	req := &eventservice.ListStatusTransitionsRequest{EventId: eventID}
+	var resp eventservice.ListStatusTransitionsResponse
+	c := call{method: http.MethodGet, path: "/events/" + *id + "/status/history", backend: "event", rpc: "/event_service.EventService/ListStatusTransitions", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runVenueEvents(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("venues events", flag.ContinueOnError)
+	var p pageFlags
+	p.register(fs)
+	id := fs.String("id", "", "ID of the venue")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+	venueID, err := parseID(*id)
+	if err != nil {
+		return err
+	}
+
+	q := p.query(a, nil)
+	req := &eventservice.ListEventsByVenueRequest{VenueId: venueID, Page: q.page, PageSize: q.limit, GamesId: q.gamesID, Fields: q.fields, PageToken: q.pageToken}
+	var resp eventservice.GetAllEventsResponse
+	c := call{method: http.MethodGet, path: "/venues/" + *id + "/events", query: q.values("page_size"), backend: "event", rpc: "/event_service.EventService/ListEventsByVenue", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func (r resource) runGet(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet(r.name+" get", flag.ContinueOnError)
+	id := fs.String("id", "", "ID of the "+strings.ToLower(r.single))
+	fieldList := fs.String("fields", "", "fields to return, comma separated")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+
+	mask := fieldMask(*fieldList)
+	req, err := r.get(*id, mask)
+	if err != nil {
+		return err
+	}
+	query := url.Values{"id": {*id}}
+	if mask != nil {
+		query.Set("fields", *fieldList)
+		a.out.fields = mask.Paths
+	}
+	resp := r.getResponse()
+	c := call{method: http.MethodGet, path: "/" + r.name + "/get", query: query, backend: r.backend, rpc: r.rpc("Get" + r.single), request: req}
+	if err := a.transport.invoke(ctx, c, resp); err != nil {
+		return err
+	}
+	return a.out.print(resp)
+}
+
+// runWrite returns the add command, or the edit command when edit is set.
+func (r resource) runWrite(edit bool) func(context.Context, *app, []string) error {
+	verb, method, httpMethod := "add", "Add", http.MethodPost
+	if edit {
+		verb, method, httpMethod = "edit", "Edit", http.MethodPut
+	}
+	return func(ctx context.Context, a *app, args []string) error {
+		fs := flag.NewFlagSet(r.name+" "+verb, flag.ContinueOnError)
+		file := fs.String("f", "", "JSON or YAML file with the "+strings.ToLower(r.single)+", - for stdin")
+		if err := parse(fs, args); err != nil {
+			return err
+		}
+		if *file == "" {
+			return errUsage
+		}
+
+		item := r.item()
+		if err := readDocument(*file, item); err != nil {
+			return err
+		}
+		req := item
+		if r.wrap != nil {
+			req = r.wrap(item, edit)
+		}
+		resp := r.written()
+		c := call{method: httpMethod, path: "/" + r.name + "/" + verb, backend: r.backend, rpc: r.rpc(method + r.single), request: req}
+		if err := a.transport.invoke(ctx, c, resp); err != nil {
+			return err
+		}
+		return a.out.print(resp)
+	}
+}
+
+func (r resource) runDelete(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet(r.name+" delete", flag.ContinueOnError)
+	id := fs.String("id", "", "ID of the "+strings.ToLower(r.single))
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+
+	req, err := r.remove(*id)
+	if err != nil {
+		return err
+	}
+	resp := r.message()
+	c := call{method: http.MethodDelete, path: "/" + r.name + "/delete", query: url.Values{"id": {*id}}, backend: r.backend, rpc: r.rpc("Delete" + r.single), request: req}
+	if err := a.transport.invoke(ctx, c, resp); err != nil {
+		return err
+	}
+	return a.out.print(resp)
+}
+
+func (r resource) runImport(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet(r.name+" import", flag.ContinueOnError)
+	file := fs.String("f", "", "CSV or NDJSON file, - for stdin")
+	format := fs.String("format", "", "csv or ndjson; inferred from the file extension by default")
+	dryRun := fs.Bool("dry-run", false, "validate only, nothing is written")
+	mode := fs.String("mode", "atomic", "atomic (all or nothing) or chunked")
+	chunkSize := fs.Int("chunk-size", 0, "rows per chunk in chunked mode")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errUsage
+	}
+	if *mode != "atomic" && *mode != "chunked" {
+		return errors.New("mode must be atomic or chunked")
+	}
+	if *format == "" {
+		*format = "csv"
+		switch strings.ToLower(filepath.Ext(*file)) {
+		case ".ndjson", ".jsonl", ".json":
+			*format = "ndjson"
+		}
+	}
+
+	data, closeFile, err := open(*file)
+	if err != nil {
+		return err
+	}
+	defer closeFile()
+
+	query := url.Values{"format": {*format}, "mode": {*mode}, "dry_run": {strconv.FormatBool(*dryRun)}}
+	if *chunkSize > 0 {
+		query.Set("chunk_size", strconv.Itoa(*chunkSize))
+	}
+	opts := importOptions{format: *format, dryRun: *dryRun, chunked: *mode == "chunked", chunkSize: int32(*chunkSize), gamesID: a.opts.gamesID}
+	report := r.report()
+	c := call{path: "/" + r.name + "/import", query: query, backend: r.backend, rpc: r.rpc("Import" + r.plural), chunk: r.imports(opts)}
+	if err := a.transport.upload(ctx, c, data, report); err != nil {
+		return err
+	}
+	if err := a.out.print(report); err != nil {
+		return err
+	}
+	if report.GetFailed() > 0 && !opts.dryRun && !opts.chunked {
+		return fmt.Errorf("import rejected: %d invalid rows, nothing was imported", report.GetFailed())
+	}
+	return nil
+}
+
+func (r resource) runExport(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet(r.name+" export", flag.ContinueOnError)
+	format := fs.String("format", "csv", "csv, ndjson or xlsx")
+	out := fs.String("out", "", "file to write; stdout by default")
+	filters := filterFlags(fs, r.exportFilters())
+	var ranking *bool
+	if r.name == "medals" {
+		ranking = fs.Bool("ranking", false, "export the medal ranking instead of the medals")
+	}
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if a.opts.direct && *format != "ndjson" {
+		return errors.New("-grpc exports ndjson only")
+	}
+
+	q := listQuery{filters: filters(), gamesID: a.opts.gamesID}
+	req, item, err := r.export(q)
+	if err != nil {
+		return err
+	}
+	query := url.Values{"format": {*format}}
+	for name, values := range q.filters {
+		query[name] = values
+	}
+	c := call{path: "/" + r.name + "/export", query: query, backend: r.backend, rpc: r.rpc("Export" + r.plural), request: req, item: item}
+	if ranking != nil && *ranking {
+		c.path = "/medals/ranking/export"
+		c.query = url.Values{"format": {*format}}
+		c.rpc = r.rpc("ExportMedalRanking")
+		c.request = &medalservice.RankingRequest{GamesId: a.opts.gamesID}
+		c.item = func() interface{} { return &medalservice.CountryMedalCount{} }
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return a.transport.download(ctx, c, w)
+}
+
+func rankingCommand() command {
+	return command{
+		name:  "medals ranking",
+		usage: "(countries ranked by medals, scoped by -games)",
+		run: func(ctx context.Context, a *app, args []string) error {
+			fs := flag.NewFlagSet("medals ranking", flag.ContinueOnError)
+			if err := parse(fs, args); err != nil {
+				return err
+			}
+			var resp medalservice.MedalRankingResponse
+			c := call{method: http.MethodGet, path: "/medals/ranking", backend: "medal", rpc: "/medal_service.MedalService/GetMedalRanking", request: &medalservice.RankingRequest{GamesId: a.opts.gamesID}}
+			if err := a.transport.invoke(ctx, c, &resp); err != nil {
+				return err
+			}
+			return a.out.print(&resp)
+		},
+	}
+}
+
+// readDocument decodes a JSON or YAML file into v. YAML is converted to
+// JSON first, so both use the JSON field names.
+func readDocument(name string, v interface{}) error {
+	r, closeFile, err := open(name)
+	if err != nil {
+		return err
+	}
+	defer closeFile()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if json.Valid(data) {
+		return json.Unmarshal(data, v)
+	}
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("%s is neither JSON nor YAML: %v", name, err)
+	}
+	if data, err = json.Marshal(doc); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// open opens a file, or stdin for "-".
+func open(name string) (io.Reader, func() error, error) {
+	if name == "-" {
+		return os.Stdin, func() error { return nil }, nil
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
+
+func fieldMask(list string) *types.FieldMask {
+	var paths []string
+	for _, path := range strings.Split(list, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return &types.FieldMask{Paths: paths}
+}
+
+func parseID(id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q", id)
+	}
+	return n, nil
+}
+
+func int64Filter(filters url.Values, name string) (int64, error) {
+	v := filters.Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, v)
+	}
+	return n, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	authservice "olympy/api-gateway/genproto/auth_service"
+)
+
+// session caches the tokens of the last login, so that later commands are
+// authenticated.
+type session struct {
+	path string
+
+	Server       string            `json:"server"`
+	AccessToken  string            `json:"access_token"`
+	RefreshToken string            `json:"refresh_token"`
+	User         *authservice.User `json:"user,omitempty"`
+}
+
+func defaultSessionFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".olympyctl-session.json"
+	}
+	return filepath.Join(dir, "olympyctl", "session.json")
+}
+
+// loadSession reads the session cached in path; there is none before the
+// first login.
+func loadSession(path string) (*session, error) {
+	s := &session{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// save writes the session readable only by the current user, since the
+// tokens grant its role.
+func (s *session) save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+func (s *session) clear() error {
+	err := os.Remove(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// expired reports whether the access token has expired or is about to. The
+// gateway verifies the token; the expiry is only read to refresh it in time.
+func (s *session) expired() bool {
+	parts := strings.Split(s.AccessToken, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return false
+	}
+	return time.Now().Add(30 * time.Second).After(time.Unix(claims.Exp, 0))
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	streamservice "olympy/api-gateway/genproto/stream_service"
+)
+
+func tailCommand() command {
+	return command{
+		name:  "tail",
+		usage: "-event ID [-since COMMENTARY_ID] (follow live commentary until interrupted)",
+		run:   runTail,
+	}
+}
+
+func runTail(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	eventID := fs.String("event", "", "event ID")
+	since := fs.String("since", "", "commentary ID to resume after; live commentary only by default")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *eventID == "" {
+		return errUsage
+	}
+
+	return a.transport.tail(ctx, *eventID, *since, func(msg *streamservice.Commentary) error {
+		// Commentary is printed as it arrives: one line per message in
+		// tables, one document per message otherwise.
+		if a.out.format == "table" {
+			_, err := fmt.Fprintf(a.out.w, "%s  %s  %s\n", msg.Timestamp, msg.Id, msg.Text)
+			return err
+		}
+		return a.out.print(msg)
+	})
+}
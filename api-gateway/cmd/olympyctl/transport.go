@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	streamservice "olympy/api-gateway/genproto/stream_service"
+)
+
+// call is a request to the platform, described both as the gateway route
+// and as the gRPC method the gateway forwards it to.
+type call struct {
+	method string // HTTP method of the gateway route
+	path   string // gateway route under /api/v1
+	query  url.Values
+	// body is the JSON body of POST and PUT routes; request when nil.
+	body interface{}
+
+	backend string // service serving rpc, e.g. "medal"
+	rpc     string // full gRPC method name
+	request interface{}
+
+	// item returns a new message of an export stream, and chunk the
+	// message carrying data to an import stream.
+	item  func() interface{}
+	chunk func(data []byte, first bool) interface{}
+}
+
+// transport sends calls to the gateway or to the services.
+type transport interface {
+	// invoke makes a unary call and decodes its result into resp.
+	invoke(ctx context.Context, c call, resp interface{}) error
+	// upload streams data to an import call and decodes its report into
+	// resp.
+	upload(ctx context.Context, c call, data io.Reader, resp interface{}) error
+	// download writes the result of an export call to w.
+	download(ctx context.Context, c call, w io.Writer) error
+	// tail passes the commentary of an event, starting after lastID, to
+	// each until ctx is done.
+	tail(ctx context.Context, eventID, lastID string, each func(*streamservice.Commentary) error) error
+}
+
+// backend is a service that -grpc dials.
+type backend struct {
+	name string
+	addr string
+}
+
+var backends = []backend{
+	{"auth", "localhost:2222"},
+	{"event", "localhost:4444"},
+	{"medal", "localhost:5555"},
+	{"athlete", "localhost:6666"},
+	{"stream", "localhost:8777"},
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	authservice "olympy/api-gateway/genproto/auth_service"
+)
+
+func authCommands() []command {
+	return []command{
+		{name: "login", usage: "-u USERNAME [-password P] (password also read from OLYMPYCTL_PASSWORD or stdin)", run: runLogin},
+		{name: "logout", usage: "(forget the cached tokens)", run: runLogout},
+		{name: "whoami", usage: "(user of the cached tokens)", run: runWhoami},
+	}
+}
+
+func runLogin(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("login", flag.ContinueOnError)
+	username := fs.String("u", "", "username")
+	password := fs.String("password", os.Getenv("OLYMPYCTL_PASSWORD"), "password")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *username == "" {
+		return errUsage
+	}
+	if *password == "" {
+		var err error
+		if *password, err = readPassword(); err != nil {
+			return err
+		}
+	}
+
+	var resp authservice.LoginUserResponse
+	req := &authservice.LoginUserRequest{Username: *username, Password: *password}
+	c := call{method: http.MethodPost, path: "/auth/login", backend: "auth", rpc: loginRPC, request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	if resp.AccessToken == "" {
+		return errors.New("login failed: no token issued")
+	}
+
+	s := a.session
+	s.Server = strings.TrimSuffix(a.opts.server, "/")
+	s.AccessToken, s.RefreshToken, s.User = resp.AccessToken, resp.RefreshToken, resp.User
+	if err := s.save(); err != nil {
+		return err
+	}
+	return a.out.print(resp.User)
+}
+
+// readPassword reads the password from the first line of stdin. The input
+// is echoed when stdin is a terminal.
+func readPassword() (string, error) {
+	fmt.Fprint(os.Stderr, "Password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.New("no password given")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func runLogout(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("logout", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	return a.session.clear()
+}
+
+func runWhoami(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("whoami", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if a.session.User == nil {
+		return errors.New("not logged in")
+	}
+	return a.out.print(a.session.User)
+}
+
+func userCommands() []command {
+	return []command{
+		{name: "users list", usage: "[-page N] [-limit N] [-role user|admin] [-page-token T]", run: runListUsers},
+		{name: "users get", usage: "-id ID", run: runGetUser},
+		{name: "users add", usage: "-u USERNAME -password P [-role user|admin]", run: runAddUser},
+		{name: "users set-role", usage: "-id ID -role user|admin", run: runSetUserRole},
+		{name: "users delete", usage: "-id ID", run: runDeleteUser},
+	}
+}
+
+func runListUsers(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("users list", flag.ContinueOnError)
+	page := fs.Int("page", 1, "page number")
+	limit := fs.Int("limit", 10, "number of users per page")
+	role := fs.String("role", "", "only users with this role")
+	pageToken := fs.String("page-token", "", "next_page_token of the previous page; overrides -page")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	query := url.Values{"page": {strconv.Itoa(*page)}, "limit": {strconv.Itoa(*limit)}}
+	if *role != "" {
+		query.Set("role", *role)
+	}
+	if *pageToken != "" {
+		query.Set("page_token", *pageToken)
+	}
+	var resp authservice.ListUsersResponse
+	req := &authservice.ListUsersRequest{Page: int32(*page), Limit: int32(*limit), Role: *role, PageToken: *pageToken}
+	c := call{method: http.MethodGet, path: "/users/getall", query: query, backend: "auth", rpc: "/auth_service.AuthService/ListUsers", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runGetUser(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("users get", flag.ContinueOnError)
+	id := fs.String("id", "", "user ID")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+
+	var resp authservice.User
+	c := call{method: http.MethodGet, path: "/users/get", query: url.Values{"id": {*id}}, backend: "auth", rpc: "/auth_service.AuthService/GetUser", request: &authservice.GetUserRequest{Id: *id}}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+// runAddUser registers a user. The gateway queues registrations, so the
+// user may appear in users list a moment later.
+func runAddUser(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("users add", flag.ContinueOnError)
+	username := fs.String("u", "", "username")
+	password := fs.String("password", "", "password")
+	role := fs.String("role", "user", "user or admin")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *username == "" || *password == "" {
+		return errUsage
+	}
+
+	var resp authservice.RegisterUserResponse
+	req := &authservice.RegisterUserRequest{Username: *username, Password: *password, Role: *role}
+	c := call{method: http.MethodPost, path: "/auth/register", backend: "auth", rpc: "/auth_service.AuthService/RegisterUser", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runSetUserRole(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("users set-role", flag.ContinueOnError)
+	id := fs.String("id", "", "user ID")
+	role := fs.String("role", "", "user or admin")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" || *role == "" {
+		return errUsage
+	}
+
+	var resp authservice.User
+	req := &authservice.SetUserRoleRequest{Id: *id, Role: *role}
+	c := call{method: http.MethodPut, path: "/users/role", backend: "auth", rpc: "/auth_service.AuthService/SetUserRole", request: req}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
+
+func runDeleteUser(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("users delete", flag.ContinueOnError)
+	id := fs.String("id", "", "user ID")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errUsage
+	}
+
+	var resp authservice.DeleteUserResponse
+	c := call{method: http.MethodDelete, path: "/users/delete", query: url.Values{"id": {*id}}, backend: "auth", rpc: "/auth_service.AuthService/DeleteUser", request: &authservice.GetUserRequest{Id: *id}}
+	if err := a.transport.invoke(ctx, c, &resp); err != nil {
+		return err
+	}
+	return a.out.print(&resp)
+}
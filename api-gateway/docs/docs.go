@@ -42,6 +42,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries of this request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -57,6 +63,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
                         }
                     },
+                    "422": {
+                        "description": "Idempotency-Key reused with a different payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -166,6 +178,66 @@ const docTemplate = `{
                 }
             }
         },
+        "/athletes/export": {
+            "get": {
+                "description": "Downloads every athlete matching the filters of the athlete list as CSV, NDJSON or an Excel workbook.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Athlete"
+                ],
+                "summary": "Export athletes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv (default), ndjson or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Country ID filter",
+                        "name": "country_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sport type filter",
+                        "name": "sport_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    }
+                }
+            }
+        },
         "/athletes/get": {
             "get": {
                 "description": "This endpoint retrieves an athlete by its ID.",
@@ -186,6 +258,18 @@ const docTemplate = `{
                         "name": "id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -249,6 +333,30 @@ const docTemplate = `{
                         "description": "Sport type filter",
                         "name": "sport_type",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -273,6 +381,149 @@ const docTemplate = `{
                 }
             }
         },
+        "/athletes/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Imports athletes with the columns name, country and sport_type. country may be the country ID, name or NOC code.\nThe body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Athlete"
+                ],
+                "summary": "Import athletes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv or ndjson; defaults to the Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Check the rows without importing them",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "atomic (default) or chunked",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rows per transaction in chunked mode",
+                        "name": "chunk_size",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON data",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.ImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/athletes/{id}/profile": {
+            "get": {
+                "description": "This endpoint returns an athlete with their country and medals. Parts that could not be loaded are listed in \"errors\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Athlete"
+                ],
+                "summary": "Get athlete profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Athlete ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_handlers_composite-handlers.AthleteProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Message"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/login": {
             "post": {
                 "description": "This endpoint for logging in user.",
@@ -567,6 +818,18 @@ const docTemplate = `{
                         "name": "id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -618,6 +881,24 @@ const docTemplate = `{
                         "description": "Number of items per page",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -642,32 +923,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/add": {
+        "/countries/import": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint adds a new event.",
+                "description": "Imports countries with the columns name, flag and noc_code (optional).\nThe body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Country"
                 ],
-                "summary": "Add an event",
+                "summary": "Import countries",
                 "parameters": [
                     {
-                        "description": "Event details to add",
-                        "name": "request",
+                        "type": "string",
+                        "description": "csv or ndjson; defaults to the Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Check the rows without importing them",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "atomic (default) or chunked",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rows per transaction in chunked mode",
+                        "name": "chunk_size",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON data",
+                        "name": "file",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.AddEventRequest"
+                            "type": "string"
                         }
                     }
                 ],
@@ -675,32 +980,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.AddEventResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.ImportReport"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Message"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.ImportReport"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Message"
                         }
                     }
                 }
             }
         },
-        "/events/delete": {
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "This endpoint deletes an event by its ID.",
+        "/countries/{id}/profile": {
+            "get": {
+                "description": "This endpoint returns a country with its athletes, its medals and its position in the medal ranking. Parts that could not be loaded are listed in \"errors\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -708,48 +1014,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Country"
                 ],
-                "summary": "Delete an event",
+                "summary": "Get country profile",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID to delete",
+                        "type": "integer",
+                        "description": "Country ID",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                            "$ref": "#/definitions/api_handlers_composite-handlers.CountryProfile"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Message"
                         }
                     }
                 }
             }
         },
-        "/events/edit": {
-            "put": {
+        "/events/add": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint edits an existing event.",
+                "description": "This endpoint adds a new event. A venue_id assigns it to a venue, which must be able to host its sport, and location to a field of play within it. Events clashing with the schedule are answered with 409 and the conflicts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -759,23 +1083,29 @@ const docTemplate = `{
                 "tags": [
                     "Event"
                 ],
-                "summary": "Edit an event",
+                "summary": "Add an event",
                 "parameters": [
                     {
-                        "description": "Event details to edit",
+                        "description": "Event details to add",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.EditEventRequest"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.AddEventRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.EditEventResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.AddEventResponse"
                         }
                     },
                     "400": {
@@ -784,6 +1114,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -793,33 +1129,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/get": {
+        "/events/calendar.ics": {
             "get": {
-                "description": "This endpoint retrieves an event by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the events as an iCalendar (.ics) file that calendar apps can subscribe to. Events keep their UID across changes, and their SEQUENCE grows when they are rescheduled or cancelled, so that calendar apps update them instead of adding copies.\nWith the token of a calendar subscription, the feed has the subscription's filters and the filter parameters are ignored.",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "Event"
+                    "Calendar"
                 ],
-                "summary": "Get an event",
+                "summary": "Schedule as an iCalendar feed",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID to retrieve",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Private token of a calendar subscription",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sport type",
+                        "name": "sport_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Matches the name or sport type",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events ending after, a date such as 2024-07-27 or an RFC 3339 time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events starting before, a date (inclusive) or an RFC 3339 time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetEventResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -828,6 +1190,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -837,9 +1205,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/getall": {
-            "get": {
-                "description": "This endpoint retrieves all events with pagination.",
+        "/events/calendar/subscriptions/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a private feed of the events matching filter for the caller. The url, which holds the feed's token, is only returned by this endpoint; anyone with it can read the feed until the subscription is deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -847,30 +1220,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Calendar"
                 ],
-                "summary": "Get all events",
+                "summary": "Create a calendar subscription",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of items per page",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Name and filter of the feed",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.CalendarSubscription"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetAllEventsResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.CalendarSubscription"
                         }
                     },
                     "400": {
@@ -888,46 +1256,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/search": {
-            "get": {
-                "description": "This endpoint searches events by query with pagination.",
-                "consumes": [
-                    "application/json"
+        "/events/calendar/subscriptions/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Deletes one of the caller's calendar subscriptions, which revokes its feed URL.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Event"
+                    "Calendar"
                 ],
-                "summary": "Search events",
+                "summary": "Delete a calendar subscription",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of items per page",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetAllEventsResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "400": {
@@ -936,6 +1293,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -945,65 +1308,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/medals/add": {
-            "post": {
+        "/events/calendar/subscriptions/getall": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint adds a new medal.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the caller's calendar subscriptions, newest first, without their tokens.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Medal"
-                ],
-                "summary": "Add a medal",
-                "parameters": [
-                    {
-                        "description": "Medal details to add",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
-                        }
-                    }
+                    "Calendar"
                 ],
+                "summary": "List calendar subscriptions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ListCalendarSubscriptionsResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/medals/delete": {
+        "/events/delete": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint deletes a medal by its ID.",
+                "description": "This endpoint deletes an event by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1011,13 +1354,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medal"
+                    "Event"
                 ],
-                "summary": "Delete a medal",
+                "summary": "Delete an event",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medal ID to delete",
+                        "description": "Event ID to delete",
                         "name": "id",
                         "in": "query",
                         "required": true
@@ -1027,32 +1370,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/medals/edit": {
+        "/events/edit": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint edits an existing medal.",
+                "description": "This endpoint edits an existing event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1060,89 +1403,117 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medal"
+                    "Event"
                 ],
-                "summary": "Edit a medal",
+                "summary": "Edit an event",
                 "parameters": [
                     {
-                        "description": "Medal details to edit",
+                        "description": "Event details to edit",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.EditEventRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.EditEventResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/medals/get": {
+        "/events/export": {
             "get": {
-                "description": "This endpoint retrieves a medal by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Downloads all events, or those whose name or sport type matches query, as CSV, NDJSON or an Excel workbook.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Medal"
+                    "Event"
                 ],
-                "summary": "Get a medal",
+                "summary": "Export events",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medal ID to retrieve",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "csv (default), ndjson or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                            "type": "file"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/medals/getall": {
+        "/events/get": {
             "get": {
-                "description": "This endpoint retrieves all medals with pagination and optional filters.",
+                "description": "This endpoint retrieves an event by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1150,9 +1521,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medal"
+                    "Event"
                 ],
-                "summary": "List medals",
+                "summary": "Get an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID to retrieve",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/getall": {
+            "get": {
+                "description": "This endpoint retrieves all events with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Get all events",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1165,25 +1598,37 @@ const docTemplate = `{
                         "type": "integer",
                         "default": 10,
                         "description": "Number of items per page",
-                        "name": "limit",
+                        "name": "page_size",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Country ID",
-                        "name": "country",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Event ID",
-                        "name": "event_id",
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Athlete ID",
-                        "name": "athlete_id",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
                         "in": "query"
                     }
                 ],
@@ -1191,61 +1636,119 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.ListResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetAllEventsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/medals/ranking": {
-            "get": {
-                "description": "This endpoint retrieves the ranking of countries based on medals.",
+        "/events/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Imports events with the columns name, sport_type, start_time and end_time.\nThe body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Medal"
+                    "Event"
+                ],
+                "summary": "Import events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv or ndjson; defaults to the Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Check the rows without importing them",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "atomic (default) or chunked",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rows per transaction in chunked mode",
+                        "name": "chunk_size",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON data",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get medal rankings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.MedalRankingResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ImportReport"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
         },
-        "/stream/send": {
-            "post": {
+        "/events/schedule/validate": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "This endpoint sends an event to the streaming service.",
+                "description": "This endpoint reports the conflicts among the events of a day or an edition: events at the same location without the changeover between them, and more events of a sport at once than its rules allow.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1253,60 +1756,3424 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live Streaming"
+                    "Event"
                 ],
-                "summary": "Send an event to the streaming service",
+                "summary": "Validate the schedule",
                 "parameters": [
                     {
-                        "description": "Event details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Day, e.g. 2024-07-27",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID; one of date and games_id is required",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventRequest"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ValidateScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
+                }
+            }
+        },
+        "/events/search": {
+            "get": {
+                "description": "This endpoint searches events by query with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Search events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetAllEventsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "olympy_api-gateway_genproto_athlete_service.Athlete": {
-            "type": "object",
-            "properties": {
+        },
+        "/events/status": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint moves an event to another status: scheduled, live, delayed, suspended, completed or cancelled. Transitions the lifecycle does not allow, such as from a completed event, are answered with 409. The change is recorded with the reason and the caller, and published to the live commentary of the event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Change the status of an event",
+                "parameters": [
+                    {
+                        "description": "Event, status and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.TransitionEventStatusRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.TransitionEventStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/detail": {
+            "get": {
+                "description": "This endpoint returns an event with its medals, medalists and their countries. Parts that could not be loaded are listed in \"errors\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "Get event detail",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_handlers_composite-handlers.EventDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/status/history": {
+            "get": {
+                "description": "This endpoint retrieves the status history of an event, oldest first. Automatic transitions have the actor \"system\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "List the status transitions of an event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ListStatusTransitionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds an edition. Dates are YYYY-MM-DD; status defaults to planned. Only one edition may exist per year and season.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Add an Olympic Games edition",
+                "parameters": [
+                    {
+                        "description": "Edition details to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes an edition. Editions that events, athletes or medals still refer to cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Delete an Olympic Games edition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Edition ID to delete",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/edit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces an edition's details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Edit an Olympic Games edition",
+                "parameters": [
+                    {
+                        "description": "Edition details to edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/get": {
+            "get": {
+                "description": "Retrieves an edition by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Get an Olympic Games edition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Edition ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/games/getall": {
+            "get": {
+                "description": "Retrieves the editions newest first, with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "List Olympic Games editions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "summer or winter",
+                        "name": "season",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "planned, ongoing or completed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint adds a new medal.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Add a medal",
+                "parameters": [
+                    {
+                        "description": "Medal details to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries of this request safe",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency-Key reused with a different payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint deletes a medal by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Delete a medal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medal ID to delete",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/edit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint edits an existing medal.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Edit a medal",
+                "parameters": [
+                    {
+                        "description": "Medal details to edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/export": {
+            "get": {
+                "description": "Downloads every medal matching the filters of the medal list as CSV, NDJSON or an Excel workbook.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Export medals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv (default), ndjson or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Country ID",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Athlete ID",
+                        "name": "athlete_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/get": {
+            "get": {
+                "description": "This endpoint retrieves a medal by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Get a medal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medal ID to retrieve",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/getall": {
+            "get": {
+                "description": "This endpoint retrieves all medals with pagination and optional filters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "List medals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Country ID",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Athlete ID",
+                        "name": "athlete_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Imports medals with the columns country, type, event_id and athlete_id. country may be the country ID, name or NOC code.\nThe body is CSV with a header row, or NDJSON. Without dry_run, mode=atomic (the default) imports nothing when any row is invalid and answers 422, while mode=chunked imports the valid rows in transactions of chunk_size rows.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Import medals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv or ndjson; defaults to the Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Check the rows without importing them",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "atomic (default) or chunked",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Rows per transaction in chunked mode",
+                        "name": "chunk_size",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON data",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.ImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/ranking": {
+            "get": {
+                "description": "This endpoint retrieves the ranking of countries based on medals.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Get medal rankings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.MedalRankingResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/medals/ranking/export": {
+            "get": {
+                "description": "Downloads the ranking of countries based on medals as CSV, NDJSON or an Excel workbook.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Medal"
+                ],
+                "summary": "Export the medal ranking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv (default), ndjson or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/events/{event_id}/sse": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Relays the commentary of an event as Server-Sent Events. Each message is a \"commentary\" event whose id is the commentary ID and whose data is the commentary as JSON.\nReconnecting clients resume after the Last-Event-ID header (or the last_event_id query parameter) from the stored history. Keep-alive comments are sent while the event is quiet.\nEventSource cannot set headers, so the token may also be passed as the access_token query parameter.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Live Streaming"
+                ],
+                "summary": "Follow an event's live commentary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Commentary ID to resume after",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Commentary ID to resume after",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.Commentary"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/send": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "This endpoint sends an event to the streaming service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Streaming"
+                ],
+                "summary": "Send an event to the streaming service",
+                "parameters": [
+                    {
+                        "description": "Event details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StreamEventResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/ws": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Proxies the streaming service's WebSocket. Each message is a commentary as JSON: {\"id\", \"event_id\", \"text\", \"timestamp\", \"status_change\"}.\nSubscribe to events with repeated event_id parameters; without any, the connection receives every event, which only admins may do. Each event is authorized separately.\nBrowsers cannot set headers on the handshake, so the token may instead be offered as a \"bearer.\u003ctoken\u003e\" subprotocol, alongside \"olympy.v1\".",
+                "tags": [
+                    "Live Streaming"
+                ],
+                "summary": "Follow live commentary over WebSocket",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Event IDs to follow",
+                        "name": "event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes the name of a country, event or sport in a locale; responses fall back to the next locale.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Delete a translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "country, event or sport",
+                        "name": "entity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Country or event ID, or sport type",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, e.g. fr",
+                        "name": "locale",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/getall": {
+            "get": {
+                "description": "Retrieves the translations of countries, events or sports with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "List translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "country, event or sport",
+                        "name": "entity",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Country or event ID, or sport type",
+                        "name": "key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale, e.g. fr",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/set": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds or replaces the name of a country, event or sport in a locale. The key is the country or event ID, or the English sport type. Locales are BCP 47 tags such as \"fr\" or \"fr-CA\" and are stored lower-case.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Set a translation",
+                "parameters": [
+                    {
+                        "description": "Translation to set",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Translation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Translation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to delete",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a user and their role by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getall": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Lists the registered users with their roles, ordered by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of users per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only users with this role (user or admin)",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.ListUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/role": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sets the role of a user to user or admin. Tokens issued before keep the old role until they expire.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Change a user's role",
+                "parameters": [
+                    {
+                        "description": "User ID and new role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.SetUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.DeleteUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a venue. The timezone is an IANA time zone such as Europe/Paris; sports lists the sport types the venue can host, any when empty. Only one venue of a name may exist per city.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venue"
+                ],
+                "summary": "Add a venue",
+                "parameters": [
+                    {
+                        "description": "Venue details to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a venue. Venues that events are held at cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venue"
+                ],
+                "summary": "Delete a venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID to delete",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/edit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces a venue's details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venue"
+                ],
+                "summary": "Edit a venue",
+                "parameters": [
+                    {
+                        "description": "Venue details to edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/get": {
+            "get": {
+                "description": "Retrieves a venue by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venue"
+                ],
+                "summary": "Get a venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/getall": {
+            "get": {
+                "description": "Retrieves the venues by name, with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venue"
+                ],
+                "summary": "List venues",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sport type the venues can host",
+                        "name": "sport",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/{id}/events": {
+            "get": {
+                "description": "This endpoint retrieves the events held at a venue by start time, with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Event"
+                ],
+                "summary": "List events at a venue",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Venue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Olympic Games edition ID",
+                        "name": "games_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of names, e.g. fr-CA, ar;q=0.5",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fields to return, comma separated; all when empty",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time zone of the times returned, e.g. Europe/Paris, or venue for each event's venue; UTC when empty",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.GetAllEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/add": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Registers a partner endpoint. event_types filters the notifications (medal.created, medal.updated, medal.deleted, event.updated, event.rescheduled, event.status_changed); an empty list subscribes to all of them.\nThe signing secret is generated when empty. It is only returned by this endpoint and by edits that set a new one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Register a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook details to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a webhook together with its deliveries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID to delete",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the delivery log, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "webhook_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending, succeeded or failed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type, e.g. medal.created",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.ListDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a delivery with its payload and the log of every attempt.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queues a delivery again right away, with a fresh set of retries, whatever its status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Redeliver a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/edit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces a webhook's settings. The secret is kept when empty.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Edit a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook details to edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/get": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a webhook by its ID, without its secret.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/getall": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all webhooks with pagination, without their secrets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhooks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from next_page_token of the previous page; overrides page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Message"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api_handlers_composite-handlers.AthleteProfile": {
+            "type": "object",
+            "properties": {
+                "athlete": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
+                },
+                "country": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Country"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_handlers_composite-handlers.PartialError"
+                    }
+                },
+                "medals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                    }
+                }
+            }
+        },
+        "api_handlers_composite-handlers.CountryProfile": {
+            "type": "object",
+            "properties": {
+                "athletes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
+                    }
+                },
+                "country": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Country"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_handlers_composite-handlers.PartialError"
+                    }
+                },
+                "medals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                    }
+                },
+                "ranking": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.CountryMedalCount"
+                }
+            }
+        },
+        "api_handlers_composite-handlers.EventDetail": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_handlers_composite-handlers.PartialError"
+                    }
+                },
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                },
+                "medalists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_handlers_composite-handlers.Medalist"
+                    }
+                }
+            }
+        },
+        "api_handlers_composite-handlers.Medalist": {
+            "type": "object",
+            "properties": {
+                "athlete": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
+                },
+                "country": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Country"
+                },
+                "medal": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                }
+            }
+        },
+        "api_handlers_composite-handlers.PartialError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_athlete_service.Athlete": {
+            "type": "object",
+            "properties": {
+                "country_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "games_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sport_type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_athlete_service.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_athlete_service.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_athlete_service.ListResponse": {
+            "type": "object",
+            "properties": {
+                "athletes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "next_page_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_athlete_service.Message": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.DeleteUserResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.ListUsersResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "next_page_token": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
+                    }
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.LoginUserRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.LoginUserResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.RefreshTokenRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.RefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.RegisterUserRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.RegisterUserResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.SetUserRoleRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_auth_service.User": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_country_service.Country": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "flag": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "noc_code": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_country_service.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_country_service.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_country_service.ListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Country"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_country_service.Message": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.AddEventRequest": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.AddEventResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.CalendarFilter": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "games_id": {
+                    "type": "integer"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "sport_type": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.CalendarSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.CalendarFilter"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.EditEventRequest": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.EditEventResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.Event": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "games_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sequence": {
+                    "type": "integer"
+                },
+                "sport_type": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "venue_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.GetAllEventsResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string"
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.GetEventResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ListCalendarSubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.CalendarSubscription"
+                    }
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ListStatusTransitionsResponse": {
+            "type": "object",
+            "properties": {
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.StatusTransition"
+                    }
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.Message": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ScheduleConflict": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                    }
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.StatusTransition": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.TransitionEventStatusRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.TransitionEventStatusResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                },
+                "transition": {
+                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.StatusTransition"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_event_service.ValidateScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer"
+                },
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.ScheduleConflict"
+                    }
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_games_service.Games": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "host_city": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "season": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_games_service.ListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_games_service.Games"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_games_service.Message": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_medal_service.CountryMedalCount": {
+            "type": "object",
+            "properties": {
+                "bronze": {
+                    "type": "integer"
+                },
+                "country_id": {
+                    "type": "integer"
+                },
+                "country_name": {
+                    "type": "string"
+                },
+                "gold": {
+                    "type": "integer"
+                },
+                "ranking": {
+                    "type": "integer"
+                },
+                "silver": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_medal_service.ImportReport": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.ImportRowError"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_medal_service.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_medal_service.ListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "medals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "olympy_api-gateway_genproto_medal_service.Medal": {
+            "type": "object",
+            "properties": {
+                "athlete_id": {
+                    "type": "string"
+                },
                 "country_id": {
                     "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "event_id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "games_id": {
+                    "type": "integer"
                 },
-                "sport_type": {
+                "id": {
+                    "type": "integer"
+                },
+                "type": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -1314,21 +5181,18 @@ const docTemplate = `{
                 }
             }
         },
-        "olympy_api-gateway_genproto_athlete_service.ListResponse": {
+        "olympy_api-gateway_genproto_medal_service.MedalRankingResponse": {
             "type": "object",
             "properties": {
-                "athletes": {
+                "country_medal_counts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/olympy_api-gateway_genproto_athlete_service.Athlete"
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.CountryMedalCount"
                     }
-                },
-                "count": {
-                    "type": "integer"
                 }
             }
         },
-        "olympy_api-gateway_genproto_athlete_service.Message": {
+        "olympy_api-gateway_genproto_medal_service.Message": {
             "type": "object",
             "properties": {
                 "message": {
@@ -1336,104 +5200,110 @@ const docTemplate = `{
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.LoginUserRequest": {
+        "olympy_api-gateway_genproto_stream_service.Commentary": {
             "type": "object",
             "properties": {
-                "password": {
+                "event_id": {
                     "type": "string"
                 },
-                "username": {
+                "id": {
+                    "type": "string"
+                },
+                "status_change": {
+                    "description": "Set when the message announces a change of the event's status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StatusChange"
+                        }
+                    ]
+                },
+                "text": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "RFC 3339",
                     "type": "string"
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.LoginUserResponse": {
+        "olympy_api-gateway_genproto_stream_service.StatusChange": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "from_status": {
                     "type": "string"
                 },
-                "message": {
+                "reason": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "to_status": {
                     "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.RefreshTokenRequest": {
+        "olympy_api-gateway_genproto_stream_service.StreamEventRequest": {
             "type": "object",
             "properties": {
-                "refresh_token": {
+                "event_id": {
+                    "type": "string"
+                },
+                "status_change": {
+                    "description": "Set when the message announces a change of the event's status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/olympy_api-gateway_genproto_stream_service.StatusChange"
+                        }
+                    ]
+                },
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.RefreshTokenResponse": {
+        "olympy_api-gateway_genproto_stream_service.StreamEventResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
-                },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.RegisterUserRequest": {
+        "olympy_api-gateway_genproto_translation_service.ListResponse": {
             "type": "object",
             "properties": {
-                "password": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "role": {
+                "next_page_token": {
                     "type": "string"
                 },
-                "username": {
-                    "type": "string"
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_translation_service.Translation"
+                    }
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.RegisterUserResponse": {
+        "olympy_api-gateway_genproto_translation_service.Message": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_auth_service.User"
                 }
             }
         },
-        "olympy_api-gateway_genproto_auth_service.User": {
+        "olympy_api-gateway_genproto_translation_service.Translation": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "role": {
+                "entity": {
                     "type": "string"
                 },
-                "username": {
-                    "type": "string"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_country_service.Country": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "key": {
                     "type": "string"
                 },
-                "flag": {
+                "locale": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
                 },
@@ -1442,21 +5312,24 @@ const docTemplate = `{
                 }
             }
         },
-        "olympy_api-gateway_genproto_country_service.ListResponse": {
+        "olympy_api-gateway_genproto_venue_service.ListResponse": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "countries": {
+                "next_page_token": {
+                    "type": "string"
+                },
+                "venues": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/olympy_api-gateway_genproto_country_service.Country"
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_venue_service.Venue"
                     }
                 }
             }
         },
-        "olympy_api-gateway_genproto_country_service.Message": {
+        "olympy_api-gateway_genproto_venue_service.Message": {
             "type": "object",
             "properties": {
                 "message": {
@@ -1464,163 +5337,146 @@ const docTemplate = `{
                 }
             }
         },
-        "olympy_api-gateway_genproto_event_service.AddEventRequest": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.AddEventResponse": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.EditEventRequest": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.EditEventResponse": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.Event": {
+        "olympy_api-gateway_genproto_venue_service.Venue": {
             "type": "object",
             "properties": {
-                "end_time": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
-                "sport_type": {
+                "sports": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timezone": {
                     "type": "string"
                 },
-                "start_time": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "olympy_api-gateway_genproto_event_service.GetAllEventsResponse": {
+        "olympy_api-gateway_genproto_webhook_service.Delivery": {
             "type": "object",
             "properties": {
-                "events": {
+                "attempt_log": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.DeliveryAttempt"
                     }
                 },
-                "total_count": {
+                "attempts": {
                     "type": "integer"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.GetEventResponse": {
-            "type": "object",
-            "properties": {
-                "event": {
-                    "$ref": "#/definitions/olympy_api-gateway_genproto_event_service.Event"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_event_service.Message": {
-            "type": "object",
-            "properties": {
-                "message": {
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
                 }
             }
         },
-        "olympy_api-gateway_genproto_medal_service.CountryMedalCount": {
+        "olympy_api-gateway_genproto_webhook_service.DeliveryAttempt": {
             "type": "object",
             "properties": {
-                "bronze": {
-                    "type": "integer"
-                },
-                "country_id": {
+                "attempt": {
                     "type": "integer"
                 },
-                "country_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "gold": {
+                "duration_ms": {
                     "type": "integer"
                 },
-                "ranking": {
-                    "type": "integer"
+                "error": {
+                    "type": "string"
                 },
-                "silver": {
+                "status_code": {
                     "type": "integer"
                 }
             }
         },
-        "olympy_api-gateway_genproto_medal_service.ListResponse": {
+        "olympy_api-gateway_genproto_webhook_service.ListDeliveriesResponse": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "medals": {
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.Medal"
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Delivery"
                     }
+                },
+                "next_page_token": {
+                    "type": "string"
                 }
             }
         },
-        "olympy_api-gateway_genproto_medal_service.Medal": {
+        "olympy_api-gateway_genproto_webhook_service.ListResponse": {
             "type": "object",
             "properties": {
-                "athlete_id": {
-                    "type": "string"
-                },
-                "country_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "event_id": {
-                    "type": "integer"
-                },
-                "id": {
+                "count": {
                     "type": "integer"
                 },
-                "type": {
+                "next_page_token": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_medal_service.MedalRankingResponse": {
-            "type": "object",
-            "properties": {
-                "country_medal_counts": {
+                "webhooks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/olympy_api-gateway_genproto_medal_service.CountryMedalCount"
+                        "$ref": "#/definitions/olympy_api-gateway_genproto_webhook_service.Webhook"
                     }
                 }
             }
         },
-        "olympy_api-gateway_genproto_medal_service.Message": {
+        "olympy_api-gateway_genproto_webhook_service.Message": {
             "type": "object",
             "properties": {
                 "message": {
@@ -1628,21 +5484,34 @@ const docTemplate = `{
                 }
             }
         },
-        "olympy_api-gateway_genproto_stream_service.StreamEventRequest": {
+        "olympy_api-gateway_genproto_webhook_service.Webhook": {
             "type": "object",
             "properties": {
-                "event_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "text": {
+                "description": {
                     "type": "string"
-                }
-            }
-        },
-        "olympy_api-gateway_genproto_stream_service.StreamEventResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
                     "type": "string"
                 }
             }
@@ -20,7 +20,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StreamingService_StreamEvent_FullMethodName = "/streaming_service.StreamingService/StreamEvent"
+	StreamingService_StreamEvent_FullMethodName    = "/streaming_service.StreamingService/StreamEvent"
+	StreamingService_SubscribeEvent_FullMethodName = "/streaming_service.StreamingService/SubscribeEvent"
 )
 
 // StreamingServiceClient is the client API for StreamingService service.
@@ -28,6 +29,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StreamingServiceClient interface {
 	StreamEvent(ctx context.Context, in *StreamEventRequest, opts ...grpc.CallOption) (*StreamEventResponse, error)
+	// Sends the commentary stored after last_id, then live commentary until
+	// the client cancels.
+	SubscribeEvent(ctx context.Context, in *SubscribeEventRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Commentary], error)
 }
 
 type streamingServiceClient struct {
@@ -48,11 +52,33 @@ func (c *streamingServiceClient) StreamEvent(ctx context.Context, in *StreamEven
 	return out, nil
 }
 
+func (c *streamingServiceClient) SubscribeEvent(ctx context.Context, in *SubscribeEventRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Commentary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StreamingService_ServiceDesc.Streams[0], StreamingService_SubscribeEvent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeEventRequest, Commentary]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingService_SubscribeEventClient = grpc.ServerStreamingClient[Commentary]
+
 // StreamingServiceServer is the server API for StreamingService service.
 // All implementations must embed UnimplementedStreamingServiceServer
 // for forward compatibility.
 type StreamingServiceServer interface {
 	StreamEvent(context.Context, *StreamEventRequest) (*StreamEventResponse, error)
+	// Sends the commentary stored after last_id, then live commentary until
+	// the client cancels.
+	SubscribeEvent(*SubscribeEventRequest, grpc.ServerStreamingServer[Commentary]) error
 	mustEmbedUnimplementedStreamingServiceServer()
 }
 
@@ -66,6 +92,9 @@ type UnimplementedStreamingServiceServer struct{}
 func (UnimplementedStreamingServiceServer) StreamEvent(context.Context, *StreamEventRequest) (*StreamEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamEvent not implemented")
 }
+func (UnimplementedStreamingServiceServer) SubscribeEvent(*SubscribeEventRequest, grpc.ServerStreamingServer[Commentary]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvent not implemented")
+}
 func (UnimplementedStreamingServiceServer) mustEmbedUnimplementedStreamingServiceServer() {}
 func (UnimplementedStreamingServiceServer) testEmbeddedByValue()                          {}
 
@@ -105,6 +134,17 @@ func _StreamingService_StreamEvent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingService_SubscribeEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServiceServer).SubscribeEvent(m, &grpc.GenericServerStream[SubscribeEventRequest, Commentary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingService_SubscribeEventServer = grpc.ServerStreamingServer[Commentary]
+
 // StreamingService_ServiceDesc is the grpc.ServiceDesc for StreamingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +157,12 @@ var StreamingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _StreamingService_StreamEvent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvent",
+			Handler:       _StreamingService_SubscribeEvent_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "protos/stream_service/streaming_service.proto",
 }
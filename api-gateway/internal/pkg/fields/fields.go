@@ -0,0 +1,81 @@
+// Package fields implements sparse fieldsets: the fields query parameter of
+// read endpoints is forwarded to the backends as a field mask, and the
+// response is trimmed to the requested fields.
+package fields
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gogo/protobuf/types"
+)
+
+// Param is the query parameter listing the fields to return, comma
+// separated, e.g. fields=name,country_id.
+const Param = "fields"
+
+// Mask returns the field mask requested by ctx, or nil when every field is
+// wanted.
+func Mask(ctx *gin.Context) *types.FieldMask {
+	var paths []string
+	for _, path := range strings.Split(ctx.Query(Param), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return &types.FieldMask{Paths: paths}
+}
+
+// Respond writes resp with status 200. The generated messages marshal unset
+// fields too, so when a mask was requested every object with an id is
+// trimmed to its id and the masked fields.
+func Respond(ctx *gin.Context, resp interface{}) {
+	mask := Mask(ctx)
+	if mask == nil {
+		ctx.IndentedJSON(200, resp)
+		return
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		ctx.IndentedJSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	keep := map[string]bool{"id": true}
+	for _, path := range mask.Paths {
+		keep[path] = true
+	}
+	prune(v, keep)
+	ctx.IndentedJSON(200, v)
+}
+
+func prune(v interface{}, keep map[string]bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		_, entity := v["id"]
+		for key, value := range v {
+			if entity && !keep[key] {
+				delete(v, key)
+				continue
+			}
+			prune(value, keep)
+		}
+	case []interface{}:
+		for _, item := range v {
+			prune(item, keep)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package grpcclient
+
+import "time"
+
+var (
+	read = Method{Idempotent: true}
+	// Bulk imports stream whole files and insert them in transactions;
+	// exports stream whole tables.
+	bulk = Method{Timeout: 5 * time.Minute}
+)
+
+// Reads of the caller's own data, like ListCalendarSubscriptions, are not
+// marked read: the stale cache is shared by all callers.
+
+func AuthBackend(target string) Backend {
+	return Backend{
+		Name:     "auth-service",
+		Target:   target,
+		Services: []string{"auth_service.AuthService"},
+		Methods: map[string]Method{
+			// Password hashing makes these noticeably slower than other calls.
+			"/auth_service.AuthService/RegisterUser": {Timeout: 10 * time.Second},
+			"/auth_service.AuthService/LoginUser":    {Timeout: 10 * time.Second},
+		},
+	}
+}
+
+func EventBackend(target string) Backend {
+	return Backend{
+		Name:     "event-service",
+		Target:   target,
+		Services: []string{"event_service.EventService", "games_service.GamesService", "translation_service.TranslationService", "venue_service.VenueService"},
+		Methods: map[string]Method{
+			"/event_service.EventService/GetEvent":                     read,
+			"/event_service.EventService/GetAllEvents":                 read,
+			"/event_service.EventService/SearchEvents":                 read,
+			"/event_service.EventService/ListEventsByVenue":            read,
+			"/event_service.EventService/ValidateSchedule":             read,
+			"/event_service.EventService/ListStatusTransitions":        read,
+			"/event_service.EventService/ImportEvents":                 bulk,
+			"/event_service.EventService/ExportEvents":                 bulk,
+			"/event_service.EventService/CalendarEvents":               bulk,
+			"/games_service.GamesService/GetGames":                     read,
+			"/games_service.GamesService/ListGames":                    read,
+			"/translation_service.TranslationService/ListTranslations": read,
+			"/venue_service.VenueService/GetVenue":                     read,
+			"/venue_service.VenueService/ListVenues":                   read,
+		},
+	}
+}
+
+func MedalBackend(target string) Backend {
+	return Backend{
+		Name:     "medal-service",
+		Target:   target,
+		Services: []string{"medal_service.MedalService", "service_service.CountryService", "webhook_service.WebhookService"},
+		Methods: map[string]Method{
+			"/medal_service.MedalService/GetMedal":            read,
+			"/medal_service.MedalService/ListMedals":          read,
+			"/medal_service.MedalService/GetMedalRanking":     {Timeout: 10 * time.Second, Idempotent: true},
+			"/medal_service.MedalService/ImportMedals":        bulk,
+			"/medal_service.MedalService/ExportMedals":        bulk,
+			"/medal_service.MedalService/ExportMedalRanking":  bulk,
+			"/service_service.CountryService/GetCountry":      read,
+			"/service_service.CountryService/ListCountries":   read,
+			"/service_service.CountryService/ImportCountries": bulk,
+			"/webhook_service.WebhookService/GetWebhook":      read,
+			"/webhook_service.WebhookService/ListWebhooks":    read,
+			"/webhook_service.WebhookService/ListDeliveries":  read,
+			"/webhook_service.WebhookService/GetDelivery":     read,
+		},
+	}
+}
+
+func AthleteBackend(target string) Backend {
+	return Backend{
+		Name:     "athlete-service",
+		Target:   target,
+		Services: []string{"athlete_service.AthleteService"},
+		Methods: map[string]Method{
+			"/athlete_service.AthleteService/GetAthlete":     read,
+			"/athlete_service.AthleteService/ListAthletes":   read,
+			"/athlete_service.AthleteService/ImportAthletes": bulk,
+			"/athlete_service.AthleteService/ExportAthletes": bulk,
+		},
+	}
+}
+
+func StreamBackend(target string) Backend {
+	return Backend{
+		Name:     "streaming-service",
+		Target:   target,
+		Services: []string{"streaming_service.StreamingService"},
+		Methods: map[string]Method{
+			"/streaming_service.StreamingService/SubscribeEvent": {NoDeadline: true},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"olympy/api-gateway/internal/pkg/locale"
+	"olympy/pkg/identity"
+	"olympy/pkg/logger"
+
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	DefaultTimeout  = 5 * time.Second
+	DefaultStaleTTL = 10 * time.Minute
+
+	maxAttempts = 3
+)
+
+// Method describes how calls to a single RPC are handled.
+type Method struct {
+	// Timeout overrides the factory's default timeout for this method.
+	Timeout time.Duration
+	// NoDeadline methods, such as live subscriptions, run until the caller
+	// cancels them.
+	NoDeadline bool
+	// Idempotent methods are retried with backoff on UNAVAILABLE and, while
+	// the backend is down, answered from the stale cache.
+	Idempotent bool
+}
+
+// Backend is a downstream gRPC server. A single backend may expose several
+// services (medal-service serves both MedalService and CountryService).
+type Backend struct {
+	Name     string
+	Target   string
+	Services []string
+	// Methods is keyed by full method name, e.g. "/medal_service.MedalService/GetMedal".
+	Methods map[string]Method
+}
+
+// Factory dials backends with deadlines, retries, a circuit breaker and a
+// stale-read cache configured for each of them.
+type Factory struct {
+	timeout  time.Duration
+	staleTTL time.Duration
+	creds    credentials.TransportCredentials
+	signer   *identity.Signer
+	logger   *log.Logger
+}
+
+func NewFactory(timeout, staleTTL time.Duration, creds credentials.TransportCredentials, signer *identity.Signer, logger *log.Logger) *Factory {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Factory{
+		timeout:  timeout,
+		staleTTL: staleTTL,
+		creds:    creds,
+		signer:   signer,
+		logger:   logger,
+	}
+}
+
+func (f *Factory) Dial(b Backend) (*grpc.ClientConn, error) {
+	serviceConfig, err := f.serviceConfig(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build service config for %s: %v", b.Name, err)
+	}
+
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        b.Name,
+		MaxRequests: 1,
+		Timeout:     10 * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= 5
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			f.logger.Printf("circuit breaker %s: %s -> %s", name, from, to)
+		},
+		IsSuccessful: func(err error) bool {
+			return !isUnavailable(err)
+		},
+	})
+
+	return grpc.Dial(b.Target,
+		grpc.WithTransportCredentials(f.creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(
+			logger.UnaryClientInterceptor(),
+			locale.UnaryClientInterceptor(),
+			identity.UnaryClientInterceptor(f.signer),
+			f.unaryInterceptor(b, cb, newStaleCache(f.staleTTL)),
+		),
+		grpc.WithChainStreamInterceptor(logger.StreamClientInterceptor(), locale.StreamClientInterceptor(), identity.StreamClientInterceptor(f.signer)),
+	)
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// serviceConfig applies the default timeout to every method of the backend's
+// services and adds a method-level entry for each configured RPC.
+func (f *Factory) serviceConfig(b Backend) (string, error) {
+	var configs []methodConfig
+
+	defaults := methodConfig{Timeout: duration(f.timeout)}
+	for _, service := range b.Services {
+		defaults.Name = append(defaults.Name, methodName{Service: service})
+	}
+	configs = append(configs, defaults)
+
+	for fullMethod, m := range b.Methods {
+		service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+		if !ok {
+			return "", fmt.Errorf("invalid method name %q", fullMethod)
+		}
+
+		timeout := m.Timeout
+		if timeout <= 0 {
+			timeout = f.timeout
+		}
+		mc := methodConfig{Name: []methodName{{Service: service, Method: method}}}
+		if !m.NoDeadline {
+			mc.Timeout = duration(timeout)
+		}
+		if m.Idempotent {
+			mc.RetryPolicy = &retryPolicy{
+				MaxAttempts:          maxAttempts,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			}
+		}
+		configs = append(configs, mc)
+	}
+
+	out, err := json.Marshal(map[string]interface{}{"methodConfig": configs})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func duration(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
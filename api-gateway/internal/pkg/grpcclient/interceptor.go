@@ -0,0 +1,71 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"olympy/api-gateway/internal/pkg/locale"
+
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StaleKey is the context key under which callers can store a StaleFunc.
+// Handlers pass *gin.Context straight to the clients, and gin resolves string
+// keys through ctx.Keys, so a middleware can register it with ctx.Set.
+const StaleKey = "grpcclient.stale"
+
+// StaleFunc is called when a response is served from the stale cache.
+type StaleFunc func()
+
+func (f *Factory) unaryInterceptor(b Backend, cb *gobreaker.CircuitBreaker, cache *staleCache) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		m := b.Methods[method]
+		// Responses differ by language, so each set of locales is cached
+		// separately.
+		cached := method
+		if locales := locale.Locales(ctx); len(locales) > 0 {
+			cached += "@" + strings.Join(locales, ",")
+		}
+
+		_, err := cb.Execute(func() (interface{}, error) {
+			return nil, invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if err == nil {
+			if m.Idempotent {
+				cache.store(cached, req, reply)
+			}
+			return nil
+		}
+
+		breakerOpen := errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests)
+		if m.Idempotent && (breakerOpen || isUnavailable(err)) && cache.load(cached, req, reply) {
+			f.logger.Printf("%s is unavailable, serving stale response for %s: %v", b.Name, method, err)
+			if notify, ok := ctx.Value(StaleKey).(StaleFunc); ok {
+				notify()
+			}
+			return nil
+		}
+
+		if breakerOpen {
+			return status.Errorf(codes.Unavailable, "%s is unavailable: %v", b.Name, err)
+		}
+		return err
+	}
+}
+
+// isUnavailable reports whether err means the backend could not serve the
+// call, as opposed to the call itself being rejected.
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
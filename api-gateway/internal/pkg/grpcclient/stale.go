@@ -0,0 +1,99 @@
+package grpcclient
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+)
+
+const maxStaleEntries = 1024
+
+type staleEntry struct {
+	data     []byte
+	storedAt time.Time
+}
+
+// staleCache keeps the last successful response of each idempotent call so
+// that reads can still be answered while a backend is down.
+type staleCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	codec   encoding.Codec
+	entries map[string]staleEntry
+}
+
+func newStaleCache(ttl time.Duration) *staleCache {
+	return &staleCache{
+		ttl:     ttl,
+		codec:   encoding.GetCodec(proto.Name),
+		entries: make(map[string]staleEntry),
+	}
+}
+
+func (c *staleCache) key(method string, req interface{}) (string, bool) {
+	data, err := c.codec.Marshal(req)
+	if err != nil {
+		return "", false
+	}
+	return method + "\x00" + string(data), true
+}
+
+func (c *staleCache) store(method string, req, reply interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	key, ok := c.key(method, req)
+	if !ok {
+		return
+	}
+	data, err := c.codec.Marshal(reply)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= maxStaleEntries {
+		c.evict()
+	}
+	c.entries[key] = staleEntry{data: data, storedAt: time.Now()}
+}
+
+func (c *staleCache) load(method string, req, reply interface{}) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	key, ok := c.key(method, req)
+	if !ok {
+		return false
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok || time.Since(entry.storedAt) > c.ttl {
+		return false
+	}
+	return c.codec.Unmarshal(entry.data, reply) == nil
+}
+
+// evict drops expired entries, or the oldest one if none has expired.
+func (c *staleCache) evict() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if time.Since(entry.storedAt) > c.ttl {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+	if len(c.entries) >= maxStaleEntries {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,135 @@
+// Package ical writes calendars in the iCalendar format of RFC 5545, which
+// calendar apps subscribe to.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of calendars.
+const ContentType = "text/calendar; charset=utf-8"
+
+// Statuses of events.
+const (
+	Confirmed = "CONFIRMED"
+	Cancelled = "CANCELLED"
+)
+
+// Event is a VEVENT. UID must stay the same across revisions of an event,
+// and Sequence grow with every significant change, so that calendar apps
+// update their copy rather than add another.
+type Event struct {
+	UID        string
+	Sequence   int
+	Start, End time.Time
+	Summary    string
+	Location   string
+	Categories string
+	Status     string
+}
+
+// Writer writes a VCALENDAR, one event at a time. Errors are sticky and
+// reported by Close.
+type Writer struct {
+	w     *bufio.Writer
+	stamp string
+	err   error
+}
+
+// NewWriter starts a calendar called name, which calendar apps refresh
+// every refresh.
+func NewWriter(w io.Writer, prodID, name string, refresh time.Duration) *Writer {
+	cw := &Writer{w: bufio.NewWriter(w), stamp: formatTime(time.Now())}
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", prodID)
+	cw.line("CALSCALE", "GREGORIAN")
+	cw.line("METHOD", "PUBLISH")
+	cw.line("X-WR-CALNAME", escape(name))
+	cw.line("REFRESH-INTERVAL;VALUE=DURATION", formatDuration(refresh))
+	cw.line("X-PUBLISHED-TTL", formatDuration(refresh))
+	return cw
+}
+
+// Write adds an event. Times are written in UTC, which calendar apps show
+// in the time zone of the user.
+func (cw *Writer) Write(e Event) error {
+	cw.line("BEGIN", "VEVENT")
+	cw.line("UID", e.UID)
+	cw.line("DTSTAMP", cw.stamp)
+	cw.line("SEQUENCE", strconv.Itoa(e.Sequence))
+	cw.line("DTSTART", formatTime(e.Start))
+	cw.line("DTEND", formatTime(e.End))
+	cw.line("SUMMARY", escape(e.Summary))
+	if e.Location != "" {
+		cw.line("LOCATION", escape(e.Location))
+	}
+	if e.Categories != "" {
+		cw.line("CATEGORIES", escape(e.Categories))
+	}
+	if e.Status != "" {
+		cw.line("STATUS", e.Status)
+	}
+	cw.line("END", "VEVENT")
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.err
+}
+
+// Close ends the calendar.
+func (cw *Writer) Close() error {
+	cw.line("END", "VCALENDAR")
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.err
+}
+
+// line writes a content line, folded into lines of at most 75 octets
+// without splitting characters.
+func (cw *Writer) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+	s := name + ":" + value
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		if _, cw.err = cw.w.WriteString(s[:cut] + "\r\n "); cw.err != nil {
+			return
+		}
+		s = s[cut:]
+		// Continuation lines start with the folding space.
+		limit = 74
+	}
+	_, cw.err = cw.w.WriteString(s + "\r\n")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape quotes the characters TEXT values may not contain.
+func escape(text string) string {
+	return escaper.Replace(text)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// formatDuration renders d, rounded to minutes, as an RFC 5545 duration.
+func formatDuration(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes%60 == 0 {
+		return "PT" + strconv.Itoa(minutes/60) + "H"
+	}
+	return "PT" + strconv.Itoa(minutes) + "M"
+}
@@ -0,0 +1,111 @@
+// Package locale negotiates the languages names are returned in and forwards
+// them to the backends as gRPC metadata.
+package locale
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header is the HTTP header the locales are negotiated from.
+	Header = "Accept-Language"
+	// Key is the gRPC metadata key carrying the locales, most preferred
+	// first and comma separated.
+	Key = "accept-language"
+
+	// fallback is the language of the untranslated names.
+	fallback   = "en"
+	maxLocales = 10
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{1,8})*$`)
+
+type contextKey struct{}
+
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locales)
+}
+
+func Locales(ctx context.Context) []string {
+	locales, _ := ctx.Value(contextKey{}).([]string)
+	return locales
+}
+
+// Parse orders the language ranges of an Accept-Language header by quality
+// and follows each with its fallbacks, so "fr-CA, ar;q=0.5" becomes
+// fr-ca, fr, ar. Wildcards, ranges with q=0 and malformed tags are dropped.
+// The chain ends at English, since untranslated names are English.
+func Parse(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+		if len(tag) > 35 || !tagPattern.MatchString(tag) {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	var locales []string
+	seen := make(map[string]bool)
+	for _, r := range ranges {
+		for tag := r.tag; ; {
+			if !seen[tag] && len(locales) < maxLocales {
+				seen[tag] = true
+				locales = append(locales, tag)
+			}
+			if tag == fallback {
+				return locales
+			}
+			i := strings.LastIndex(tag, "-")
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+		}
+	}
+	return locales
+}
+
+// UnaryClientInterceptor forwards the locales in ctx to the called service.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(toOutgoingMetadata(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor forwards the locales in ctx to the called service.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(toOutgoingMetadata(ctx), desc, cc, method, opts...)
+	}
+}
+
+func toOutgoingMetadata(ctx context.Context) context.Context {
+	locales := Locales(ctx)
+	if len(locales) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, Key, strings.Join(locales, ","))
+}
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
-	"github.com/k0kubun/pp"
 	"go.uber.org/zap"
 )
 
@@ -119,7 +118,6 @@ func ExtractClaim(tokenStr string) (jwt.MapClaims, error) {
 		err   error
 	)
 	token, err = jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		pp.Println("SIGNINGGG: ", config.SignKey)
 		return []byte(config.SignKey), nil
 	})
 	if err != nil {
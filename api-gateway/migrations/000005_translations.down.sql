@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS sport_translations;
+DROP TABLE IF EXISTS event_translations;
+DROP TABLE IF EXISTS country_translations;
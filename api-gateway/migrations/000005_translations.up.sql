@@ -0,0 +1,51 @@
+-- Names in other languages. Locales are lower-case BCP 47 tags such as "fr"
+-- or "fr-ca"; the name columns of countries and events stay the English
+-- defaults every lookup falls back to.
+CREATE TABLE country_translations (
+    country_id INT NOT NULL REFERENCES countries(id) ON DELETE CASCADE,
+    locale VARCHAR(35) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (country_id, locale)
+);
+
+CREATE TABLE event_translations (
+    event_id INT NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    locale VARCHAR(35) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, locale)
+);
+
+-- Sport types are free text on events and athletes, so their translations
+-- are keyed by the English sport type itself.
+CREATE TABLE sport_translations (
+    sport_type VARCHAR(100) NOT NULL,
+    locale VARCHAR(35) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (sport_type, locale)
+);
+
+INSERT INTO sport_translations (sport_type, locale, name) VALUES
+('Athletics', 'fr', 'Athlétisme'),
+('Athletics', 'ar', 'ألعاب القوى'),
+('Athletics', 'ja', '陸上競技'),
+('Swimming', 'fr', 'Natation'),
+('Swimming', 'ar', 'السباحة'),
+('Swimming', 'ja', '競泳'),
+('Basketball', 'fr', 'Basket-ball'),
+('Basketball', 'ar', 'كرة السلة'),
+('Basketball', 'ja', 'バスケットボール'),
+('Football', 'fr', 'Football'),
+('Football', 'ar', 'كرة القدم'),
+('Football', 'ja', 'サッカー'),
+('Judo', 'fr', 'Judo'),
+('Judo', 'ar', 'الجودو'),
+('Judo', 'ja', '柔道'),
+('Tennis', 'fr', 'Tennis'),
+('Tennis', 'ar', 'التنس'),
+('Tennis', 'ja', 'テニス'),
+('Boxing', 'fr', 'Boxe'),
+('Boxing', 'ar', 'الملاكمة'),
+('Boxing', 'ja', 'ボクシング');
@@ -1,37 +1,56 @@
 package api
 
 import (
+	"context"
 	"log"
 	"net"
 
 	athleteservice "olympy/athlete-service/genproto/athlete_service"
 	"olympy/athlete-service/internal/config"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type (
 	API struct {
 		service athleteservice.AthleteServiceServer
+		health  *health.Checker
+		server  *grpc.Server
 	}
 )
 
-func New(service athleteservice.AthleteServiceServer) *API {
+func New(service athleteservice.AthleteServiceServer, creds credentials.TransportCredentials, signer *identity.Signer, health *health.Checker, zapLogger *zap.Logger) *API {
 	return &API{
 		service: service,
+		health:  health,
+		server: grpc.NewServer(
+			grpc.Creds(creds),
+			grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(zapLogger), identity.UnaryServerInterceptor(signer, permissions)),
+			grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(zapLogger), identity.StreamServerInterceptor(signer, permissions)),
+		),
 	}
 }
 
 func (a *API) RUN(config *config.Config) error {
-	listener, err := net.Listen("tcp", config.Server.Port)
+	listener, err := net.Listen("tcp", config.Server.Addr())
 	if err != nil {
 		return err
 	}
 
-	serverRegisterer := grpc.NewServer()
-	athleteservice.RegisterAthleteServiceServer(serverRegisterer, a.service)
+	athleteservice.RegisterAthleteServiceServer(a.server, a.service)
+	a.health.Register(a.server)
 
-	log.Println("server has started running on port", config.Server.Port)
+	log.Println("server has started running on port", config.Server.Addr())
 
-	return serverRegisterer.Serve(listener)
+	return a.server.Serve(listener)
+}
+
+func (a *API) Shutdown(ctx context.Context) error {
+	return lifecycle.GracefulStop(a.server)(ctx)
 }
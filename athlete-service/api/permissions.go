@@ -0,0 +1,14 @@
+package api
+
+import "olympy/pkg/identity"
+
+// permissions are the scopes the caller's identity needs for each RPC.
+var permissions = identity.Permissions{
+	"/athlete_service.AthleteService/AddAthlete":     "athletes:write",
+	"/athlete_service.AthleteService/EditAthlete":    "athletes:write",
+	"/athlete_service.AthleteService/DeleteAthlete":  "athletes:write",
+	"/athlete_service.AthleteService/ImportAthletes": "athletes:write",
+	"/athlete_service.AthleteService/ListAthletes":   "",
+	"/athlete_service.AthleteService/GetAthlete":     "",
+	"/athlete_service.AthleteService/ExportAthletes": "",
+}
@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"log"
 	"olympy/athlete-service/api"
 	"olympy/athlete-service/internal/config"
 	"olympy/athlete-service/internal/service"
 	"olympy/athlete-service/internal/storage"
-	"os"
+	"olympy/pkg/configloader"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
+	"olympy/pkg/mtls"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/k0kubun/pp"
+	"go.uber.org/zap"
 )
 
 func main() {
@@ -20,23 +26,46 @@ func main() {
 		log.Fatal(err)
 	}
 
-	migrateDB()
+	zapLogger, err := logger.New(configs.Log.Level, configs.Log.Environment, configs.Log.File)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer zapLogger.Sync()
+	logger.SetDefault(zapLogger)
+	zapLogger.Info("effective config", zap.Any("config", configloader.Redacted(configs)))
+
+	migrateDB(configs.Database.MigrationURL())
 
 	athletestorage, err := storage.NewAthleteService(configs)
 	if err != nil {
 		log.Fatal(err)
 	}
-	api := api.New(service.NewAthleteService(*athletestorage))
 
-	log.Fatal(api.RUN(configs))
-}
+	checker := health.New(map[string]health.Check{
+		"postgres": athletestorage.Ping,
+	}, "athlete_service.AthleteService")
 
-func migrateDB() {
-	databaseURL, ok := os.LookupEnv("PG_URL")
-	if !ok || len(databaseURL) == 0 {
-		log.Fatalf("migrate: environment variable PG_URL not declared or empty")
+	certs, err := mtls.NewStore(mtls.Files{Cert: configs.TLS.CertFile, Key: configs.TLS.KeyFile, CA: configs.TLS.CAFile})
+	if err != nil {
+		log.Fatal(err)
 	}
 
+	api := api.New(service.NewAthleteService(*athletestorage), mtls.ServerCredentials(certs, configs.TLS.AllowedClients), identity.NewSigner(configs.Identity.Secret), checker, zapLogger)
+
+	runner := lifecycle.New(configs.Server.ShutdownTimeout)
+	runner.Go("grpc server", func(context.Context) error { return api.RUN(configs) }, api.Shutdown)
+	runner.Go("health checker", func(ctx context.Context) error {
+		checker.Run(ctx)
+		return nil
+	}, nil)
+	runner.Close("postgres", athletestorage.Close)
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func migrateDB(databaseURL string) {
 	m, err := migrate.New("file://migrations", databaseURL)
 	if err != nil {
 		log.Fatalf("Migrate: error creating migrate instance: %v", err)
@@ -50,6 +79,6 @@ func migrateDB() {
 			log.Fatalf("Migrate: error applying migrations: %v", err)
 		}
 	} else {
-		pp.Printf("Migrate: up success")
+		log.Println("Migrate: up success")
 	}
 }
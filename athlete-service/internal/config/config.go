@@ -1,59 +1,89 @@
 package config
 
 import (
-	"fmt"
-	"os"
+	"net"
+	"net/url"
+	"time"
 
-	"github.com/joho/godotenv"
-
-	_ "github.com/lib/pq"
+	"olympy/pkg/configloader"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
+	Server     ServerConfig     `yaml:"server"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Log        LogConfig        `yaml:"log"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	TLS        TLSConfig        `yaml:"tls"`
+	Identity   IdentityConfig   `yaml:"identity"`
 }
 
 type ServerConfig struct {
-	Port string
+	Port            string        `yaml:"port" env:"SERVER_PORT" default:"6666" validate:"required,port"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"15s" validate:"positive"`
 }
 
-type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
+// Addr is the listen address of the gRPC server.
+func (s ServerConfig) Addr() string {
+	return ":" + s.Port
 }
 
-func (c *Config) Load() error {
+type LogConfig struct {
+	Level       string `yaml:"level" env:"LOG_LEVEL" default:"info" validate:"oneof=debug|info|warn|error"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT" default:"production" validate:"oneof=production|development"`
+	File        string `yaml:"file" env:"LOG_FILE"`
+}
 
-	err := godotenv.Load()
-	if err != nil {
-		fmt.Println(".env file not found, proceeding with other environment variables")
-	}
+// TLSConfig locates the certificates for mutual TLS with the other services
+// and names the services allowed to call this one.
+type TLSConfig struct {
+	CertFile       string   `yaml:"cert_file" env:"TLS_CERT_FILE" default:"certs/athlete-service.pem" validate:"required"`
+	KeyFile        string   `yaml:"key_file" env:"TLS_KEY_FILE" default:"certs/athlete-service-key.pem" validate:"required"`
+	CAFile         string   `yaml:"ca_file" env:"TLS_CA_FILE" default:"certs/ca.pem" validate:"required"`
+	AllowedClients []string `yaml:"allowed_clients" env:"TLS_ALLOWED_CLIENTS" default:"api-gateway,health-probe,olympyctl" validate:"required"`
+}
+
+// IdentityConfig holds the key that the gateway signs caller identities with;
+// it must match the gateway's.
+type IdentityConfig struct {
+	Secret string `yaml:"secret" env:"INTERNAL_IDENTITY_SECRET" validate:"required" secret:"true"`
+}
 
-	c.Server.Port = ":" + getEnv("SERVER_PORT", "6666")
-	c.Database.Host = getEnv("DB_HOST", "postgres")
-	c.Database.Port = getEnv("DB_PORT", "5432")
-	c.Database.User = getEnv("DB_USER", "postgres")
-	c.Database.Password = getEnv("DB_PASSWORD", "aaaa")
-	c.Database.DBName = getEnv("DB_NAME", "olympydb")
+// PaginationConfig holds the key that list page tokens are signed with.
+type PaginationConfig struct {
+	TokenSecret string `yaml:"token_secret" env:"PAGE_TOKEN_SECRET" validate:"required" secret:"true"`
+}
 
-	return nil
+type DatabaseConfig struct {
+	Host     string `yaml:"host" env:"DB_HOST" default:"postgres" validate:"required"`
+	Port     string `yaml:"port" env:"DB_PORT" default:"5432" validate:"required,port"`
+	User     string `yaml:"user" env:"DB_USER" default:"postgres" validate:"required"`
+	Password string `yaml:"password" env:"DB_PASSWORD" default:"aaaa" validate:"required" secret:"true"`
+	DBName   string `yaml:"name" env:"DB_NAME" default:"olympydb" validate:"required"`
+	URL      string `yaml:"url" env:"PG_URL" validate:"url" secret:"true"`
 }
 
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// MigrationURL returns PG_URL, or a URL built from the connection fields.
+func (d DatabaseConfig) MigrationURL() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(d.User, d.Password),
+		Host:     net.JoinHostPort(d.Host, d.Port),
+		Path:     d.DBName,
+		RawQuery: "sslmode=disable",
 	}
-	return defaultValue
+	return u.String()
+}
+
+func (c *Config) Load() error {
+	return configloader.Load(c)
 }
 
 func New() (*Config, error) {
 	config := &Config{}
-	err := config.Load()
-	if err != nil {
+	if err := config.Load(); err != nil {
 		return nil, err
 	}
 	return config, nil
@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log"
 	athleteservice "olympy/athlete-service/genproto/athlete_service"
 	"olympy/athlete-service/internal/storage"
-	"os"
+	"olympy/pkg/importer"
+	"olympy/pkg/pagetoken"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type (
@@ -19,7 +25,7 @@ type (
 func NewAthleteService(athleteservice storage.Athlete) *AthleteService {
 	return &AthleteService{
 		athleteStorage: athleteservice,
-		logger:         log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile),
+		logger:         log.Default(),
 	}
 }
 
@@ -40,10 +46,74 @@ func (s *AthleteService) DeleteAthlete(ctx context.Context, req *athleteservice.
 
 func (s *AthleteService) GetAthlete(ctx context.Context, req *athleteservice.GetSingleRequest) (*athleteservice.Athlete, error) {
 	s.logger.Println("Get Medal Request")
-	return s.athleteStorage.GetAthlete(ctx, req)
+	return readResult(s.athleteStorage.GetAthlete(ctx, req))
 }
 
 func (s *AthleteService) ListAthletes(ctx context.Context, req *athleteservice.ListRequest) (*athleteservice.ListResponse, error) {
 	s.logger.Println("List Medals Request")
-	return s.athleteStorage.ListAthletes(ctx, req)
+	return readResult(s.athleteStorage.ListAthletes(ctx, req))
+}
+
+func (s *AthleteService) ExportAthletes(req *athleteservice.ListRequest, stream athleteservice.AthleteService_ExportAthletesServer) error {
+	s.logger.Println("Export Athletes Request")
+	return s.athleteStorage.ExportAthletes(stream.Context(), req, stream.Send)
+}
+
+func (s *AthleteService) ImportAthletes(stream athleteservice.AthleteService_ImportAthletesServer) error {
+	s.logger.Println("Import Athletes Request")
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "import is empty")
+	}
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	opts := importer.Options{
+		Format:    options.GetFormat(),
+		DryRun:    options.GetDryRun(),
+		Chunked:   options.GetChunked(),
+		ChunkSize: int(options.GetChunkSize()),
+	}
+	src := importer.StreamReader(first.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	result, err := s.athleteStorage.ImportAthletes(stream.Context(), opts, options.GetGamesId(), src)
+	if errors.Is(err, importer.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	report := &athleteservice.ImportReport{
+		Total:    int32(result.Total),
+		Valid:    int32(result.Valid),
+		Imported: int32(result.Imported),
+		Failed:   int32(result.Failed),
+		DryRun:   opts.DryRun,
+	}
+	for _, e := range result.Errors {
+		report.Errors = append(report.Errors, &athleteservice.ImportRowError{
+			Row:     int32(e.Row),
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return stream.SendAndClose(report)
+}
+
+// readResult reports field masks naming unknown fields and page tokens that
+// fail verification as invalid arguments.
+func readResult[T any](resp T, err error) (T, error) {
+	if errors.Is(err, storage.ErrUnknownField) || errors.Is(err, pagetoken.ErrInvalid) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, err
 }
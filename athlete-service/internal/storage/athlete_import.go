@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	athleteservice "olympy/athlete-service/genproto/athlete_service"
+	"olympy/pkg/importer"
+)
+
+// ImportAthletes validates the athletes read from src and inserts the valid
+// ones as entries of the edition gamesID. Rows have name, country and
+// sport_type columns; country may be the country's id, name or NOC code.
+func (a *Athlete) ImportAthletes(ctx context.Context, opts importer.Options, gamesID int64, src io.Reader) (*importer.Result, error) {
+	countries, err := importer.LoadCountries(ctx, a.db)
+	if err != nil {
+		return nil, err
+	}
+
+	parse := func(row importer.Row) (*athleteservice.Athlete, []importer.RowError) {
+		var problems importer.Problems
+		athlete := &athleteservice.Athlete{
+			Name:      problems.Text("name", row.Get("name"), 100),
+			SportType: problems.Text("sport_type", row.Get("sport_type", "sport"), 50),
+			GamesId:   gamesID,
+		}
+		if ref := row.Get("country", "country_id", "noc_code", "noc"); ref == "" {
+			problems.Add("country", "is required")
+		} else if id, ok := countries.Resolve(ref); ok {
+			athlete.CountryId = id
+		} else {
+			problems.Add("country", "unknown country %q", ref)
+		}
+		return athlete, problems
+	}
+
+	return importer.Run(ctx, a.db, opts, src, parse, a.insertAthlete)
+}
+
+func (a *Athlete) insertAthlete(ctx context.Context, tx *sql.Tx, athlete *athleteservice.Athlete) error {
+	_, err := a.queryBuilder.Insert("athletes").
+		SetMap(map[string]interface{}{
+			"name":       athlete.Name,
+			"country_id": athlete.CountryId,
+			"sport_type": athlete.SportType,
+			"games_id":   nullID(athlete.GamesId),
+			"created_at": time.Now(),
+		}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert athlete: %v", err)
+	}
+	return nil
+}
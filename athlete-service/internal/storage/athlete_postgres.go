@@ -8,14 +8,18 @@ import (
 
 	athleteservice "olympy/athlete-service/genproto/athlete_service"
 	"olympy/athlete-service/internal/config"
+	"olympy/athlete-service/internal/pkg/locale"
+	"olympy/pkg/pagetoken"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	//"github.com/google/uuid"
 )
 
 type Athlete struct {
 	db           *sql.DB
 	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
 }
 
 func NewAthleteService(config *config.Config) (*Athlete, error) {
@@ -27,15 +31,46 @@ func NewAthleteService(config *config.Config) (*Athlete, error) {
 	return &Athlete{
 		db:           db,
 		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
 	}, nil
 }
 
+func (a *Athlete) Ping(ctx context.Context) error {
+	return a.db.PingContext(ctx)
+}
+
+func (a *Athlete) Close() error {
+	return a.db.Close()
+}
+
+// athleteColumns are the fields an athlete read may select. Athletes
+// entered before editions existed may have no games_id, which is reported
+// as 0.
+var athleteColumns = columns[athleteservice.Athlete]{
+	{"id", "id", func(a *athleteservice.Athlete) interface{} { return &a.Id }},
+	{"name", "name", func(a *athleteservice.Athlete) interface{} { return &a.Name }},
+	{"country_id", "country_id", func(a *athleteservice.Athlete) interface{} { return &a.CountryId }},
+	{"sport_type", "sport_type", func(a *athleteservice.Athlete) interface{} { return &a.SportType }},
+	{"games_id", "COALESCE(games_id, 0)", func(a *athleteservice.Athlete) interface{} { return &a.GamesId }},
+	{"created_at", "created_at", func(a *athleteservice.Athlete) interface{} { return &a.CreatedAt }},
+	{"updated_at", "updated_at", func(a *athleteservice.Athlete) interface{} { return &a.UpdatedAt }},
+}
+
+// nullID stores an unset reference as NULL.
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
 func (a *Athlete) AddAthlete(ctx context.Context, req *athleteservice.Athlete) (*athleteservice.Athlete, error) {
 	data := map[string]interface{}{
 		"id":         req.Id,
 		"name":       req.Name,
 		"country_id": req.CountryId,
 		"sport_type": req.SportType,
+		"games_id":   nullID(req.GamesId),
 		"created_at": time.Now(),
 	}
 
@@ -58,7 +93,8 @@ func (a *Athlete) AddAthlete(ctx context.Context, req *athleteservice.Athlete) (
 		Name:      req.Name,
 		CountryId: req.CountryId,
 		SportType: req.SportType,
-		CreatedAt: data["created_at"].(time.Time).String(),
+		GamesId:   req.GamesId,
+		CreatedAt: data["created_at"].(time.Time).UTC().Format(time.RFC3339Nano),
 	}, nil
 }
 
@@ -67,6 +103,7 @@ func (a *Athlete) EditAthlete(ctx context.Context, req *athleteservice.Athlete)
 		"name":       req.Name,
 		"country_id": req.CountryId,
 		"sport_type": req.SportType,
+		"games_id":   nullID(req.GamesId),
 		"updated_at": time.Now(),
 	}
 
@@ -82,14 +119,12 @@ func (a *Athlete) EditAthlete(ctx context.Context, req *athleteservice.Athlete)
 		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
 	}
 
-	var updatedAthlete athleteservice.Athlete
-	err = a.db.QueryRowContext(ctx, "SELECT id, name, country_id, sport_type, created_at, updated_at FROM athletes WHERE id = $1", req.Id).
-		Scan(&updatedAthlete.Id, &updatedAthlete.Name, &updatedAthlete.CountryId, &updatedAthlete.SportType, &updatedAthlete.CreatedAt, &updatedAthlete.UpdatedAt)
+	updatedAthlete, err := a.GetAthlete(ctx, &athleteservice.GetSingleRequest{Id: req.Id})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch updated athlete: %v", err)
 	}
 
-	return &updatedAthlete, nil
+	return updatedAthlete, nil
 }
 
 func (a *Athlete) DeleteAthlete(ctx context.Context, req *athleteservice.GetSingleRequest) (*athleteservice.Message, error) {
@@ -118,31 +153,72 @@ func (a *Athlete) DeleteAthlete(ctx context.Context, req *athleteservice.GetSing
 }
 
 func (a *Athlete) GetAthlete(ctx context.Context, req *athleteservice.GetSingleRequest) (*athleteservice.Athlete, error) {
-	var athlete athleteservice.Athlete
-	err := a.db.QueryRowContext(ctx, "SELECT id, name, country_id, sport_type, created_at, updated_at FROM athletes WHERE id = $1", req.Id).
-		Scan(&athlete.Id, &athlete.Name, &athlete.CountryId, &athlete.SportType, &athlete.CreatedAt, &athlete.UpdatedAt)
+	selected, err := athleteColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	athlete, err := selected.scan(a.queryBuilder.Select(selected.exprs()...).
+		From("athletes").
+		Where(squirrel.Eq{"id": req.Id}).
+		RunWith(a.db).
+		QueryRowContext(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch athlete: %v", err)
 	}
 
-	return &athlete, nil
+	if err := a.localize(ctx, athlete); err != nil {
+		return nil, err
+	}
+	return athlete, nil
+}
+
+// filterAthletes applies the filters of req shared by listing and export.
+func filterAthletes(query squirrel.SelectBuilder, req *athleteservice.ListRequest) squirrel.SelectBuilder {
+	if len(req.Ids) > 0 {
+		query = query.Where(squirrel.Eq{"id": req.Ids})
+	}
+	if req.CountryId != 0 {
+		query = query.Where(squirrel.Eq{"country_id": req.CountryId})
+	}
+	if req.SportType != "" {
+		// The sport type may also be given in any of its translations.
+		query = query.Where("(sport_type = ? OR sport_type IN (SELECT sport_type FROM sport_translations WHERE name = ?))", req.SportType, req.SportType)
+	}
+	if req.GamesId != 0 {
+		query = query.Where(squirrel.Eq{"games_id": req.GamesId})
+	}
+	return query
 }
 
 func (a *Athlete) ListAthletes(ctx context.Context, req *athleteservice.ListRequest) (*athleteservice.ListResponse, error) {
 	var athletes []*athleteservice.Athlete
 	var total int64
 
-	query := a.queryBuilder.Select("id", "name", "country_id", "sport_type", "created_at", "updated_at").
-		From("athletes").
-		Limit(uint64(req.Limit)).
-		Offset(uint64((req.Page - 1) * req.Limit))
-
-	if req.CountryId != 0 {
-		query = query.Where(squirrel.Eq{"country_id": req.CountryId})
+	selected, err := athleteColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
 	}
-	if req.SportType != "" {
-		query = query.Where(squirrel.Eq{"sport_type": req.SportType})
+
+	query := a.queryBuilder.Select(selected.exprs()...).
+		From("athletes").
+		OrderBy("id")
+
+	// Batch lookups by id are not paginated.
+	if len(req.Ids) == 0 || req.Limit > 0 {
+		if req.PageToken != "" {
+			var after int64
+			if err := a.pageTokens.Decode(req.PageToken, athletePages(req), &after); err != nil {
+				return nil, err
+			}
+			query = query.Where(squirrel.Gt{"id": after})
+		} else {
+			query = query.Offset(pageOffset(req.Page, req.Limit))
+		}
+		query = query.Limit(pageLimit(req.Limit))
 	}
+
+	query = filterAthletes(query, req)
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
@@ -157,22 +233,110 @@ func (a *Athlete) ListAthletes(ctx context.Context, req *athleteservice.ListRequ
 	defer rows.Close()
 
 	for rows.Next() {
-		var athlete athleteservice.Athlete
-		err := rows.Scan(&athlete.Id, &athlete.Name, &athlete.CountryId, &athlete.SportType, &athlete.CreatedAt, &athlete.UpdatedAt)
+		athlete, err := selected.scan(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan athlete row: %v", err)
 		}
-		athletes = append(athletes, &athlete)
+		athletes = append(athletes, athlete)
+	}
+
+	var nextPageToken string
+	athletes, more := trimPage(athletes, req.Limit)
+	if more {
+		nextPageToken, err = a.pageTokens.Encode(athletePages(req), athletes[len(athletes)-1].Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
 	}
 
-	err = a.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM athletes").Scan(&total)
+	err = filterAthletes(a.queryBuilder.Select("COUNT(*)").From("athletes"), req).
+		RunWith(a.db).
+		QueryRowContext(ctx).
+		Scan(&total)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total number of athletes: %v", err)
 	}
 
+	if err := a.localize(ctx, athletes...); err != nil {
+		return nil, err
+	}
+
 	return &athleteservice.ListResponse{
-		Count:    total,
-		Athletes: athletes,
+		Count:         total,
+		Athletes:      athletes,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// athletePages identifies the listing an athlete page token was issued for.
+func athletePages(req *athleteservice.ListRequest) string {
+	return fmt.Sprintf("athletes/%v/%d/%q/%d", req.Ids, req.CountryId, req.SportType, req.GamesId)
+}
+
+// ExportAthletes sends every athlete matching the filters of req, ignoring
+// paging, as the rows are read from the database.
+func (a *Athlete) ExportAthletes(ctx context.Context, req *athleteservice.ListRequest, send func(*athleteservice.Athlete) error) error {
+	query := a.queryBuilder.Select(athleteColumns.exprs()...).
+		From("athletes").
+		OrderBy("id")
+
+	rows, err := filterAthletes(query, req).RunWith(a.db).QueryContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch athletes: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		athlete, err := athleteColumns.scan(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan athlete row: %v", err)
+		}
+		if err := send(athlete); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+
+// localize replaces the sport types of athletes with their translations into
+// the caller's locales, taking the earliest locale that has one.
+func (a *Athlete) localize(ctx context.Context, athletes ...*athleteservice.Athlete) error {
+	locales := locale.FromContext(ctx)
+	if len(locales) == 0 || len(athletes) == 0 {
+		return nil
+	}
+
+	sports := make([]string, len(athletes))
+	for i, athlete := range athletes {
+		sports[i] = athlete.SportType
+	}
+
+	rows, err := a.db.QueryContext(ctx, `SELECT DISTINCT ON (sport_type) sport_type, name
+		FROM sport_translations
+		WHERE sport_type = ANY($1) AND locale = ANY($2::text[])
+		ORDER BY sport_type, array_position($2::text[], locale::text)`, pq.Array(sports), pq.Array(locales))
+	if err != nil {
+		return fmt.Errorf("failed to fetch sport translations: %v", err)
+	}
+	defer rows.Close()
+
+	names := make(map[string]string)
+	for rows.Next() {
+		var sport, name string
+		if err := rows.Scan(&sport, &name); err != nil {
+			return fmt.Errorf("failed to scan translation row: %v", err)
+		}
+		names[sport] = name
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	for _, athlete := range athletes {
+		if name, ok := names[athlete.SportType]; ok {
+			athlete.SportType = name
+		}
+	}
+	return nil
+}
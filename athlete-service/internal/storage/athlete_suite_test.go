@@ -9,6 +9,7 @@ import (
 	"olympy/athlete-service/internal/config"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -85,6 +86,15 @@ func (s *AthleteRepositoryTestSuite) TestAthleteCRUD() {
 	s.NotZero(listResp.Count)
 	s.NotEmpty(listResp.Athletes)
 
+	// List athletes with a field mask
+	listReq.Fields = &types.FieldMask{Paths: []string{"name"}}
+	maskedResp, err := s.repo.ListAthletes(ctx, listReq)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(maskedResp.Athletes)
+	s.NotZero(maskedResp.Athletes[0].Id)
+	s.NotEmpty(maskedResp.Athletes[0].Name)
+	s.Empty(maskedResp.Athletes[0].SportType)
+
 	// Delete athlete
 	msg, err := s.repo.DeleteAthlete(ctx, &athleteservice.GetSingleRequest{Id: athlete.Id})
 	s.Require().NoError(err)
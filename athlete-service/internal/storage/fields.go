@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gogo/protobuf/types"
+)
+
+// ErrUnknownField is returned when a field mask names a field the entity
+// does not have.
+var ErrUnknownField = errors.New("unknown field")
+
+// column reads the proto field named field from the SQL expression expr.
+type column[T any] struct {
+	field string
+	expr  string
+	dest  func(*T) interface{}
+}
+
+// columns lists the readable fields of T, starting with its id.
+type columns[T any] []column[T]
+
+// selection returns the columns named by mask, or all of them when mask is
+// empty. The id is always selected so that results can be told apart.
+func (cs columns[T]) selection(mask *types.FieldMask) (columns[T], error) {
+	if mask == nil || len(mask.Paths) == 0 {
+		return cs, nil
+	}
+
+	selected := columns[T]{cs[0]}
+	for _, path := range mask.Paths {
+		c, ok := cs.find(path)
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownField, path)
+		}
+		if !selected.has(path) {
+			selected = append(selected, c)
+		}
+	}
+	return selected, nil
+}
+
+func (cs columns[T]) find(field string) (column[T], bool) {
+	for _, c := range cs {
+		if c.field == field {
+			return c, true
+		}
+	}
+	return column[T]{}, false
+}
+
+func (cs columns[T]) has(field string) bool {
+	_, ok := cs.find(field)
+	return ok
+}
+
+func (cs columns[T]) exprs() []string {
+	exprs := make([]string, len(cs))
+	for i, c := range cs {
+		exprs[i] = c.expr
+	}
+	return exprs
+}
+
+// sql joins the expressions of cs for use in a SELECT or RETURNING clause.
+func (cs columns[T]) sql() string {
+	return strings.Join(cs.exprs(), ", ")
+}
+
+func (cs columns[T]) scan(row squirrel.RowScanner) (*T, error) {
+	var v T
+	dest := make([]interface{}, len(cs))
+	for i, c := range cs {
+		dest[i] = c.dest(&v)
+	}
+	return &v, row.Scan(dest...)
+}
@@ -0,0 +1,27 @@
+package storage
+
+// pageLimit is the number of rows fetched for a page of limit rows. The
+// extra row tells whether there is a next page.
+func pageLimit(limit int32) uint64 {
+	if limit <= 0 {
+		return 0
+	}
+	return uint64(limit) + 1
+}
+
+// pageOffset skips the pages before page in page-number mode.
+func pageOffset(page, limit int32) uint64 {
+	if page <= 1 || limit <= 0 {
+		return 0
+	}
+	return uint64(page-1) * uint64(limit)
+}
+
+// trimPage drops the extra row fetched by pageLimit and reports whether
+// there is a next page.
+func trimPage[T any](rows []T, limit int32) ([]T, bool) {
+	if limit <= 0 || len(rows) <= int(limit) {
+		return rows, false
+	}
+	return rows[:limit], true
+}
@@ -0,0 +1,4 @@
+ALTER TABLE medals DROP COLUMN IF EXISTS games_id;
+ALTER TABLE athletes DROP COLUMN IF EXISTS games_id;
+ALTER TABLE events DROP COLUMN IF EXISTS games_id;
+DROP TABLE IF EXISTS games;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS event_status_history;
+DROP INDEX IF EXISTS events_status_idx;
+ALTER TABLE events DROP COLUMN IF EXISTS status;
@@ -0,0 +1,26 @@
+-- Lifecycle of events. Events already under way or over are moved to live
+-- or completed; the rest are scheduled.
+ALTER TABLE events ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'scheduled'
+    CHECK (status IN ('scheduled', 'live', 'delayed', 'suspended', 'completed', 'cancelled'));
+
+UPDATE events SET status = 'completed' WHERE end_time <= NOW();
+UPDATE events SET status = 'live' WHERE start_time <= NOW() AND end_time > NOW();
+
+-- Transitions of event statuses, oldest first. published is set once the
+-- change has been sent to streaming-service.
+CREATE TABLE event_status_history (
+    id SERIAL PRIMARY KEY,
+    event_id INT NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    from_status VARCHAR(16) NOT NULL,
+    to_status VARCHAR(16) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    actor VARCHAR(100) NOT NULL,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX event_status_history_event_id_idx ON event_status_history (event_id, id);
+CREATE INDEX event_status_history_unpublished_idx ON event_status_history (id) WHERE NOT published;
+
+-- Serves the automatic transitions.
+CREATE INDEX events_status_idx ON events (status, start_time);
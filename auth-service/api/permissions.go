@@ -0,0 +1,16 @@
+package api
+
+import "olympy/pkg/identity"
+
+// permissions are the scopes the caller's identity needs for each RPC.
+// Anonymous callers register and log in, so those only require a verified
+// identity.
+var permissions = identity.Permissions{
+	"/auth_service.AuthService/RegisterUser": "",
+	"/auth_service.AuthService/LoginUser":    "",
+	"/auth_service.AuthService/RefreshToken": "",
+	"/auth_service.AuthService/ListUsers":    "users:manage",
+	"/auth_service.AuthService/GetUser":      "users:manage",
+	"/auth_service.AuthService/SetUserRole":  "users:manage",
+	"/auth_service.AuthService/DeleteUser":   "users:manage",
+}
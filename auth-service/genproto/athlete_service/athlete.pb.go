@@ -6,6 +6,7 @@ package athlete_service
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -33,6 +34,7 @@ type Athlete struct {
 	SportType            string   `protobuf:"bytes,4,opt,name=sport_type,json=sportType,proto3" json:"sport_type"`
 	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	GamesId              int64    `protobuf:"varint,7,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -113,11 +115,19 @@ func (m *Athlete) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *Athlete) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
 type GetSingleRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Fields               *types.FieldMask `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetSingleRequest) Reset()         { *m = GetSingleRequest{} }
@@ -160,14 +170,25 @@ func (m *GetSingleRequest) GetId() int64 {
 	return 0
 }
 
+func (m *GetSingleRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 type ListRequest struct {
-	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	CountryId            int64    `protobuf:"varint,3,opt,name=country_id,json=countryId,proto3" json:"country_id"`
-	SportType            string   `protobuf:"bytes,4,opt,name=sport_type,json=sportType,proto3" json:"sport_type"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page                 int32            `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int32            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	CountryId            int64            `protobuf:"varint,3,opt,name=country_id,json=countryId,proto3" json:"country_id"`
+	SportType            string           `protobuf:"bytes,4,opt,name=sport_type,json=sportType,proto3" json:"sport_type"`
+	Ids                  []int64          `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids"`
+	GamesId              int64            `protobuf:"varint,6,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	Fields               *types.FieldMask `protobuf:"bytes,7,opt,name=fields,proto3" json:"fields"`
+	PageToken            string           `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
@@ -231,9 +252,38 @@ func (m *ListRequest) GetSportType() string {
 	return ""
 }
 
+func (m *ListRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ListRequest) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
+func (m *ListRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListResponse struct {
 	Count                int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Athletes             []*Athlete `protobuf:"bytes,2,rep,name=athletes,proto3" json:"athletes"`
+	NextPageToken        string     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -286,6 +336,13 @@ func (m *ListResponse) GetAthletes() []*Athlete {
 	return nil
 }
 
+func (m *ListResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Message struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -333,43 +390,354 @@ func (m *Message) GetMessage() string {
 	return ""
 }
 
+type ImportOptions struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Chunked              bool     `protobuf:"varint,3,opt,name=chunked,proto3" json:"chunked"`
+	ChunkSize            int32    `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size"`
+	GamesId              int64    `protobuf:"varint,5,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOptions) Reset()         { *m = ImportOptions{} }
+func (m *ImportOptions) String() string { return proto.CompactTextString(m) }
+func (*ImportOptions) ProtoMessage()    {}
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea8d4527636194e9, []int{5}
+}
+func (m *ImportOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOptions.Merge(m, src)
+}
+func (m *ImportOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOptions proto.InternalMessageInfo
+
+func (m *ImportOptions) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunked() bool {
+	if m != nil {
+		return m.Chunked
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *ImportOptions) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
+type ImportRequest struct {
+	Options              *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options"`
+	Data                 []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea8d4527636194e9, []int{6}
+}
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetOptions() *ImportOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	Row                  int32    `protobuf:"varint,1,opt,name=row,proto3" json:"row"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowError) Reset()         { *m = ImportRowError{} }
+func (m *ImportRowError) String() string { return proto.CompactTextString(m) }
+func (*ImportRowError) ProtoMessage()    {}
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea8d4527636194e9, []int{7}
+}
+func (m *ImportRowError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRowError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowError.Merge(m, src)
+}
+func (m *ImportRowError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowError proto.InternalMessageInfo
+
+func (m *ImportRowError) GetRow() int32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportRowError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ImportRowError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportReport struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Valid                int32             `protobuf:"varint,2,opt,name=valid,proto3" json:"valid"`
+	Imported             int32             `protobuf:"varint,3,opt,name=imported,proto3" json:"imported"`
+	Failed               int32             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed"`
+	DryRun               bool              `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Errors               []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportReport) Reset()         { *m = ImportReport{} }
+func (m *ImportReport) String() string { return proto.CompactTextString(m) }
+func (*ImportReport) ProtoMessage()    {}
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea8d4527636194e9, []int{8}
+}
+func (m *ImportReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportReport.Merge(m, src)
+}
+func (m *ImportReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportReport proto.InternalMessageInfo
+
+func (m *ImportReport) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImportReport) GetValid() int32 {
+	if m != nil {
+		return m.Valid
+	}
+	return 0
+}
+
+func (m *ImportReport) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportReport) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportReport) GetErrors() []*ImportRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Athlete)(nil), "athlete_service.Athlete")
 	proto.RegisterType((*GetSingleRequest)(nil), "athlete_service.GetSingleRequest")
 	proto.RegisterType((*ListRequest)(nil), "athlete_service.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "athlete_service.ListResponse")
 	proto.RegisterType((*Message)(nil), "athlete_service.Message")
+	proto.RegisterType((*ImportOptions)(nil), "athlete_service.ImportOptions")
+	proto.RegisterType((*ImportRequest)(nil), "athlete_service.ImportRequest")
+	proto.RegisterType((*ImportRowError)(nil), "athlete_service.ImportRowError")
+	proto.RegisterType((*ImportReport)(nil), "athlete_service.ImportReport")
 }
 
 func init() { proto.RegisterFile("athlete_service/athlete.proto", fileDescriptor_ea8d4527636194e9) }
 
 var fileDescriptor_ea8d4527636194e9 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0xce, 0xd2, 0x40,
-	0x10, 0xb6, 0x85, 0x82, 0x1d, 0x14, 0xc9, 0xc6, 0xc3, 0x86, 0x48, 0x83, 0xf5, 0xc2, 0x09, 0x13,
-	0xf4, 0x01, 0xac, 0xd1, 0x10, 0x22, 0x5e, 0x8a, 0x27, 0x2f, 0x4d, 0x65, 0x27, 0xb8, 0x09, 0xb4,
-	0xb5, 0x3b, 0x35, 0xe1, 0x4d, 0x7c, 0x05, 0xdf, 0xc4, 0xa3, 0x8f, 0x60, 0xf0, 0x31, 0xfe, 0xcb,
-	0x9f, 0xee, 0x6e, 0xff, 0xfc, 0x29, 0x3f, 0x27, 0x6e, 0x33, 0xdf, 0x37, 0xf3, 0xcd, 0xcc, 0x7e,
-	0x59, 0x98, 0xa4, 0xf4, 0x7d, 0x8f, 0x84, 0x89, 0xc2, 0xf2, 0xa7, 0xdc, 0xe2, 0x6b, 0x9b, 0xcf,
-	0x8b, 0x32, 0xa7, 0x9c, 0x3d, 0x6b, 0xd1, 0xe1, 0x6f, 0x07, 0xfa, 0x91, 0xc1, 0xd8, 0x10, 0x5c,
-	0x29, 0xb8, 0x33, 0x75, 0x66, 0x9d, 0xd8, 0x95, 0x82, 0x31, 0xe8, 0x66, 0xe9, 0x01, 0xb9, 0x3b,
-	0x75, 0x66, 0x7e, 0xac, 0x63, 0x36, 0x01, 0xd8, 0xe6, 0x55, 0x46, 0xe5, 0x31, 0x91, 0x82, 0x77,
-	0x74, 0xad, 0x6f, 0x91, 0x95, 0xa8, 0x69, 0x55, 0xe4, 0x25, 0x25, 0x74, 0x2c, 0x90, 0x77, 0x75,
-	0xa3, 0xaf, 0x91, 0x2f, 0xc7, 0xc2, 0x74, 0x97, 0x98, 0x12, 0x8a, 0x24, 0x25, 0xee, 0x19, 0xda,
-	0x22, 0x11, 0xd5, 0x74, 0x55, 0x88, 0x86, 0xee, 0x19, 0xda, 0x22, 0x11, 0x85, 0x21, 0x8c, 0x96,
-	0x48, 0x1b, 0x99, 0xed, 0xf6, 0x18, 0xe3, 0x8f, 0x0a, 0x15, 0xb5, 0x77, 0x0e, 0x2b, 0x18, 0xac,
-	0xa5, 0xa2, 0x86, 0x66, 0xd0, 0x2d, 0xd2, 0x1d, 0xea, 0x02, 0x2f, 0xd6, 0x31, 0x7b, 0x0e, 0xde,
-	0x5e, 0x1e, 0x24, 0xe9, 0xbb, 0xbc, 0xd8, 0x24, 0xd7, 0x1d, 0x16, 0x7e, 0x85, 0x27, 0x66, 0xac,
-	0x2a, 0xf2, 0x4c, 0xe9, 0x19, 0xba, 0xd7, 0x6e, 0x66, 0x12, 0xf6, 0x16, 0x1e, 0xdb, 0xf7, 0x57,
-	0xdc, 0x9d, 0x76, 0x66, 0x83, 0x05, 0x9f, 0xb7, 0x0c, 0x99, 0x5b, 0x33, 0xe2, 0xbb, 0xca, 0xf0,
-	0x15, 0xf4, 0x3f, 0xa3, 0x52, 0xf5, 0xea, 0x1c, 0xfa, 0x07, 0x13, 0x6a, 0x61, 0x3f, 0x6e, 0xd2,
-	0xc5, 0x8d, 0x0b, 0x43, 0xdb, 0xba, 0x31, 0x4a, 0xec, 0x1d, 0x40, 0x24, 0x44, 0x63, 0xee, 0xc5,
-	0x49, 0xe3, 0x8b, 0x0c, 0x8b, 0x60, 0xf0, 0x51, 0x48, 0xba, 0x46, 0x62, 0x0d, 0x4f, 0x3f, 0x60,
-	0x1d, 0x35, 0xc0, 0xcb, 0xb3, 0xd2, 0xb6, 0xa7, 0x0f, 0xa8, 0x35, 0xf7, 0x7f, 0x32, 0xcf, 0x6c,
-	0xb5, 0x14, 0x7b, 0x71, 0x56, 0x79, 0xcf, 0xfc, 0xf1, 0xe4, 0x02, 0x6b, 0x3d, 0x5a, 0x01, 0x2c,
-	0x91, 0xae, 0xda, 0xcb, 0x36, 0xbf, 0x1f, 0xfd, 0x39, 0x05, 0xce, 0xdf, 0x53, 0xe0, 0xfc, 0x3b,
-	0x05, 0xce, 0xaf, 0xff, 0xc1, 0xa3, 0x6f, 0x3d, 0xfd, 0xdf, 0xde, 0xdc, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0x66, 0x6d, 0x57, 0x90, 0x03, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x48,
+	0x14, 0x5f, 0xd7, 0xb1, 0x9d, 0xbc, 0xb4, 0x69, 0x35, 0x5a, 0xed, 0x7a, 0xa3, 0x6d, 0x36, 0x9b,
+	0x95, 0x56, 0x39, 0xa5, 0xab, 0x2c, 0x12, 0x1c, 0x09, 0xa2, 0x54, 0x81, 0x56, 0xc0, 0xb4, 0xe2,
+	0x86, 0x2c, 0xb7, 0x33, 0x4d, 0x47, 0x71, 0x6c, 0xe3, 0x99, 0xb4, 0x4d, 0x8f, 0x7c, 0x06, 0x0e,
+	0x88, 0x4f, 0xc3, 0x81, 0x03, 0x47, 0x3e, 0x02, 0x2a, 0x9f, 0x81, 0x3b, 0x9a, 0x7f, 0x51, 0xdc,
+	0x10, 0x84, 0xd4, 0x8b, 0xf5, 0xfe, 0xcd, 0x9b, 0xdf, 0xfb, 0xbd, 0xdf, 0x18, 0xb6, 0x63, 0x71,
+	0x96, 0x50, 0x41, 0x23, 0x4e, 0x8b, 0x73, 0x76, 0x42, 0x77, 0x8c, 0xdf, 0xcb, 0x8b, 0x4c, 0x64,
+	0x68, 0xf3, 0x46, 0xba, 0xd9, 0x1e, 0x65, 0xd9, 0x28, 0xa1, 0x3b, 0x2a, 0x7d, 0x3c, 0x3d, 0xdd,
+	0x39, 0x65, 0x34, 0x21, 0xd1, 0x24, 0xe6, 0x63, 0x7d, 0xa4, 0xf3, 0xc1, 0x81, 0x60, 0xa0, 0x4f,
+	0xa1, 0x06, 0xac, 0x31, 0x12, 0x3a, 0x6d, 0xa7, 0xeb, 0xe2, 0x35, 0x46, 0x10, 0x82, 0x4a, 0x1a,
+	0x4f, 0x68, 0xb8, 0xd6, 0x76, 0xba, 0x35, 0xac, 0x6c, 0xb4, 0x0d, 0x70, 0x92, 0x4d, 0x53, 0x51,
+	0xcc, 0x22, 0x46, 0x42, 0x57, 0xd5, 0xd6, 0x4c, 0x64, 0x48, 0x64, 0x9a, 0xe7, 0x59, 0x21, 0x22,
+	0x31, 0xcb, 0x69, 0x58, 0x51, 0x07, 0x6b, 0x2a, 0x72, 0x34, 0xcb, 0xf5, 0xe9, 0x82, 0xc6, 0x82,
+	0x92, 0x28, 0x16, 0xa1, 0xa7, 0xd3, 0x26, 0x32, 0x10, 0x32, 0x3d, 0xcd, 0x89, 0x4d, 0xfb, 0x3a,
+	0x6d, 0x22, 0x03, 0x81, 0xfe, 0x80, 0xea, 0x28, 0x9e, 0x50, 0x2e, 0x6f, 0x0e, 0xd4, 0xcd, 0x81,
+	0xf2, 0x87, 0xa4, 0xf3, 0x02, 0xb6, 0xf6, 0xa8, 0x38, 0x64, 0xe9, 0x28, 0xa1, 0x98, 0xbe, 0x9a,
+	0x52, 0x2e, 0x96, 0xc6, 0xe9, 0x83, 0xaf, 0xc6, 0xe7, 0x6a, 0xa0, 0x7a, 0xbf, 0xd9, 0xd3, 0xec,
+	0xf4, 0x2c, 0x3b, 0xbd, 0x47, 0x32, 0x7d, 0x10, 0xf3, 0x31, 0x36, 0x95, 0x9d, 0xaf, 0x0e, 0xd4,
+	0xf7, 0x19, 0x17, 0xb6, 0x27, 0x82, 0x4a, 0x1e, 0x8f, 0xa8, 0xea, 0xea, 0x61, 0x65, 0xa3, 0x5f,
+	0xc1, 0x4b, 0xd8, 0x84, 0x09, 0xd5, 0xd6, 0xc3, 0xda, 0xb9, 0x25, 0x51, 0x5b, 0xe0, 0x32, 0xc2,
+	0x43, 0xaf, 0xed, 0x76, 0x5d, 0x2c, 0xcd, 0xd2, 0xf0, 0x7e, 0x69, 0xf8, 0x85, 0xc1, 0x82, 0x9f,
+	0x1d, 0x4c, 0xde, 0x2f, 0xc1, 0x47, 0x22, 0x1b, 0xd3, 0x34, 0xac, 0xea, 0xfb, 0x65, 0xe4, 0x48,
+	0x06, 0x3a, 0xaf, 0x1d, 0x58, 0xd7, 0x73, 0xf3, 0x3c, 0x4b, 0xb9, 0x1a, 0x52, 0x81, 0x37, 0x7c,
+	0x6a, 0x07, 0xdd, 0x81, 0xaa, 0x91, 0x9c, 0x24, 0xd5, 0xed, 0xd6, 0xfb, 0x61, 0xef, 0x86, 0x06,
+	0x7b, 0x46, 0x5d, 0x78, 0x5e, 0x89, 0xfe, 0x85, 0xcd, 0x94, 0x5e, 0x8a, 0x68, 0x01, 0x80, 0xab,
+	0x00, 0x6c, 0xc8, 0xf0, 0xb3, 0x39, 0x88, 0x7f, 0x20, 0x38, 0xa0, 0x9c, 0x4b, 0x8e, 0x43, 0x08,
+	0x26, 0xda, 0x54, 0x00, 0x6a, 0xd8, 0xba, 0x9d, 0x37, 0x0e, 0x6c, 0x0c, 0x27, 0x92, 0xb8, 0xa7,
+	0xb9, 0x60, 0x59, 0xca, 0xd1, 0x6f, 0xe0, 0x9f, 0x66, 0xc5, 0x24, 0x16, 0xa6, 0xd4, 0x78, 0xe8,
+	0x77, 0x08, 0x48, 0x31, 0x8b, 0x8a, 0x69, 0xaa, 0x36, 0x55, 0xc5, 0x3e, 0x29, 0x66, 0x78, 0x9a,
+	0xca, 0xe6, 0x27, 0x67, 0xd3, 0x74, 0x4c, 0xf5, 0x9e, 0xaa, 0xd8, 0xba, 0x6a, 0x89, 0xd2, 0x8c,
+	0x38, 0xbb, 0xd2, 0x5b, 0xf2, 0x70, 0x4d, 0x45, 0x0e, 0xd9, 0x15, 0x2d, 0xed, 0xc4, 0x2b, 0x0b,
+	0xf2, 0xa5, 0x45, 0x65, 0x95, 0x73, 0x0f, 0x82, 0x4c, 0x03, 0x54, 0xb0, 0xea, 0xfd, 0xd6, 0x12,
+	0x53, 0xa5, 0x31, 0xb0, 0x2d, 0x97, 0x9a, 0x23, 0xb1, 0x88, 0x15, 0xe8, 0x75, 0xac, 0xec, 0x0e,
+	0x86, 0x86, 0x69, 0x9f, 0x5d, 0xec, 0x16, 0x45, 0x56, 0x48, 0xc5, 0x14, 0xd9, 0x85, 0x11, 0xa6,
+	0x34, 0xe5, 0xca, 0xd4, 0xb2, 0xcd, 0xfb, 0xd5, 0xce, 0x22, 0x93, 0x6e, 0x99, 0xc9, 0xf7, 0x0e,
+	0xac, 0x5b, 0xcc, 0xf2, 0x2b, 0x1b, 0x88, 0x4c, 0xc4, 0x89, 0x69, 0xaa, 0x1d, 0x19, 0x3d, 0x8f,
+	0x13, 0x46, 0xac, 0xdc, 0x95, 0x83, 0x9a, 0x50, 0x65, 0xea, 0xac, 0x21, 0xd1, 0xc3, 0x73, 0x5f,
+	0x2d, 0x24, 0x66, 0x09, 0x25, 0x86, 0x41, 0xe3, 0x2d, 0x2e, 0xc4, 0x2b, 0x2d, 0xe4, 0x2e, 0xf8,
+	0x54, 0x0e, 0xc5, 0x43, 0x5f, 0x89, 0xea, 0xaf, 0x15, 0x54, 0xd9, 0xe1, 0xb1, 0x29, 0xef, 0xbf,
+	0xab, 0x40, 0xc3, 0xe8, 0xed, 0x50, 0x57, 0xa2, 0xfb, 0x00, 0x03, 0x42, 0x4c, 0x10, 0xad, 0x94,
+	0x67, 0x73, 0x65, 0x06, 0x0d, 0xa0, 0xbe, 0x4b, 0x98, 0xb8, 0x4d, 0x8b, 0x7d, 0xd8, 0x78, 0x48,
+	0xa5, 0x65, 0x03, 0x7f, 0x2f, 0x95, 0xde, 0xfc, 0x7d, 0x7d, 0xa7, 0x9b, 0x7d, 0x0c, 0x4f, 0xf4,
+	0xdb, 0x1c, 0xd8, 0xf7, 0xf4, 0xe7, 0x52, 0xe5, 0xc2, 0x2f, 0xab, 0xb9, 0xbd, 0x22, 0x6b, 0x1e,
+	0xf6, 0x10, 0x60, 0x8f, 0x8a, 0x5b, 0xe1, 0xb2, 0x87, 0x9f, 0x5b, 0x51, 0xce, 0x91, 0xad, 0xd2,
+	0xf8, 0x6a, 0x6c, 0x8b, 0x02, 0xec, 0x3a, 0xe8, 0x31, 0x34, 0x76, 0x2f, 0x4b, 0x2d, 0x7f, 0x3c,
+	0xec, 0x4a, 0x70, 0xff, 0x39, 0x0f, 0xb6, 0x3e, 0x5e, 0xb7, 0x9c, 0x4f, 0xd7, 0x2d, 0xe7, 0xf3,
+	0x75, 0xcb, 0x79, 0xfb, 0xa5, 0xf5, 0xcb, 0xb1, 0xaf, 0x7e, 0x90, 0xff, 0x7f, 0x1b, 0x00, 0xb0,
+	0x22, 0x8d, 0x40, 0x57, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -389,6 +757,8 @@ type AthleteServiceClient interface {
 	DeleteAthlete(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Message, error)
 	ListAthletes(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	GetAthlete(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Athlete, error)
+	ImportAthletes(ctx context.Context, opts ...grpc.CallOption) (AthleteService_ImportAthletesClient, error)
+	ExportAthletes(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (AthleteService_ExportAthletesClient, error)
 }
 
 type athleteServiceClient struct {
@@ -444,6 +814,72 @@ func (c *athleteServiceClient) GetAthlete(ctx context.Context, in *GetSingleRequ
 	return out, nil
 }
 
+func (c *athleteServiceClient) ImportAthletes(ctx context.Context, opts ...grpc.CallOption) (AthleteService_ImportAthletesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AthleteService_serviceDesc.Streams[0], "/athlete_service.AthleteService/ImportAthletes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &athleteServiceImportAthletesClient{stream}
+	return x, nil
+}
+
+type AthleteService_ImportAthletesClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportReport, error)
+	grpc.ClientStream
+}
+
+type athleteServiceImportAthletesClient struct {
+	grpc.ClientStream
+}
+
+func (x *athleteServiceImportAthletesClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *athleteServiceImportAthletesClient) CloseAndRecv() (*ImportReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *athleteServiceClient) ExportAthletes(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (AthleteService_ExportAthletesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AthleteService_serviceDesc.Streams[1], "/athlete_service.AthleteService/ExportAthletes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &athleteServiceExportAthletesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AthleteService_ExportAthletesClient interface {
+	Recv() (*Athlete, error)
+	grpc.ClientStream
+}
+
+type athleteServiceExportAthletesClient struct {
+	grpc.ClientStream
+}
+
+func (x *athleteServiceExportAthletesClient) Recv() (*Athlete, error) {
+	m := new(Athlete)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AthleteServiceServer is the server API for AthleteService service.
 type AthleteServiceServer interface {
 	AddAthlete(context.Context, *Athlete) (*Athlete, error)
@@ -451,6 +887,8 @@ type AthleteServiceServer interface {
 	DeleteAthlete(context.Context, *GetSingleRequest) (*Message, error)
 	ListAthletes(context.Context, *ListRequest) (*ListResponse, error)
 	GetAthlete(context.Context, *GetSingleRequest) (*Athlete, error)
+	ImportAthletes(AthleteService_ImportAthletesServer) error
+	ExportAthletes(*ListRequest, AthleteService_ExportAthletesServer) error
 }
 
 // UnimplementedAthleteServiceServer can be embedded to have forward compatible implementations.
@@ -472,6 +910,12 @@ func (*UnimplementedAthleteServiceServer) ListAthletes(ctx context.Context, req
 func (*UnimplementedAthleteServiceServer) GetAthlete(ctx context.Context, req *GetSingleRequest) (*Athlete, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAthlete not implemented")
 }
+func (*UnimplementedAthleteServiceServer) ImportAthletes(srv AthleteService_ImportAthletesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportAthletes not implemented")
+}
+func (*UnimplementedAthleteServiceServer) ExportAthletes(req *ListRequest, srv AthleteService_ExportAthletesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAthletes not implemented")
+}
 
 func RegisterAthleteServiceServer(s *grpc.Server, srv AthleteServiceServer) {
 	s.RegisterService(&_AthleteService_serviceDesc, srv)
@@ -567,14 +1011,61 @@ func _AthleteService_GetAthlete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _AthleteService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "athlete_service.AthleteService",
-	HandlerType: (*AthleteServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddAthlete",
-			Handler:    _AthleteService_AddAthlete_Handler,
-		},
+func _AthleteService_ImportAthletes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AthleteServiceServer).ImportAthletes(&athleteServiceImportAthletesServer{stream})
+}
+
+type AthleteService_ImportAthletesServer interface {
+	SendAndClose(*ImportReport) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type athleteServiceImportAthletesServer struct {
+	grpc.ServerStream
+}
+
+func (x *athleteServiceImportAthletesServer) SendAndClose(m *ImportReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *athleteServiceImportAthletesServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AthleteService_ExportAthletes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AthleteServiceServer).ExportAthletes(m, &athleteServiceExportAthletesServer{stream})
+}
+
+type AthleteService_ExportAthletesServer interface {
+	Send(*Athlete) error
+	grpc.ServerStream
+}
+
+type athleteServiceExportAthletesServer struct {
+	grpc.ServerStream
+}
+
+func (x *athleteServiceExportAthletesServer) Send(m *Athlete) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _AthleteService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "athlete_service.AthleteService",
+	HandlerType: (*AthleteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAthlete",
+			Handler:    _AthleteService_AddAthlete_Handler,
+		},
 		{
 			MethodName: "EditAthlete",
 			Handler:    _AthleteService_EditAthlete_Handler,
@@ -592,7 +1083,18 @@ var _AthleteService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _AthleteService_GetAthlete_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportAthletes",
+			Handler:       _AthleteService_ImportAthletes_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportAthletes",
+			Handler:       _AthleteService_ExportAthletes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "athlete_service/athlete.proto",
 }
 
@@ -620,6 +1122,11 @@ func (m *Athlete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GamesId != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.UpdatedAt) > 0 {
 		i -= len(m.UpdatedAt)
 		copy(dAtA[i:], m.UpdatedAt)
@@ -685,6 +1192,18 @@ func (m *GetSingleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAthlete(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Id != 0 {
 		i = encodeVarintAthlete(dAtA, i, uint64(m.Id))
 		i--
@@ -717,6 +1236,49 @@ func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAthlete(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GamesId != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Ids) > 0 {
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
+		for _, num1 := range m.Ids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAthlete(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.SportType) > 0 {
 		i -= len(m.SportType)
 		copy(dAtA[i:], m.SportType)
@@ -766,6 +1328,13 @@ func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Athletes) > 0 {
 		for iNdEx := len(m.Athletes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -822,6 +1391,233 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ImportOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GamesId != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Chunked {
+		i--
+		if m.Chunked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAthlete(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRowError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRowError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRowError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAthlete(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Row != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAthlete(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Imported != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Valid != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.Valid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintAthlete(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAthlete(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAthlete(v)
 	base := offset
@@ -861,6 +1657,9 @@ func (m *Athlete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAthlete(uint64(l))
 	}
+	if m.GamesId != 0 {
+		n += 1 + sovAthlete(uint64(m.GamesId))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -876,6 +1675,10 @@ func (m *GetSingleRequest) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovAthlete(uint64(m.Id))
 	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovAthlete(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -901,6 +1704,24 @@ func (m *ListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAthlete(uint64(l))
 	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovAthlete(uint64(e))
+		}
+		n += 1 + sovAthlete(uint64(l)) + l
+	}
+	if m.GamesId != 0 {
+		n += 1 + sovAthlete(uint64(m.GamesId))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -922,6 +1743,10 @@ func (m *ListResponse) Size() (n int) {
 			n += 1 + l + sovAthlete(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -944,15 +1769,119 @@ func (m *Message) Size() (n int) {
 	return n
 }
 
-func sovAthlete(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAthlete(x uint64) (n int) {
-	return sovAthlete(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ImportOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.Chunked {
+		n += 2
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovAthlete(uint64(m.ChunkSize))
+	}
+	if m.GamesId != 0 {
+		n += 1 + sovAthlete(uint64(m.GamesId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *Athlete) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *ImportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRowError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovAthlete(uint64(m.Row))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovAthlete(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovAthlete(uint64(m.Total))
+	}
+	if m.Valid != 0 {
+		n += 1 + sovAthlete(uint64(m.Valid))
+	}
+	if m.Imported != 0 {
+		n += 1 + sovAthlete(uint64(m.Imported))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovAthlete(uint64(m.Failed))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovAthlete(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAthlete(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAthlete(x uint64) (n int) {
+	return sovAthlete(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Athlete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1145,6 +2074,25 @@ func (m *Athlete) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
+			}
+			m.GamesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAthlete(dAtA[iNdEx:])
@@ -1215,6 +2163,42 @@ func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAthlete(dAtA[iNdEx:])
@@ -1355,6 +2339,169 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SportType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAthlete
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAthlete
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAthlete
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAthlete
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAthlete
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
+			}
+			m.GamesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAthlete(dAtA[iNdEx:])
@@ -1459,6 +2606,38 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAthlete(dAtA[iNdEx:])
@@ -1564,6 +2743,603 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ImportOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAthlete
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Chunked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
+			}
+			m.GamesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAthlete(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAthlete
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &ImportOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAthlete(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportRowError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAthlete
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportRowError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportRowError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAthlete(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAthlete
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			m.Valid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAthlete
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ImportRowError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAthlete(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAthlete
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAthlete(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
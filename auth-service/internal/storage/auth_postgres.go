@@ -3,7 +3,9 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -12,6 +14,7 @@ import (
 
 	genprotos "olympy/auth-service/genproto/auth_service"
 	"olympy/auth-service/internal/config"
+	"olympy/pkg/pagetoken"
 )
 
 type AuthService struct {
@@ -20,6 +23,7 @@ type AuthService struct {
 	jwtSecret       string
 	accessTokenExp  time.Duration
 	refreshTokenExp time.Duration
+	pageTokens      *pagetoken.Signer
 }
 
 func NewAuthService(cfg *config.Config) (*AuthService, error) {
@@ -34,9 +38,18 @@ func NewAuthService(cfg *config.Config) (*AuthService, error) {
 		jwtSecret:       cfg.JWT.Secret,
 		accessTokenExp:  cfg.JWT.AccessTokenExp,
 		refreshTokenExp: cfg.JWT.RefreshTokenExp,
+		pageTokens:      pagetoken.NewSigner(cfg.Pagination.TokenSecret),
 	}, nil
 }
 
+func (a *AuthService) Ping(ctx context.Context) error {
+	return a.db.PingContext(ctx)
+}
+
+func (a *AuthService) Close() error {
+	return a.db.Close()
+}
+
 func (a *AuthService) RegisterUser(ctx context.Context, req *genprotos.RegisterUserRequest) (*genprotos.RegisterUserResponse, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -155,3 +168,132 @@ func (a *AuthService) RefreshToken(ctx context.Context, req *genprotos.RefreshTo
 		AccessToken: accessToken,
 	}, nil
 }
+
+// ErrUserNotFound is returned for user IDs that do not exist.
+var ErrUserNotFound = errors.New("user not found")
+
+// ListUsers returns a page of users in ID order. A page token resumes after
+// the last user of the previous page; otherwise the page number is used.
+func (a *AuthService) ListUsers(ctx context.Context, req *genprotos.ListUsersRequest) (*genprotos.ListUsersResponse, error) {
+	limit := uint64(req.Limit)
+	if req.Limit <= 0 {
+		limit = 10
+	}
+	page := uint64(req.Page)
+	if req.Page <= 0 {
+		page = 1
+	}
+
+	// One extra row tells whether there is a next page.
+	query := a.queryBuilder.Select("id", "username", "role").
+		From("users").
+		OrderBy("id").
+		Limit(limit + 1)
+	if req.PageToken != "" {
+		var after int64
+		if err := a.pageTokens.Decode(req.PageToken, userPages(req), &after); err != nil {
+			return nil, err
+		}
+		query = query.Where(squirrel.Gt{"id": after})
+	} else {
+		query = query.Offset((page - 1) * limit)
+	}
+	count := a.queryBuilder.Select("COUNT(*)").From("users")
+	if req.Role != "" {
+		query = query.Where(squirrel.Eq{"role": req.Role})
+		count = count.Where(squirrel.Eq{"role": req.Role})
+	}
+
+	rows, err := query.RunWith(a.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %v", err)
+	}
+	defer rows.Close()
+
+	var users []*genprotos.User
+	for rows.Next() {
+		var user genprotos.User
+		if err := rows.Scan(&user.Id, &user.Username, &user.Role); err != nil {
+			return nil, fmt.Errorf("failed to scan user row: %v", err)
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %v", err)
+	}
+
+	var nextPageToken string
+	if uint64(len(users)) > limit {
+		users = users[:limit]
+		last, err := strconv.ParseInt(users[limit-1].Id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user ID: %v", err)
+		}
+		nextPageToken, err = a.pageTokens.Encode(userPages(req), last)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	var total int64
+	if err := count.RunWith(a.db).QueryRowContext(ctx).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count users: %v", err)
+	}
+
+	return &genprotos.ListUsersResponse{Users: users, Count: total, NextPageToken: nextPageToken}, nil
+}
+
+// userPages identifies the listing a user page token was issued for.
+func userPages(req *genprotos.ListUsersRequest) string {
+	return fmt.Sprintf("users/%q", req.Role)
+}
+
+func (a *AuthService) GetUser(ctx context.Context, req *genprotos.GetUserRequest) (*genprotos.User, error) {
+	query, args, err := a.queryBuilder.Select("id", "username", "role").
+		From("users").
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+	return a.scanUser(a.db.QueryRowContext(ctx, query, args...), req.Id)
+}
+
+func (a *AuthService) SetUserRole(ctx context.Context, req *genprotos.SetUserRoleRequest) (*genprotos.User, error) {
+	query, args, err := a.queryBuilder.Update("users").
+		Set("role", req.Role).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": req.Id}).
+		Suffix("RETURNING id, username, role").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+	return a.scanUser(a.db.QueryRowContext(ctx, query, args...), req.Id)
+}
+
+func (a *AuthService) DeleteUser(ctx context.Context, req *genprotos.GetUserRequest) (*genprotos.DeleteUserResponse, error) {
+	query, args, err := a.queryBuilder.Delete("users").
+		Where(squirrel.Eq{"id": req.Id}).
+		Suffix("RETURNING id, username, role").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+	if _, err := a.scanUser(a.db.QueryRowContext(ctx, query, args...), req.Id); err != nil {
+		return nil, err
+	}
+	return &genprotos.DeleteUserResponse{Message: fmt.Sprintf("User with ID %s deleted successfully", req.Id)}, nil
+}
+
+func (a *AuthService) scanUser(row *sql.Row, id string) (*genprotos.User, error) {
+	var user genprotos.User
+	err := row.Scan(&user.Id, &user.Username, &user.Role)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+	return &user, nil
+}
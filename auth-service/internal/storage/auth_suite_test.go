@@ -119,6 +119,36 @@ func (s *AuthServiceTestSuite) TestLoginUser() {
 // 	s.NotEmpty(refreshResp.AccessToken)
 // }
 
+func (s *AuthServiceTestSuite) TestUserAdmin() {
+	ctx := context.Background()
+
+	_, err := s.service.RegisterUser(ctx, &genprotos.RegisterUserRequest{
+		Username: "role-change",
+		Password: "testpass",
+		Role:     "user",
+	})
+	s.Require().NoError(err)
+	login, err := s.service.LoginUser(ctx, &genprotos.LoginUserRequest{Username: "role-change", Password: "testpass"})
+	s.Require().NoError(err)
+
+	users, err := s.service.ListUsers(ctx, &genprotos.ListUsersRequest{Role: "user", Limit: 1000})
+	s.Require().NoError(err)
+	s.Contains(users.Users, login.User)
+
+	user, err := s.service.SetUserRole(ctx, &genprotos.SetUserRoleRequest{Id: login.User.Id, Role: "admin"})
+	s.Require().NoError(err)
+	s.Equal("admin", user.Role)
+
+	user, err = s.service.GetUser(ctx, &genprotos.GetUserRequest{Id: login.User.Id})
+	s.Require().NoError(err)
+	s.Equal("admin", user.Role)
+
+	_, err = s.service.DeleteUser(ctx, &genprotos.GetUserRequest{Id: login.User.Id})
+	s.Require().NoError(err)
+	_, err = s.service.GetUser(ctx, &genprotos.GetUserRequest{Id: login.User.Id})
+	s.ErrorIs(err, ErrUserNotFound)
+}
+
 func TestAuthServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthServiceTestSuite))
 }
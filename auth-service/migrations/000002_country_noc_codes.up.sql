@@ -0,0 +1,15 @@
+-- NOC codes let bulk imports reference countries by code, e.g. "JPN".
+ALTER TABLE countries ADD COLUMN noc_code VARCHAR(3) UNIQUE;
+
+UPDATE countries SET noc_code = CASE name
+    WHEN 'USA' THEN 'USA'
+    WHEN 'China' THEN 'CHN'
+    WHEN 'Russia' THEN 'RUS'
+    WHEN 'Japan' THEN 'JPN'
+    WHEN 'Germany' THEN 'GER'
+    WHEN 'UK' THEN 'GBR'
+    WHEN 'France' THEN 'FRA'
+    WHEN 'Italy' THEN 'ITA'
+    WHEN 'Canada' THEN 'CAN'
+    WHEN 'Australia' THEN 'AUS'
+END;
@@ -0,0 +1,27 @@
+-- Venues events are held at. Sports lists the sport types a venue can host;
+-- an empty list means any.
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    capacity INT NOT NULL DEFAULT 0 CHECK (capacity >= 0),
+    timezone VARCHAR(64) NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    sports TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (name, city)
+);
+
+INSERT INTO venues (name, city, capacity, timezone, latitude, longitude, sports) VALUES
+('Stade de France', 'Saint-Denis', 77083, 'Europe/Paris', 48.924459, 2.360164, '{Athletics}'),
+('Paris La Défense Arena', 'Nanterre', 15220, 'Europe/Paris', 48.895652, 2.229223, '{Swimming}'),
+('Roland-Garros Stadium', 'Paris', 15225, 'Europe/Paris', 48.846997, 2.249220, '{Tennis,Boxing}'),
+('Champ-de-Mars Arena', 'Paris', 9000, 'Europe/Paris', 48.853200, 2.302600, '{Judo}'),
+('Bercy Arena', 'Paris', 15000, 'Europe/Paris', 48.838600, 2.378600, '{Basketball}'),
+('Parc des Princes', 'Paris', 47929, 'Europe/Paris', 48.841400, 2.253000, '{Football}');
+
+ALTER TABLE events ADD COLUMN venue_id INT REFERENCES venues(id);
+
+CREATE INDEX events_venue_id_idx ON events (venue_id, start_time);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS calendar_subscriptions;
+ALTER TABLE events DROP COLUMN IF EXISTS sequence;
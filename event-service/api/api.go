@@ -1,36 +1,67 @@
 package api
 
 import (
+	"context"
 	"log"
 	"net"
 	genprotos "olympy/event-service/genproto/event_service"
+	gamesproto "olympy/event-service/genproto/games_service"
+	translationproto "olympy/event-service/genproto/translation_service"
+	venueproto "olympy/event-service/genproto/venue_service"
 	"olympy/event-service/internal/config"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type (
 	API struct {
-		service genprotos.EventServiceServer
+		service            genprotos.EventServiceServer
+		gamesservice       gamesproto.GamesServiceServer
+		translationservice translationproto.TranslationServiceServer
+		venueservice       venueproto.VenueServiceServer
+		health             *health.Checker
+		server             *grpc.Server
 	}
 )
 
-func New(service genprotos.EventServiceServer) *API {
+func New(service genprotos.EventServiceServer, gamesservice gamesproto.GamesServiceServer, translationservice translationproto.TranslationServiceServer, venueservice venueproto.VenueServiceServer, creds credentials.TransportCredentials, signer *identity.Signer, health *health.Checker, zapLogger *zap.Logger) *API {
 	return &API{
-		service: service,
+		service:            service,
+		gamesservice:       gamesservice,
+		translationservice: translationservice,
+		venueservice:       venueservice,
+		health:             health,
+		server: grpc.NewServer(
+			grpc.Creds(creds),
+			grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(zapLogger), identity.UnaryServerInterceptor(signer, permissions)),
+			grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(zapLogger), identity.StreamServerInterceptor(signer, permissions)),
+		),
 	}
 }
 
 func (a *API) RUN(config *config.Config) error {
-	listener, err := net.Listen("tcp", config.Server.Port)
+	listener, err := net.Listen("tcp", config.Server.Addr())
 	if err != nil {
 		return err
 	}
 
-	serverRegisterer := grpc.NewServer()
-	genprotos.RegisterEventServiceServer(serverRegisterer, a.service)
+	genprotos.RegisterEventServiceServer(a.server, a.service)
+	gamesproto.RegisterGamesServiceServer(a.server, a.gamesservice)
+	translationproto.RegisterTranslationServiceServer(a.server, a.translationservice)
+	venueproto.RegisterVenueServiceServer(a.server, a.venueservice)
+	a.health.Register(a.server)
 
-	log.Println("server has started running on port", config.Server.Port)
+	log.Println("server has started running on port", config.Server.Addr())
 
-	return serverRegisterer.Serve(listener)
+	return a.server.Serve(listener)
+}
+
+func (a *API) Shutdown(ctx context.Context) error {
+	return lifecycle.GracefulStop(a.server)(ctx)
 }
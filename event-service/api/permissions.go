@@ -0,0 +1,40 @@
+package api
+
+import "olympy/pkg/identity"
+
+// permissions are the scopes the caller's identity needs for each RPC.
+var permissions = identity.Permissions{
+	"/event_service.EventService/AddEvent":              "events:write",
+	"/event_service.EventService/EditEvent":             "events:write",
+	"/event_service.EventService/DeleteEvent":           "events:write",
+	"/event_service.EventService/ImportEvents":          "events:write",
+	"/event_service.EventService/GetEvent":              "",
+	"/event_service.EventService/GetAllEvents":          "",
+	"/event_service.EventService/SearchEvents":          "",
+	"/event_service.EventService/ExportEvents":          "",
+	"/event_service.EventService/ListEventsByVenue":     "",
+	"/event_service.EventService/ValidateSchedule":      "",
+	"/event_service.EventService/TransitionEventStatus": "events:write",
+	"/event_service.EventService/ListStatusTransitions": "",
+
+	"/event_service.EventService/CalendarEvents":             "",
+	"/event_service.EventService/AddCalendarSubscription":    "calendar:subscribe",
+	"/event_service.EventService/ListCalendarSubscriptions":  "calendar:subscribe",
+	"/event_service.EventService/DeleteCalendarSubscription": "calendar:subscribe",
+
+	"/games_service.GamesService/AddGames":    "games:write",
+	"/games_service.GamesService/EditGames":   "games:write",
+	"/games_service.GamesService/DeleteGames": "games:write",
+	"/games_service.GamesService/GetGames":    "",
+	"/games_service.GamesService/ListGames":   "",
+
+	"/translation_service.TranslationService/SetTranslation":    "translations:write",
+	"/translation_service.TranslationService/DeleteTranslation": "translations:write",
+	"/translation_service.TranslationService/ListTranslations":  "",
+
+	"/venue_service.VenueService/AddVenue":    "venues:write",
+	"/venue_service.VenueService/EditVenue":   "venues:write",
+	"/venue_service.VenueService/DeleteVenue": "venues:write",
+	"/venue_service.VenueService/GetVenue":    "",
+	"/venue_service.VenueService/ListVenues":  "",
+}
@@ -1,17 +1,27 @@
 package main
 
 import (
+	"context"
 	"log"
 	"olympy/event-service/api"
+	streamservice "olympy/event-service/genproto/stream_service"
 	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
 	service "olympy/event-service/internal/service"
 	"olympy/event-service/internal/storage"
-	"os"
+	"olympy/pkg/configloader"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
+	"olympy/pkg/mtls"
+	_ "time/tzdata" // Venue time zones, which the alpine image has no database of
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/k0kubun/pp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -20,23 +30,83 @@ func main() {
 		log.Fatal(err)
 	}
 
-	MigrateDB()
+	zapLogger, err := logger.New(configs.Log.Level, configs.Log.Environment, configs.Log.File)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer zapLogger.Sync()
+	logger.SetDefault(zapLogger)
+	zapLogger.Info("effective config", zap.Any("config", configloader.Redacted(configs)))
+
+	MigrateDB(configs.Database.MigrationURL())
 
-	storage, err := storage.NewEventService(configs)
+	eventStorage, err := storage.NewEventService(configs)
 	if err != nil {
 		log.Fatal(err)
 	}
-	api := api.New(service.NewEventService(*storage))
 
-	log.Fatal(api.RUN(configs))
-}
+	gamesStorage, err := storage.NewGamesService(configs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	translationStorage, err := storage.NewTranslationService(configs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	venueStorage, err := storage.NewVenueService(configs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	checker := health.New(map[string]health.Check{
+		"postgres": eventStorage.PingDB,
+		"redis":    eventStorage.PingRedis,
+	}, "event_service.EventService", "games_service.GamesService", "translation_service.TranslationService", "venue_service.VenueService")
 
-func MigrateDB() {
-	databaseURL, ok := os.LookupEnv("PG_URL")
-	if !ok || len(databaseURL) == 0 {
-		log.Fatalf("migrate: environment variable PG_URL not declared or empty")
+	certs, err := mtls.NewStore(mtls.Files{Cert: configs.TLS.CertFile, Key: configs.TLS.KeyFile, CA: configs.TLS.CAFile})
+	if err != nil {
+		log.Fatal(err)
 	}
 
+	signer := identity.NewSigner(configs.Identity.Secret)
+
+	// Status changes are published to streaming-service as commentary.
+	streamConn, err := grpc.NewClient(configs.Status.StreamHost,
+		grpc.WithTransportCredentials(mtls.ClientCredentials(certs)),
+		grpc.WithUnaryInterceptor(identity.UnaryClientInterceptor(signer)))
+	if err != nil {
+		log.Fatal(err)
+	}
+	statusWorker := eventstatus.NewWorker(eventStorage, eventstatus.NewStreamPublisher(streamservice.NewStreamingServiceClient(streamConn)),
+		configs.Status.Interval, eventStorage.StatusChanged(), zapLogger)
+
+	api := api.New(service.NewEventService(*eventStorage), service.NewGamesService(*gamesStorage), service.NewTranslationService(*translationStorage), service.NewVenueService(*venueStorage), mtls.ServerCredentials(certs, configs.TLS.AllowedClients), signer, checker, zapLogger)
+
+	runner := lifecycle.New(configs.Server.ShutdownTimeout)
+	runner.Go("grpc server", func(context.Context) error { return api.RUN(configs) }, api.Shutdown)
+	runner.Go("health checker", func(ctx context.Context) error {
+		checker.Run(ctx)
+		return nil
+	}, nil)
+	runner.Go("status worker", func(ctx context.Context) error {
+		statusWorker.Run(ctx)
+		return nil
+	}, nil)
+	runner.Close("streaming-service connection", streamConn.Close)
+	runner.Close("redis", eventStorage.CloseRedis)
+	runner.Close("postgres", eventStorage.CloseDB)
+	runner.Close("postgres (games)", gamesStorage.Close)
+	runner.Close("postgres (translations)", translationStorage.Close)
+	runner.Close("postgres (venues)", venueStorage.Close)
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func MigrateDB(databaseURL string) {
 	m, err := migrate.New("file://migrations", databaseURL)
 	if err != nil {
 		log.Fatalf("Migrate: error creating migrate instance: %v", err)
@@ -50,6 +120,6 @@ func MigrateDB() {
 			log.Fatalf("Migrate: error applying migrations: %v", err)
 		}
 	} else {
-		pp.Printf("Migrate: up success")
+		log.Println("Migrate: up success")
 	}
 }
@@ -1,84 +1,117 @@
 package config
 
 import (
-	"log"
-	"os"
-	"strconv"
+	"net"
+	"net/url"
 	"time"
 
-	"github.com/joho/godotenv"
+	"olympy/pkg/configloader"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
+	Server     ServerConfig     `yaml:"server"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Redis      RedisConfig      `yaml:"redis"`
+	Log        LogConfig        `yaml:"log"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	TLS        TLSConfig        `yaml:"tls"`
+	Identity   IdentityConfig   `yaml:"identity"`
+	Schedule   ScheduleConfig   `yaml:"schedule"`
+	Status     StatusConfig     `yaml:"status"`
 }
 
 type ServerConfig struct {
-	Port string
+	Port            string        `yaml:"port" env:"SERVER_PORT" default:"4444" validate:"required,port"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"15s" validate:"positive"`
 }
 
-type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
+// Addr is the listen address of the gRPC server.
+func (s ServerConfig) Addr() string {
+	return ":" + s.Port
 }
 
-type RedisConfig struct {
-	Addr     string
-	Password string
-	DB       int
+type LogConfig struct {
+	Level       string `yaml:"level" env:"LOG_LEVEL" default:"info" validate:"oneof=debug|info|warn|error"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT" default:"production" validate:"oneof=production|development"`
+	File        string `yaml:"file" env:"LOG_FILE"`
 }
 
-type JWTConfig struct {
-	Secret          string
-	AccessTokenExp  time.Duration
-	RefreshTokenExp time.Duration
+// TLSConfig locates the certificates for mutual TLS with the other services
+// and names the services allowed to call this one.
+type TLSConfig struct {
+	CertFile       string   `yaml:"cert_file" env:"TLS_CERT_FILE" default:"certs/event-service.pem" validate:"required"`
+	KeyFile        string   `yaml:"key_file" env:"TLS_KEY_FILE" default:"certs/event-service-key.pem" validate:"required"`
+	CAFile         string   `yaml:"ca_file" env:"TLS_CA_FILE" default:"certs/ca.pem" validate:"required"`
+	AllowedClients []string `yaml:"allowed_clients" env:"TLS_ALLOWED_CLIENTS" default:"api-gateway,health-probe,olympyctl" validate:"required"`
 }
 
-func (c *Config) Load() error {
-	if err := godotenv.Load(); err != nil {
-		// Log a warning but don't fail if the .env file is not found
-		log.Println("Warning: .env file not found or failed to load.")
-	}
+// IdentityConfig holds the key that the gateway signs caller identities with;
+// it must match the gateway's.
+type IdentityConfig struct {
+	Secret string `yaml:"secret" env:"INTERNAL_IDENTITY_SECRET" validate:"required" secret:"true"`
+}
 
-	c.Server.Port = ":" + getEnv("SERVER_PORT", "4444")
-	c.Database.Host = getEnv("DB_HOST", "postgres")
-	c.Database.Port = getEnv("DB_PORT", "5432")
-	c.Database.User = getEnv("DB_USER", "postgres")
-	c.Database.Password = getEnv("DB_PASSWORD", "aaaa")
-	c.Database.DBName = getEnv("DB_NAME", "olympydb")
-
-	c.Redis.Addr = getEnv("REDIS_ADDR", "redis:6379")
-	c.Redis.Password = getEnv("REDIS_PASSWORD", "")
-	c.Redis.DB = 0
-
-	ac := getEnv("JWT_ACCESS_TOKEN_EXP", "15")
-	intacc, _ := strconv.Atoi(ac)
-	re := getEnv("JWT_REFRESH_TOKEN_EXP", "10080") // Default to 7 days in minutes
-	intref, _ := strconv.Atoi(re)
-	c.JWT.Secret = getEnv("JWT_SECRET", "your_jwt_secret")
-	c.JWT.AccessTokenExp = time.Duration(intacc) * time.Minute
-	c.JWT.RefreshTokenExp = time.Duration(intref) * time.Minute
-
-	return nil
+// PaginationConfig holds the key that list page tokens are signed with.
+type PaginationConfig struct {
+	TokenSecret string `yaml:"token_secret" env:"PAGE_TOKEN_SECRET" validate:"required" secret:"true"`
 }
 
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// ScheduleConfig holds the rules events are checked against when they are
+// added or edited. Changeover is the buffer between events at the same
+// location, and Concurrency the number of events of a sport that may run at
+// once. SportRules override both per sport as SPORT:CONCURRENCY[:CHANGEOVER],
+// e.g. "Tennis:12" or "Athletics:4:15m".
+type ScheduleConfig struct {
+	Changeover  time.Duration `yaml:"changeover" env:"SCHEDULE_CHANGEOVER" default:"30m"`
+	Concurrency int           `yaml:"concurrency" env:"SCHEDULE_CONCURRENCY" default:"1" validate:"positive"`
+	SportRules  []string      `yaml:"sport_rules" env:"SCHEDULE_SPORT_RULES"`
+}
+
+// StatusConfig holds how often events are started and completed as their
+// times pass, and the streaming-service that status changes are published
+// to.
+type StatusConfig struct {
+	Interval   time.Duration `yaml:"interval" env:"STATUS_INTERVAL" default:"30s" validate:"positive"`
+	StreamHost string        `yaml:"stream_host" env:"STREAM_HOST" default:"streaming-service:8777" validate:"required,hostport"`
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host" env:"DB_HOST" default:"postgres" validate:"required"`
+	Port     string `yaml:"port" env:"DB_PORT" default:"5432" validate:"required,port"`
+	User     string `yaml:"user" env:"DB_USER" default:"postgres" validate:"required"`
+	Password string `yaml:"password" env:"DB_PASSWORD" default:"aaaa" validate:"required" secret:"true"`
+	DBName   string `yaml:"name" env:"DB_NAME" default:"olympydb" validate:"required"`
+	URL      string `yaml:"url" env:"PG_URL" validate:"url" secret:"true"`
+}
+
+// MigrationURL returns PG_URL, or a URL built from the connection fields.
+func (d DatabaseConfig) MigrationURL() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(d.User, d.Password),
+		Host:     net.JoinHostPort(d.Host, d.Port),
+		Path:     d.DBName,
+		RawQuery: "sslmode=disable",
 	}
-	return defaultValue
+	return u.String()
+}
+
+type RedisConfig struct {
+	Addr     string `yaml:"addr" env:"REDIS_ADDR" default:"redis:6379" validate:"required,hostport"`
+	Password string `yaml:"password" env:"REDIS_PASSWORD" secret:"true"`
+	DB       int    `yaml:"db" env:"REDIS_DB"`
+}
+
+func (c *Config) Load() error {
+	return configloader.Load(c)
 }
 
 func New() (*Config, error) {
 	config := &Config{}
-	err := config.Load()
-	if err != nil {
+	if err := config.Load(); err != nil {
 		return nil, err
 	}
 	return config, nil
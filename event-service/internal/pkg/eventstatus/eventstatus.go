@@ -0,0 +1,173 @@
+// Package eventstatus defines the lifecycle of events and keeps it moving:
+// a Worker starts and completes events as their times pass, and publishes
+// every change of status to streaming-service so that live clients update.
+package eventstatus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	streamservice "olympy/event-service/genproto/stream_service"
+	"olympy/pkg/identity"
+
+	"go.uber.org/zap"
+)
+
+// Statuses of an event.
+const (
+	Scheduled = "scheduled"
+	Live      = "live"
+	Delayed   = "delayed"
+	Suspended = "suspended"
+	Completed = "completed"
+	Cancelled = "cancelled"
+)
+
+var Statuses = []string{Scheduled, Live, Delayed, Suspended, Completed, Cancelled}
+
+// transitions lists the statuses each status may move to. Completed and
+// cancelled events are final.
+var transitions = map[string][]string{
+	Scheduled: {Live, Delayed, Cancelled},
+	Delayed:   {Scheduled, Live, Cancelled},
+	Live:      {Suspended, Completed, Cancelled},
+	Suspended: {Live, Completed, Cancelled},
+}
+
+// System is the actor of automatic transitions.
+const System = "system"
+
+func Valid(status string) bool {
+	return slices.Contains(Statuses, status)
+}
+
+// Next returns the statuses an event may move to from status.
+func Next(status string) []string {
+	return transitions[status]
+}
+
+// Allowed reports whether an event may move from one status to another.
+func Allowed(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// Change is a transition of an event's status.
+type Change struct {
+	ID      int64
+	EventID int64
+	From    string
+	To      string
+	Reason  string
+}
+
+// Store is the storage the Worker drives.
+type Store interface {
+	// AutoTransition moves scheduled events whose start time has passed
+	// to live, and live events whose end time has passed to completed.
+	AutoTransition(ctx context.Context, now time.Time) (int, error)
+	// PublishChanges passes up to limit unpublished changes, oldest first,
+	// to publish, and marks those it accepts as published.
+	PublishChanges(ctx context.Context, limit int, publish func(context.Context, Change) error) (int, error)
+}
+
+// Publisher sends a change to the clients following the event.
+type Publisher interface {
+	Publish(ctx context.Context, c Change) error
+}
+
+// batch is the number of changes published per round.
+const batch = 100
+
+// Worker moves events through their lifecycle as their times pass, and
+// publishes changes. Several workers may run: events and changes are
+// claimed with SKIP LOCKED.
+type Worker struct {
+	store     Store
+	publisher Publisher
+	interval  time.Duration
+	wake      <-chan struct{}
+	logger    *zap.Logger
+}
+
+// NewWorker returns a worker that runs every interval, and whenever wake
+// receives, to publish changes made in the meantime.
+func NewWorker(store Store, publisher Publisher, interval time.Duration, wake <-chan struct{}, logger *zap.Logger) *Worker {
+	return &Worker{
+		store:     store,
+		publisher: publisher,
+		interval:  interval,
+		wake:      wake,
+		logger:    logger,
+	}
+}
+
+// Run transitions and publishes until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.store.AutoTransition(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			w.logger.Error("automatic status transitions failed", zap.Error(err))
+		}
+		w.publish(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.wake:
+		}
+	}
+}
+
+func (w *Worker) publish(ctx context.Context) {
+	for {
+		n, err := w.store.PublishChanges(ctx, batch, w.publisher.Publish)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.Warn("publishing status changes failed, retrying later", zap.Error(err))
+			}
+			return
+		}
+		if n < batch {
+			return
+		}
+	}
+}
+
+// StreamPublisher publishes changes as commentary of the event on
+// streaming-service.
+type StreamPublisher struct {
+	client streamservice.StreamingServiceClient
+}
+
+func NewStreamPublisher(client streamservice.StreamingServiceClient) *StreamPublisher {
+	return &StreamPublisher{client: client}
+}
+
+// caller is the identity event-service publishes with.
+var caller = identity.Identity{UserID: "event-service", Role: "service", Scopes: []string{"stream:publish"}}
+
+func (p *StreamPublisher) Publish(ctx context.Context, c Change) error {
+	text := "Event is now " + c.To
+	if c.Reason != "" {
+		text += ": " + c.Reason
+	}
+	_, err := p.client.StreamEvent(identity.WithIdentity(ctx, caller), &streamservice.StreamEventRequest{
+		EventId: strconv.FormatInt(c.EventID, 10),
+		Text:    text,
+		StatusChange: &streamservice.StatusChange{
+			FromStatus: c.From,
+			ToStatus:   c.To,
+			Reason:     c.Reason,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish status change %d: %v", c.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Package locale reads the locales a caller prefers, which the gateway
+// derives from Accept-Language and forwards as gRPC metadata.
+package locale
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Key is the metadata key carrying the locales, most preferred first and
+// comma separated, each followed by its fallbacks ("fr-ca,fr,ar").
+const Key = "accept-language"
+
+// maxLocales bounds the fallback chain a caller can make a query walk.
+const maxLocales = 10
+
+var tagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{1,8})*$`)
+
+// Normalize lower-cases a BCP 47 tag and accepts underscores as separators.
+// It reports whether the result is well-formed.
+func Normalize(tag string) (string, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	return tag, len(tag) <= 35 && tagPattern.MatchString(tag)
+}
+
+// FromContext returns the locales sent by the caller, or nil when it sent
+// none and the English defaults apply.
+func FromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	var locales []string
+	for _, v := range md.Get(Key) {
+		for _, tag := range strings.Split(v, ",") {
+			if tag, ok := Normalize(tag); ok && len(locales) < maxLocales {
+				locales = append(locales, tag)
+			}
+		}
+	}
+	return locales
+}
@@ -0,0 +1,75 @@
+// Package webhook queues notifications to partner endpoints.
+//
+// Changes are queued with Enqueue in the transaction that makes them; the
+// webhook_deliveries table is the queue. The medal-service dispatcher signs
+// and delivers them.
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event types queued by this service.
+const (
+	EventUpdated       = "event.updated"
+	EventRescheduled   = "event.rescheduled"
+	EventStatusChanged = "event.status_changed"
+)
+
+// Event is the JSON body of a delivery.
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// Execer is satisfied by *sql.DB and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Enqueue queues an event for every enabled webhook subscribed to
+// eventType. Pass the transaction of the change so that partners are only
+// notified of committed changes.
+func Enqueue(ctx context.Context, db Execer, eventType string, data interface{}) error {
+	id, err := newID()
+	if err != nil {
+		return err
+	}
+	eventID := "evt_" + id
+	payload, err := json.Marshal(Event{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook event: %v", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT id, $1, $2::text, $3
+		FROM webhooks
+		WHERE NOT disabled AND (cardinality(event_types) = 0 OR $2::text = ANY(event_types))`,
+		eventID, eventType, string(payload))
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook event: %v", err)
+	}
+	return nil
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
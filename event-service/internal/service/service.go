@@ -2,33 +2,110 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	genprotos "olympy/event-service/genproto/event_service"
+	gamesproto "olympy/event-service/genproto/games_service"
+	translationproto "olympy/event-service/genproto/translation_service"
+	venueproto "olympy/event-service/genproto/venue_service"
+	"olympy/event-service/internal/pkg/eventstatus"
+	"olympy/event-service/internal/pkg/locale"
 	"olympy/event-service/internal/storage"
-	"os"
+	"olympy/pkg/identity"
+	"olympy/pkg/importer"
+	"olympy/pkg/pagetoken"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-type EventService struct {
-	genprotos.UnimplementedEventServiceServer
-	eventService storage.Event
-	logger       *log.Logger
-}
+type (
+	EventService struct {
+		genprotos.UnimplementedEventServiceServer
+		eventService storage.Event
+		logger       *log.Logger
+	}
+
+	GamesService struct {
+		gamesproto.UnimplementedGamesServiceServer
+		gamesStorage storage.Games
+		logger       *log.Logger
+	}
+
+	TranslationService struct {
+		translationproto.UnimplementedTranslationServiceServer
+		translationStorage storage.Translation
+		logger             *log.Logger
+	}
+
+	VenueService struct {
+		venueproto.UnimplementedVenueServiceServer
+		venueStorage storage.Venue
+		logger       *log.Logger
+	}
+)
 
 func NewEventService(eventStorage storage.Event) *EventService {
 	return &EventService{
 		eventService: eventStorage,
-		logger:       log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile),
+		logger:       log.Default(),
+	}
+}
+
+func NewGamesService(gamesStorage storage.Games) *GamesService {
+	return &GamesService{
+		gamesStorage: gamesStorage,
+		logger:       log.Default(),
+	}
+}
+
+func NewTranslationService(translationStorage storage.Translation) *TranslationService {
+	return &TranslationService{
+		translationStorage: translationStorage,
+		logger:             log.Default(),
+	}
+}
+
+func NewVenueService(venueStorage storage.Venue) *VenueService {
+	return &VenueService{
+		venueStorage: venueStorage,
+		logger:       log.Default(),
 	}
 }
 
 func (s *EventService) AddEvent(ctx context.Context, req *genprotos.AddEventRequest) (*genprotos.AddEventResponse, error) {
 	s.logger.Println("Add Event Request")
-	return s.eventService.AddEvent(ctx, req)
+	if err := validateEvent(req.Event); err != nil {
+		return nil, err
+	}
+	return writeResult(s.eventService.AddEvent(ctx, req))
 }
 
 func (s *EventService) EditEvent(ctx context.Context, req *genprotos.EditEventRequest) (*genprotos.EditEventResponse, error) {
 	s.logger.Println("Edit Event Request")
-	return s.eventService.EditEvent(ctx, req)
+	if err := validateEvent(req.Event); err != nil {
+		return nil, err
+	}
+	return writeResult(s.eventService.EditEvent(ctx, req))
+}
+
+// validateEvent checks the times of an event.
+func validateEvent(event *genprotos.Event) error {
+	if event == nil {
+		return status.Error(codes.InvalidArgument, "event is required")
+	}
+	if event.StartTime == nil || event.EndTime == nil {
+		return status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	if !event.EndTime.After(*event.StartTime) {
+		return status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	return nil
 }
 
 func (s *EventService) DeleteEvent(ctx context.Context, req *genprotos.DeleteEventRequest) (*genprotos.Message, error) {
@@ -38,15 +115,458 @@ func (s *EventService) DeleteEvent(ctx context.Context, req *genprotos.DeleteEve
 
 func (s *EventService) GetEvent(ctx context.Context, req *genprotos.GetEventRequest) (*genprotos.GetEventResponse, error) {
 	s.logger.Println("Get Event Request")
-	return s.eventService.GetEvent(ctx, req)
+	return readResult(s.eventService.GetEvent(ctx, req))
 }
 
 func (s *EventService) GetAllEvents(ctx context.Context, req *genprotos.GetAllEventsRequest) (*genprotos.GetAllEventsResponse, error) {
 	s.logger.Println("Get All Events Request")
-	return s.eventService.GetAllEvents(ctx, req)
+	return readResult(s.eventService.GetAllEvents(ctx, req))
 }
 
 func (s *EventService) SearchEvents(ctx context.Context, req *genprotos.SearchEventsRequest) (*genprotos.GetAllEventsResponse, error) {
 	s.logger.Println("Search Events Request")
-	return s.eventService.SearchEvents(ctx, req)
+	return readResult(s.eventService.SearchEvents(ctx, req))
+}
+
+func (s *EventService) ListEventsByVenue(ctx context.Context, req *genprotos.ListEventsByVenueRequest) (*genprotos.GetAllEventsResponse, error) {
+	s.logger.Println("List Events By Venue Request")
+	if req.VenueId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "venue_id is required")
+	}
+	return readResult(s.eventService.ListEventsByVenue(ctx, req))
+}
+
+func (s *EventService) ValidateSchedule(ctx context.Context, req *genprotos.ValidateScheduleRequest) (*genprotos.ValidateScheduleResponse, error) {
+	s.logger.Println("Validate Schedule Request")
+	if req.Date == "" && req.GamesId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "date or games_id is required")
+	}
+	if req.Date != "" {
+		if _, err := time.Parse(time.DateOnly, req.Date); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("date must be a date such as 2024-07-27, got %q", req.Date))
+		}
+	}
+	return s.eventService.ValidateSchedule(ctx, req)
+}
+
+func (s *EventService) TransitionEventStatus(ctx context.Context, req *genprotos.TransitionEventStatusRequest) (*genprotos.TransitionEventStatusResponse, error) {
+	s.logger.Println("Transition Event Status Request")
+	if req.EventId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "event_id is required")
+	}
+	if !eventstatus.Valid(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, "status must be one of "+strings.Join(eventstatus.Statuses, ", "))
+	}
+	// Transitions are attributed to the user, or to the role of callers
+	// without one.
+	actor := eventstatus.System
+	if id, ok := identity.FromContext(ctx); ok {
+		actor = id.UserID
+		if actor == "" {
+			actor = id.Role
+		}
+	}
+	return statusResult(s.eventService.TransitionEventStatus(ctx, req, actor))
+}
+
+func (s *EventService) ListStatusTransitions(ctx context.Context, req *genprotos.ListStatusTransitionsRequest) (*genprotos.ListStatusTransitionsResponse, error) {
+	s.logger.Println("List Status Transitions Request")
+	if req.EventId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "event_id is required")
+	}
+	return s.eventService.ListStatusTransitions(ctx, req)
+}
+
+func (s *EventService) CalendarEvents(req *genprotos.CalendarRequest, stream genprotos.EventService_CalendarEventsServer) error {
+	s.logger.Println("Calendar Events Request")
+	if err := validateCalendarFilter(req.Filter); err != nil {
+		return err
+	}
+	err := s.eventService.CalendarEvents(stream.Context(), req, stream.Send)
+	if errors.Is(err, storage.ErrCalendarToken) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *EventService) AddCalendarSubscription(ctx context.Context, req *genprotos.CalendarSubscription) (*genprotos.CalendarSubscription, error) {
+	s.logger.Println("Add Calendar Subscription Request")
+	userID, err := subscriber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "name must be at most 100 characters")
+	}
+	if err := validateCalendarFilter(req.Filter); err != nil {
+		return nil, err
+	}
+	return calendarResult(s.eventService.AddCalendarSubscription(ctx, req, userID))
+}
+
+func (s *EventService) ListCalendarSubscriptions(ctx context.Context, req *genprotos.ListCalendarSubscriptionsRequest) (*genprotos.ListCalendarSubscriptionsResponse, error) {
+	s.logger.Println("List Calendar Subscriptions Request")
+	userID, err := subscriber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.eventService.ListCalendarSubscriptions(ctx, userID)
+}
+
+func (s *EventService) DeleteCalendarSubscription(ctx context.Context, req *genprotos.DeleteCalendarSubscriptionRequest) (*genprotos.Message, error) {
+	s.logger.Println("Delete Calendar Subscription Request")
+	userID, err := subscriber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	return calendarResult(s.eventService.DeleteCalendarSubscription(ctx, req.Id, userID))
+}
+
+// subscriber returns the user calendar subscriptions are managed for.
+func subscriber(ctx context.Context) (int64, error) {
+	id, _ := identity.FromContext(ctx)
+	userID, err := strconv.ParseInt(id.UserID, 10, 64)
+	if err != nil {
+		return 0, status.Error(codes.PermissionDenied, "calendar subscriptions belong to signed-in users")
+	}
+	return userID, nil
+}
+
+// validateCalendarFilter checks the bounds of a calendar filter.
+func validateCalendarFilter(filter *genprotos.CalendarFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	if len(filter.SportType) > 50 || len(filter.Query) > 100 {
+		return status.Error(codes.InvalidArgument, "sport_type must be at most 50 characters and query at most 100")
+	}
+	return nil
+}
+
+func (s *EventService) ExportEvents(req *genprotos.SearchEventsRequest, stream genprotos.EventService_ExportEventsServer) error {
+	s.logger.Println("Export Events Request")
+	return s.eventService.ExportEvents(stream.Context(), req, stream.Send)
+}
+
+func (s *EventService) ImportEvents(stream genprotos.EventService_ImportEventsServer) error {
+	s.logger.Println("Import Events Request")
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "import is empty")
+	}
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	opts := importer.Options{
+		Format:    options.GetFormat(),
+		DryRun:    options.GetDryRun(),
+		Chunked:   options.GetChunked(),
+		ChunkSize: int(options.GetChunkSize()),
+	}
+	src := importer.StreamReader(first.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	result, err := s.eventService.ImportEvents(stream.Context(), opts, options.GetGamesId(), src)
+	if errors.Is(err, importer.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	report := &genprotos.ImportReport{
+		Total:    int32(result.Total),
+		Valid:    int32(result.Valid),
+		Imported: int32(result.Imported),
+		Failed:   int32(result.Failed),
+		DryRun:   opts.DryRun,
+	}
+	for _, e := range result.Errors {
+		report.Errors = append(report.Errors, &genprotos.ImportRowError{
+			Row:     int32(e.Row),
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return stream.SendAndClose(report)
+}
+
+func (s *GamesService) AddGames(ctx context.Context, req *gamesproto.Games) (*gamesproto.Games, error) {
+	s.logger.Println("Add Games Request")
+	if err := validateGames(req); err != nil {
+		return nil, err
+	}
+	return gamesResult(s.gamesStorage.AddGames(ctx, req))
+}
+
+func (s *GamesService) EditGames(ctx context.Context, req *gamesproto.Games) (*gamesproto.Games, error) {
+	s.logger.Println("Edit Games Request")
+	if err := validateGames(req); err != nil {
+		return nil, err
+	}
+	return gamesResult(s.gamesStorage.EditGames(ctx, req))
+}
+
+func (s *GamesService) DeleteGames(ctx context.Context, req *gamesproto.GetSingleRequest) (*gamesproto.Message, error) {
+	s.logger.Println("Delete Games Request")
+	resp, err := s.gamesStorage.DeleteGames(ctx, req)
+	if errors.Is(err, storage.ErrGamesInUse) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, err
+}
+
+func (s *GamesService) GetGames(ctx context.Context, req *gamesproto.GetSingleRequest) (*gamesproto.Games, error) {
+	s.logger.Println("Get Games Request")
+	return s.gamesStorage.GetGames(ctx, req)
+}
+
+func (s *GamesService) ListGames(ctx context.Context, req *gamesproto.ListRequest) (*gamesproto.ListResponse, error) {
+	s.logger.Println("List Games Request")
+	return readResult(s.gamesStorage.ListGames(ctx, req))
+}
+
+func gamesResult(games *gamesproto.Games, err error) (*gamesproto.Games, error) {
+	if errors.Is(err, storage.ErrGamesExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	return games, err
+}
+
+// validateGames checks an edition and defaults its status to planned.
+func validateGames(req *gamesproto.Games) error {
+	if req.Name == "" || req.HostCity == "" {
+		return status.Error(codes.InvalidArgument, "name and host_city are required")
+	}
+	if req.Year <= 0 {
+		return status.Error(codes.InvalidArgument, "year must be positive")
+	}
+	if req.Season != "summer" && req.Season != "winter" {
+		return status.Error(codes.InvalidArgument, "season must be summer or winter")
+	}
+	switch req.Status {
+	case "":
+		req.Status = "planned"
+	case "planned", "ongoing", "completed":
+	default:
+		return status.Error(codes.InvalidArgument, "status must be planned, ongoing or completed")
+	}
+	start, err := time.Parse(time.DateOnly, req.StartDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("start_date must be a date such as 2028-07-14, got %q", req.StartDate))
+	}
+	end, err := time.Parse(time.DateOnly, req.EndDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("end_date must be a date such as 2028-07-30, got %q", req.EndDate))
+	}
+	if end.Before(start) {
+		return status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+	return nil
+}
+
+func (s *VenueService) AddVenue(ctx context.Context, req *venueproto.Venue) (*venueproto.Venue, error) {
+	s.logger.Println("Add Venue Request")
+	if err := validateVenue(req); err != nil {
+		return nil, err
+	}
+	return venueResult(s.venueStorage.AddVenue(ctx, req))
+}
+
+func (s *VenueService) EditVenue(ctx context.Context, req *venueproto.Venue) (*venueproto.Venue, error) {
+	s.logger.Println("Edit Venue Request")
+	if err := validateVenue(req); err != nil {
+		return nil, err
+	}
+	return venueResult(s.venueStorage.EditVenue(ctx, req))
+}
+
+func (s *VenueService) DeleteVenue(ctx context.Context, req *venueproto.GetSingleRequest) (*venueproto.Message, error) {
+	s.logger.Println("Delete Venue Request")
+	resp, err := s.venueStorage.DeleteVenue(ctx, req)
+	if errors.Is(err, storage.ErrVenueInUse) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, err
+}
+
+func (s *VenueService) GetVenue(ctx context.Context, req *venueproto.GetSingleRequest) (*venueproto.Venue, error) {
+	s.logger.Println("Get Venue Request")
+	return s.venueStorage.GetVenue(ctx, req)
+}
+
+func (s *VenueService) ListVenues(ctx context.Context, req *venueproto.ListRequest) (*venueproto.ListResponse, error) {
+	s.logger.Println("List Venues Request")
+	return readResult(s.venueStorage.ListVenues(ctx, req))
+}
+
+func venueResult(venue *venueproto.Venue, err error) (*venueproto.Venue, error) {
+	if errors.Is(err, storage.ErrVenueExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	return venue, err
+}
+
+// validateVenue checks a venue and trims its sport types.
+func validateVenue(req *venueproto.Venue) error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.City = strings.TrimSpace(req.City)
+	if req.Name == "" || req.City == "" {
+		return status.Error(codes.InvalidArgument, "name and city are required")
+	}
+	if req.Capacity < 0 {
+		return status.Error(codes.InvalidArgument, "capacity must not be negative")
+	}
+	if req.Timezone == "" {
+		return status.Error(codes.InvalidArgument, "timezone is required")
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("timezone must be an IANA time zone such as Europe/Paris, got %q", req.Timezone))
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return status.Error(codes.InvalidArgument, "latitude must be between -90 and 90")
+	}
+	if req.Longitude < -180 || req.Longitude > 180 {
+		return status.Error(codes.InvalidArgument, "longitude must be between -180 and 180")
+	}
+	sports := req.Sports[:0]
+	for _, sport := range req.Sports {
+		if sport = strings.TrimSpace(sport); sport != "" {
+			sports = append(sports, sport)
+		}
+	}
+	req.Sports = sports
+	return nil
+}
+
+func (s *TranslationService) SetTranslation(ctx context.Context, req *translationproto.Translation) (*translationproto.Translation, error) {
+	s.logger.Println("Set Translation Request")
+	if err := validateTranslationKey(&req.Entity, &req.Key, &req.Locale); err != nil {
+		return nil, err
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	resp, err := s.translationStorage.SetTranslation(ctx, req)
+	if errors.Is(err, storage.ErrTranslationTarget) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return resp, err
+}
+
+func (s *TranslationService) DeleteTranslation(ctx context.Context, req *translationproto.TranslationKey) (*translationproto.Message, error) {
+	s.logger.Println("Delete Translation Request")
+	if err := validateTranslationKey(&req.Entity, &req.Key, &req.Locale); err != nil {
+		return nil, err
+	}
+	return s.translationStorage.DeleteTranslation(ctx, req)
+}
+
+func (s *TranslationService) ListTranslations(ctx context.Context, req *translationproto.ListRequest) (*translationproto.ListResponse, error) {
+	s.logger.Println("List Translations Request")
+	if !translatable(req.Entity) {
+		return nil, status.Error(codes.InvalidArgument, "entity must be country, event or sport")
+	}
+	if req.Locale != "" {
+		tag, ok := locale.Normalize(req.Locale)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid locale %q", req.Locale))
+		}
+		req.Locale = tag
+	}
+	return readResult(s.translationStorage.ListTranslations(ctx, req))
+}
+
+func translatable(entity string) bool {
+	return entity == "country" || entity == "event" || entity == "sport"
+}
+
+// validateTranslationKey checks the entity, key and locale of a translation
+// and normalizes the locale.
+func validateTranslationKey(entity, key, tag *string) error {
+	if !translatable(*entity) {
+		return status.Error(codes.InvalidArgument, "entity must be country, event or sport")
+	}
+	if *key == "" {
+		return status.Error(codes.InvalidArgument, "key is required")
+	}
+	if *entity != "sport" {
+		if _, err := strconv.ParseInt(*key, 10, 64); err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("key of a %s translation must be its ID, got %q", *entity, *key))
+		}
+	}
+	normalized, ok := locale.Normalize(*tag)
+	if !ok {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid locale %q", *tag))
+	}
+	*tag = normalized
+	return nil
+}
+
+// writeResult reports events assigned to a missing venue as invalid
+// arguments, and to a venue that cannot host their sport or clashing with
+// the schedule as a failed precondition. Schedule conflicts are attached as
+// ScheduleConflicts details.
+func writeResult[T any](resp T, err error) (T, error) {
+	var conflict *storage.ConflictError
+	if errors.As(err, &conflict) {
+		st := status.New(codes.FailedPrecondition, err.Error())
+		if detailed, detailErr := st.WithDetails(&genprotos.ScheduleConflicts{Conflicts: conflict.Conflicts}); detailErr == nil {
+			st = detailed
+		}
+		return resp, st.Err()
+	}
+	if errors.Is(err, storage.ErrVenueNotFound) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, storage.ErrVenueSport) {
+		return resp, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, err
+}
+
+// statusResult maps the errors of status transitions to their codes.
+func statusResult[T any](resp T, err error) (T, error) {
+	if errors.Is(err, storage.ErrEventNotFound) {
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, storage.ErrStatusTransition) {
+		return resp, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, err
+}
+
+// calendarResult maps unknown subscriptions to NotFound, and subscriptions
+// to missing editions to InvalidArgument.
+func calendarResult[T any](resp T, err error) (T, error) {
+	if errors.Is(err, storage.ErrCalendarSubscriptionNotFound) {
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, storage.ErrCalendarGames) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, err
+}
+
+// readResult reports field masks naming unknown fields and page tokens that
+// fail verification as invalid arguments.
+func readResult[T any](resp T, err error) (T, error) {
+	if errors.Is(err, storage.ErrUnknownField) || errors.Is(err, pagetoken.ErrInvalid) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, err
 }
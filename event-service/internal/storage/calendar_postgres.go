@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	genprotos "olympy/event-service/genproto/event_service"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrCalendarSubscriptionNotFound is returned for subscriptions that do
+	// not exist or belong to another user.
+	ErrCalendarSubscriptionNotFound = errors.New("calendar subscription not found")
+	// ErrCalendarToken is returned for feed tokens that match no
+	// subscription.
+	ErrCalendarToken = errors.New("unknown calendar token")
+	// ErrCalendarGames is returned for subscriptions to an edition that
+	// does not exist.
+	ErrCalendarGames = errors.New("games edition not found")
+)
+
+// filterCalendar restricts query to the events of a calendar feed.
+func filterCalendar(query squirrel.SelectBuilder, f *genprotos.CalendarFilter) squirrel.SelectBuilder {
+	query = filterEvents(query, f.Query, f.GamesId, 0)
+	if f.SportType != "" {
+		query = query.Where(squirrel.Eq{"sport_type": f.SportType})
+	}
+	if f.From != nil {
+		query = query.Where("end_time > ?", *f.From)
+	}
+	if f.To != nil {
+		query = query.Where("start_time < ?", *f.To)
+	}
+	return query
+}
+
+// CalendarEvents sends the events matching req.Filter, or the filter of the
+// subscription whose token is req.Token, by start time.
+func (e *Event) CalendarEvents(ctx context.Context, req *genprotos.CalendarRequest, send func(*genprotos.Event) error) error {
+	filter := req.Filter
+	if req.Token != "" {
+		sub, err := scanCalendarSubscription(e.queryBuilder.Select(calendarSubscriptionColumns...).
+			From("calendar_subscriptions").
+			Where(squirrel.Eq{"token_hash": hashCalendarToken(req.Token)}).
+			RunWith(e.db).
+			QueryRowContext(ctx))
+		if err == sql.ErrNoRows {
+			return ErrCalendarToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch calendar subscription: %v", err)
+		}
+		filter = sub.Filter
+	}
+	if filter == nil {
+		filter = &genprotos.CalendarFilter{}
+	}
+
+	query := e.queryBuilder.Select(eventColumns.exprs()...).
+		From("events").
+		OrderBy("start_time", "id")
+
+	rows, err := filterCalendar(query, filter).RunWith(e.db).QueryContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch events: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event, err := eventColumns.scan(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan row: %v", err)
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+	return nil
+}
+
+var calendarSubscriptionColumns = []string{
+	"id", "name", "sport_type", "query", "from_time", "to_time", "COALESCE(games_id, 0)", "created_at",
+}
+
+func scanCalendarSubscription(row squirrel.RowScanner) (*genprotos.CalendarSubscription, error) {
+	sub := genprotos.CalendarSubscription{Filter: &genprotos.CalendarFilter{}}
+	err := row.Scan(&sub.Id, &sub.Name, &sub.Filter.SportType, &sub.Filter.Query,
+		&sub.Filter.From, &sub.Filter.To, &sub.Filter.GamesId, &sub.CreatedAt)
+	return &sub, err
+}
+
+// newCalendarToken returns a random token for a feed URL.
+func newCalendarToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashCalendarToken returns the form tokens are stored in, so that the
+// table does not hold usable feed URLs.
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// AddCalendarSubscription creates a feed of userID and returns it with its
+// token, which cannot be read again.
+func (e *Event) AddCalendarSubscription(ctx context.Context, req *genprotos.CalendarSubscription, userID int64) (*genprotos.CalendarSubscription, error) {
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+	filter := req.Filter
+	if filter == nil {
+		filter = &genprotos.CalendarFilter{}
+	}
+
+	row := e.queryBuilder.Insert("calendar_subscriptions").
+		SetMap(map[string]interface{}{
+			"user_id":    userID,
+			"token_hash": hashCalendarToken(token),
+			"name":       req.Name,
+			"sport_type": filter.SportType,
+			"query":      filter.Query,
+			"from_time":  filter.From,
+			"to_time":    filter.To,
+			"games_id":   nullID(filter.GamesId),
+		}).
+		Suffix("RETURNING " + strings.Join(calendarSubscriptionColumns, ", ")).
+		RunWith(e.db).
+		QueryRowContext(ctx)
+	sub, err := scanCalendarSubscription(row)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "calendar_subscriptions_games_id_fkey" {
+			return nil, fmt.Errorf("%w: %d", ErrCalendarGames, filter.GamesId)
+		}
+		return nil, fmt.Errorf("failed to add calendar subscription: %v", err)
+	}
+	sub.Token = token
+	return sub, nil
+}
+
+// ListCalendarSubscriptions returns the feeds of userID, newest first.
+func (e *Event) ListCalendarSubscriptions(ctx context.Context, userID int64) (*genprotos.ListCalendarSubscriptionsResponse, error) {
+	rows, err := e.queryBuilder.Select(calendarSubscriptionColumns...).
+		From("calendar_subscriptions").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("id DESC").
+		RunWith(e.db).
+		QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch calendar subscriptions: %v", err)
+	}
+	defer rows.Close()
+
+	var subs []*genprotos.CalendarSubscription
+	for rows.Next() {
+		sub, err := scanCalendarSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan calendar subscription row: %v", err)
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	return &genprotos.ListCalendarSubscriptionsResponse{Subscriptions: subs}, nil
+}
+
+// DeleteCalendarSubscription deletes a feed of userID, which revokes its
+// token.
+func (e *Event) DeleteCalendarSubscription(ctx context.Context, id, userID int64) (*genprotos.Message, error) {
+	result, err := e.queryBuilder.Delete("calendar_subscriptions").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		RunWith(e.db).
+		ExecContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrCalendarSubscriptionNotFound, id)
+	}
+
+	return &genprotos.Message{Message: fmt.Sprintf("Calendar subscription with ID %d deleted successfully", id)}, nil
+}
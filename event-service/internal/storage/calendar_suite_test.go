@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"testing"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarTestSuite struct {
+	suite.Suite
+	events      *Event
+	CleanUpFunc func()
+}
+
+func (s *CalendarTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	eventService, err := NewEventService(configs)
+	s.Require().NoError(err)
+	s.events = eventService
+
+	s.CleanUpFunc = func() {
+		eventService.CloseDB()
+		eventService.CloseRedis()
+	}
+}
+
+func (s *CalendarTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *CalendarTestSuite) calendar(ctx context.Context, req *genprotos.CalendarRequest) []*genprotos.Event {
+	var events []*genprotos.Event
+	err := s.events.CalendarEvents(ctx, req, func(e *genprotos.Event) error {
+		events = append(events, e)
+		return nil
+	})
+	s.Require().NoError(err)
+	return events
+}
+
+func (s *CalendarTestSuite) TestFeed() {
+	ctx := context.Background()
+	sport := "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	added, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Final",
+		SportType: sport,
+		StartTime: at("2099-07-29T10:00:00Z"),
+		EndTime:   at("2099-07-29T11:00:00Z"),
+	}})
+	s.Require().NoError(err)
+	event := added.Event
+	s.Equal(int32(0), event.Sequence)
+
+	filter := &genprotos.CalendarFilter{SportType: sport, From: at("2099-07-29T00:00:00Z"), To: at("2099-07-30T00:00:00Z")}
+	feed := s.calendar(ctx, &genprotos.CalendarRequest{Filter: filter})
+	s.Require().Len(feed, 1)
+	s.Equal(event.Id, feed[0].Id)
+	s.Empty(s.calendar(ctx, &genprotos.CalendarRequest{Filter: &genprotos.CalendarFilter{SportType: sport, From: at("2099-07-30T00:00:00Z")}}))
+
+	// Renaming keeps the revision, rescheduling and cancelling bump it
+	event.Name = "Test Final A"
+	edited, err := s.events.EditEvent(ctx, &genprotos.EditEventRequest{Event: event})
+	s.Require().NoError(err)
+	s.Equal(int32(0), edited.Event.Sequence)
+
+	event.StartTime, event.EndTime = at("2099-07-29T12:00:00Z"), at("2099-07-29T13:00:00Z")
+	edited, err = s.events.EditEvent(ctx, &genprotos.EditEventRequest{Event: event})
+	s.Require().NoError(err)
+	s.Equal(int32(1), edited.Event.Sequence)
+
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: event.Id, Status: eventstatus.Cancelled}, "7")
+	s.Require().NoError(err)
+	feed = s.calendar(ctx, &genprotos.CalendarRequest{Filter: filter})
+	s.Require().Len(feed, 1)
+	s.Equal(int32(2), feed[0].Sequence)
+	s.Equal(eventstatus.Cancelled, feed[0].Status)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(event.Id, 10)})
+	s.Require().NoError(err)
+}
+
+func (s *CalendarTestSuite) TestSubscriptions() {
+	ctx := context.Background()
+	sport := "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	added, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Heat",
+		SportType: sport,
+		StartTime: fromNow(48 * time.Hour),
+		EndTime:   fromNow(49 * time.Hour),
+	}})
+	s.Require().NoError(err)
+
+	sub, err := s.events.AddCalendarSubscription(ctx, &genprotos.CalendarSubscription{
+		Name:   "My sport",
+		Filter: &genprotos.CalendarFilter{SportType: sport},
+	}, 1)
+	s.Require().NoError(err)
+	s.NotEmpty(sub.Token)
+	s.Equal(sport, sub.Filter.SportType)
+	s.Nil(sub.Filter.From)
+
+	// The token selects the subscription's filter, whatever is asked for
+	feed := s.calendar(ctx, &genprotos.CalendarRequest{Token: sub.Token, Filter: &genprotos.CalendarFilter{SportType: "Other"}})
+	s.Require().Len(feed, 1)
+	s.Equal(added.Event.Id, feed[0].Id)
+
+	err = s.events.CalendarEvents(ctx, &genprotos.CalendarRequest{Token: "unknown"}, func(*genprotos.Event) error { return nil })
+	s.ErrorIs(err, ErrCalendarToken)
+
+	list, err := s.events.ListCalendarSubscriptions(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(list.Subscriptions)
+	s.Equal(sub.Id, list.Subscriptions[0].Id)
+	s.Empty(list.Subscriptions[0].Token)
+
+	// Only the owner can revoke it
+	_, err = s.events.DeleteCalendarSubscription(ctx, sub.Id, 2)
+	s.ErrorIs(err, ErrCalendarSubscriptionNotFound)
+	_, err = s.events.DeleteCalendarSubscription(ctx, sub.Id, 1)
+	s.Require().NoError(err)
+	err = s.events.CalendarEvents(ctx, &genprotos.CalendarRequest{Token: sub.Token}, func(*genprotos.Event) error { return nil })
+	s.ErrorIs(err, ErrCalendarToken)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(added.Event.Id, 10)})
+	s.Require().NoError(err)
+}
+
+func TestCalendarTestSuite(t *testing.T) {
+	suite.Run(t, new(CalendarTestSuite))
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	"olympy/pkg/importer"
+)
+
+const eventTimeLayout = "2006-01-02 15:04:05"
+
+// importTimeLayouts are the accepted formats of start_time and end_time.
+// Times without an offset are taken as UTC.
+var importTimeLayouts = []string{time.RFC3339, eventTimeLayout, "2006-01-02T15:04:05", "2006-01-02 15:04"}
+
+// ImportEvents validates the events read from src and inserts the valid
+// ones into the edition gamesID. Rows have name, sport_type, start_time and
+// end_time columns, and optionally venue_id and location. Rows are checked
+// against the venue and the schedule, including the rows imported before
+// them, as AddEvent does.
+func (e *Event) ImportEvents(ctx context.Context, opts importer.Options, gamesID int64, src io.Reader) (*importer.Result, error) {
+	parse := func(row importer.Row) (*genprotos.Event, []importer.RowError) {
+		var problems importer.Problems
+		event := &genprotos.Event{
+			Name:      problems.Text("name", row.Get("name"), 100),
+			SportType: problems.Text("sport_type", row.Get("sport_type", "sport"), 50),
+			GamesId:   gamesID,
+		}
+		if venue := row.Get("venue_id", "venue"); venue != "" {
+			event.VenueId = problems.ID("venue_id", venue)
+		}
+		if location := row.Get("location"); location != "" {
+			event.Location = problems.Text("location", location, 100)
+		}
+		start, startOK := parseEventTime(&problems, "start_time", row.Get("start_time", "start"))
+		end, endOK := parseEventTime(&problems, "end_time", row.Get("end_time", "end"))
+		if startOK && endOK && !end.After(start) {
+			problems.Add("end_time", "must be after start_time")
+		}
+		event.StartTime = &start
+		event.EndTime = &end
+		return event, problems
+	}
+
+	return importer.Run(ctx, e.db, opts, src, parse, e.insertEvent)
+}
+
+func parseEventTime(problems *importer.Problems, field, value string) (time.Time, bool) {
+	if value == "" {
+		problems.Add(field, "is required")
+		return time.Time{}, false
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	problems.Add(field, "must be a time such as 2024-07-26T10:00:00+02:00, got %q", value)
+	return time.Time{}, false
+}
+
+// insertEvent inserts an imported event. Venue and schedule errors, such as
+// a ConflictError, fail the row like any other insert error.
+func (e *Event) insertEvent(ctx context.Context, tx *sql.Tx, event *genprotos.Event) error {
+	if err := checkVenue(ctx, tx, event.VenueId, event.SportType); err != nil {
+		return err
+	}
+	if err := e.checkSchedule(ctx, tx, event); err != nil {
+		return err
+	}
+
+	_, err := e.queryBuilder.Insert("events").
+		SetMap(map[string]interface{}{
+			"name":       event.Name,
+			"sport_type": event.SportType,
+			"start_time": event.StartTime,
+			"end_time":   event.EndTime,
+			"games_id":   nullID(event.GamesId),
+			"venue_id":   nullID(event.VenueId),
+			"location":   event.Location,
+		}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %v", err)
+	}
+	return nil
+}
@@ -10,18 +10,32 @@ import (
 
 	genprotos "olympy/event-service/genproto/event_service"
 	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
+	"olympy/event-service/internal/pkg/locale"
+	"olympy/event-service/internal/pkg/webhook"
+	"olympy/pkg/pagetoken"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/go-redis/redis/v8"
+	"github.com/gogo/protobuf/types"
 )
 
 type Event struct {
 	db           *sql.DB
 	queryBuilder squirrel.StatementBuilderType
 	redisClient  *redis.Client
+	pageTokens   *pagetoken.Signer
+	schedule     scheduleRules
+
+	statusChanged chan struct{}
 }
 
 func NewEventService(config *config.Config) (*Event, error) {
+	schedule, err := newScheduleRules(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
 	db, err := ConnectDB(*config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to DB: %v", err)
@@ -37,30 +51,104 @@ func NewEventService(config *config.Config) (*Event, error) {
 		db:           db,
 		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 		redisClient:  redisClient,
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
+		schedule:     schedule,
+
+		statusChanged: make(chan struct{}, 1),
 	}, nil
 }
 
+func (e *Event) PingDB(ctx context.Context) error {
+	return e.db.PingContext(ctx)
+}
+
+func (e *Event) PingRedis(ctx context.Context) error {
+	return e.redisClient.Ping(ctx).Err()
+}
+
+func (e *Event) CloseDB() error {
+	return e.db.Close()
+}
+
+func (e *Event) CloseRedis() error {
+	return e.redisClient.Close()
+}
+
+// venueTimezone is the time zone of an event's venue, empty without one.
+const venueTimezone = "COALESCE((SELECT timezone FROM venues WHERE venues.id = events.venue_id), '')"
+
+// eventColumns are the fields an event read may select. Events created
+// before editions existed may have no games_id, and events without a venue
+// no venue_id; both are reported as 0.
+var eventColumns = columns[genprotos.Event]{
+	{"id", "id", func(e *genprotos.Event) interface{} { return &e.Id }},
+	{"name", "name", func(e *genprotos.Event) interface{} { return &e.Name }},
+	{"sport_type", "sport_type", func(e *genprotos.Event) interface{} { return &e.SportType }},
+	{"start_time", "start_time", func(e *genprotos.Event) interface{} { return &e.StartTime }},
+	{"end_time", "end_time", func(e *genprotos.Event) interface{} { return &e.EndTime }},
+	{"games_id", "COALESCE(games_id, 0)", func(e *genprotos.Event) interface{} { return &e.GamesId }},
+	{"venue_id", "COALESCE(venue_id, 0)", func(e *genprotos.Event) interface{} { return &e.VenueId }},
+	{"location", "location", func(e *genprotos.Event) interface{} { return &e.Location }},
+	{"status", "status", func(e *genprotos.Event) interface{} { return &e.Status }},
+	{"timezone", venueTimezone, func(e *genprotos.Event) interface{} { return &e.Timezone }},
+	{"sequence", "sequence", func(e *genprotos.Event) interface{} { return &e.Sequence }},
+}
+
+// cacheable reports whether reads of selected may use the cache, which only
+// holds complete events.
+func cacheable(selected columns[genprotos.Event]) bool {
+	return len(selected) == len(eventColumns)
+}
+
+// nullID stores an unset reference as NULL.
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
 func (e *Event) AddEvent(ctx context.Context, req *genprotos.AddEventRequest) (*genprotos.AddEventResponse, error) {
 	data := map[string]interface{}{
 		"name":       req.Event.Name,
 		"sport_type": req.Event.SportType,
 		"start_time": req.Event.StartTime,
 		"end_time":   req.Event.EndTime,
+		"games_id":   nullID(req.Event.GamesId),
+		"venue_id":   nullID(req.Event.VenueId),
+		"location":   req.Event.Location,
 	}
 
 	query, args, err := e.queryBuilder.Insert("events").
 		SetMap(data).
-		Suffix("RETURNING id").
+		Suffix("RETURNING id, " + venueTimezone).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
 	}
 
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkVenue(ctx, tx, req.Event.VenueId, req.Event.SportType); err != nil {
+		return nil, err
+	}
+	if err := e.checkSchedule(ctx, tx, req.Event); err != nil {
+		return nil, err
+	}
+
 	var id int64
+	var timezone string
 
-	if err := e.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&id, &timezone); err != nil {
 		return nil, fmt.Errorf("failed to scan row: %v", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
 
 	return &genprotos.AddEventResponse{
 		Event: &genprotos.Event{
@@ -69,6 +157,11 @@ func (e *Event) AddEvent(ctx context.Context, req *genprotos.AddEventRequest) (*
 			SportType: req.Event.SportType,
 			StartTime: req.Event.StartTime,
 			EndTime:   req.Event.EndTime,
+			GamesId:   req.Event.GamesId,
+			VenueId:   req.Event.VenueId,
+			Location:  req.Event.Location,
+			Status:    eventstatus.Scheduled,
+			Timezone:  timezone,
 		},
 	}, nil
 }
@@ -79,6 +172,12 @@ func (e *Event) EditEvent(ctx context.Context, req *genprotos.EditEventRequest)
 		"sport_type": req.Event.SportType,
 		"start_time": req.Event.StartTime,
 		"end_time":   req.Event.EndTime,
+		"games_id":   nullID(req.Event.GamesId),
+		"venue_id":   nullID(req.Event.VenueId),
+		"location":   req.Event.Location,
+		// Calendar apps only take rescheduled events for updates.
+		"sequence": squirrel.Expr("sequence + CASE WHEN start_time = ? AND end_time = ? THEN 0 ELSE 1 END",
+			req.Event.StartTime, req.Event.EndTime),
 	}
 
 	query, args, err := e.queryBuilder.Update("events").
@@ -89,23 +188,77 @@ func (e *Event) EditEvent(ctx context.Context, req *genprotos.EditEventRequest)
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
 	}
 
-	if _, err := e.db.ExecContext(ctx, query, args...); err != nil {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// The previous times tell partners whether the event was rescheduled.
+	var oldStart, oldEnd time.Time
+	err = tx.QueryRowContext(ctx, "SELECT start_time, end_time FROM events WHERE id = $1 FOR UPDATE", req.Event.Id).
+		Scan(&oldStart, &oldEnd)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event with ID %d not found", req.Event.Id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %v", err)
+	}
+
+	if err := checkVenue(ctx, tx, req.Event.VenueId, req.Event.SportType); err != nil {
+		return nil, err
+	}
+	if err := e.checkSchedule(ctx, tx, req.Event); err != nil {
+		return nil, err
+	}
+
+	var newStart, newEnd time.Time
+	var status, timezone string
+	var sequence int32
+	if err := tx.QueryRowContext(ctx, query+" RETURNING start_time, end_time, status, "+venueTimezone+", sequence", args...).
+		Scan(&newStart, &newEnd, &status, &timezone, &sequence); err != nil {
 		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
 	}
 
+	event := &genprotos.Event{
+		Id:        req.Event.Id,
+		Name:      req.Event.Name,
+		SportType: req.Event.SportType,
+		StartTime: req.Event.StartTime,
+		EndTime:   req.Event.EndTime,
+		GamesId:   req.Event.GamesId,
+		VenueId:   req.Event.VenueId,
+		Location:  req.Event.Location,
+		Status:    status,
+		Timezone:  timezone,
+		Sequence:  sequence,
+	}
+	change := eventChange{Event: event}
+	eventType := webhook.EventUpdated
+	if !newStart.Equal(oldStart) || !newEnd.Equal(oldEnd) {
+		eventType = webhook.EventRescheduled
+		change.PreviousStartTime = oldStart.UTC().Format(time.RFC3339)
+		change.PreviousEndTime = oldEnd.UTC().Format(time.RFC3339)
+	}
+	if err := webhook.Enqueue(ctx, tx, eventType, change); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
 	idStr := strconv.Itoa(int(req.Event.Id))
 	// Invalidate cache for this event
 	e.redisClient.Del(ctx, idStr)
 
-	return &genprotos.EditEventResponse{
-		Event: &genprotos.Event{
-			Id:        req.Event.Id,
-			Name:      req.Event.Name,
-			SportType: req.Event.SportType,
-			StartTime: req.Event.StartTime,
-			EndTime:   req.Event.EndTime,
-		},
-	}, nil
+	return &genprotos.EditEventResponse{Event: event}, nil
+}
+
+// eventChange is the webhook payload of an edited event.
+type eventChange struct {
+	*genprotos.Event
+	PreviousStartTime string `json:"previous_start_time,omitempty"`
+	PreviousEndTime   string `json:"previous_end_time,omitempty"`
 }
 
 func (e *Event) DeleteEvent(ctx context.Context, req *genprotos.DeleteEventRequest) (*genprotos.Message, error) {
@@ -137,51 +290,165 @@ func (e *Event) DeleteEvent(ctx context.Context, req *genprotos.DeleteEventReque
 }
 
 func (e *Event) GetEvent(ctx context.Context, req *genprotos.GetEventRequest) (*genprotos.GetEventResponse, error) {
+	selected, err := eventColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check cache first
-	cachedEvent, err := e.redisClient.Get(ctx, req.Id).Result()
-	if err == nil {
-		var event genprotos.Event
-		if err := json.Unmarshal([]byte(cachedEvent), &event); err == nil {
-			return &genprotos.GetEventResponse{Event: &event}, nil
+	if cacheable(selected) {
+		cachedEvent, err := e.redisClient.Get(ctx, req.Id).Result()
+		if err == nil {
+			var event genprotos.Event
+			if err := json.Unmarshal([]byte(cachedEvent), &event); err == nil {
+				return &genprotos.GetEventResponse{Event: &event}, e.localize(ctx, &event)
+			}
 		}
 	}
 
-	var event genprotos.Event
-	err = e.db.QueryRowContext(ctx, "SELECT id, name, sport_type, start_time, end_time FROM events WHERE id = $1", req.Id).
-		Scan(&event.Id, &event.Name, &event.SportType, &event.StartTime, &event.EndTime)
+	event, err := selected.scan(e.db.QueryRowContext(ctx, "SELECT "+selected.sql()+" FROM events WHERE id = $1", req.Id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch event: %v", err)
 	}
 
 	// Cache the result
-	eventJSON, err := json.Marshal(event)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal event: %v", err)
+	if cacheable(selected) {
+		eventJSON, err := json.Marshal(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal event: %v", err)
+		}
+		e.redisClient.Set(ctx, req.Id, eventJSON, 10*time.Minute)
 	}
-	e.redisClient.Set(ctx, req.Id, eventJSON, 10*time.Minute)
 
-	return &genprotos.GetEventResponse{Event: &event}, nil
+	return &genprotos.GetEventResponse{Event: event}, e.localize(ctx, event)
 }
 
 func (e *Event) GetAllEvents(ctx context.Context, req *genprotos.GetAllEventsRequest) (*genprotos.GetAllEventsResponse, error) {
-	// Determine pagination offsets
-	page := req.Page
-	pageSize := req.PageSize
-	offset := (page - 1) * pageSize
+	return e.listEvents(ctx, eventListing{
+		gamesID:  req.GamesId,
+		page:     req.Page,
+		pageSize: req.PageSize,
+		token:    req.PageToken,
+		fields:   req.Fields,
+	})
+}
+
+// eventMatches is true for events whose name or sport type contains the
+// search term, in English or in any translation. It takes the term four
+// times.
+const eventMatches = `(name ILIKE '%' || ? || '%'
+	OR sport_type ILIKE '%' || ? || '%'
+	OR EXISTS (SELECT 1 FROM event_translations t WHERE t.event_id = events.id AND t.name ILIKE '%' || ? || '%')
+	OR EXISTS (SELECT 1 FROM sport_translations t WHERE t.sport_type = events.sport_type AND t.name ILIKE '%' || ? || '%'))`
+
+// filterEvents restricts query to the events of gamesID, or all editions
+// when it is 0, that match search and are held at venueID, when set.
+func filterEvents(query squirrel.SelectBuilder, search string, gamesID, venueID int64) squirrel.SelectBuilder {
+	if search != "" {
+		query = query.Where(eventMatches, search, search, search, search)
+	}
+	if gamesID != 0 {
+		query = query.Where(squirrel.Eq{"games_id": gamesID})
+	}
+	if venueID != 0 {
+		query = query.Where(squirrel.Eq{"venue_id": venueID})
+	}
+	return query
+}
+
+func (e *Event) SearchEvents(ctx context.Context, req *genprotos.SearchEventsRequest) (*genprotos.GetAllEventsResponse, error) {
+	return e.listEvents(ctx, eventListing{
+		search:   req.Query,
+		gamesID:  req.GamesId,
+		page:     req.Page,
+		pageSize: req.PageSize,
+		token:    req.PageToken,
+		fields:   req.Fields,
+	})
+}
+
+// ListEventsByVenue returns a page of the events held at a venue.
+func (e *Event) ListEventsByVenue(ctx context.Context, req *genprotos.ListEventsByVenueRequest) (*genprotos.GetAllEventsResponse, error) {
+	return e.listEvents(ctx, eventListing{
+		gamesID:  req.GamesId,
+		venueID:  req.VenueId,
+		page:     req.Page,
+		pageSize: req.PageSize,
+		token:    req.PageToken,
+		fields:   req.Fields,
+	})
+}
+
+// eventListing is a page of the event list, of the events matching a
+// search, or of the events at a venue.
+type eventListing struct {
+	search   string
+	gamesID  int64
+	venueID  int64
+	page     int32
+	pageSize int32
+	token    string
+	fields   *types.FieldMask
+}
+
+func (l eventListing) cacheKey() string {
+	if l.venueID != 0 {
+		return fmt.Sprintf("events_venue_%d_games_%d_page_%d_size_%d_after_%s", l.venueID, l.gamesID, l.page, l.pageSize, l.token)
+	}
+	if l.search != "" {
+		return fmt.Sprintf("events_search_%s_games_%d_page_%d_size_%d_after_%s", l.search, l.gamesID, l.page, l.pageSize, l.token)
+	}
+	return fmt.Sprintf("events_games_%d_page_%d_size_%d_after_%s", l.gamesID, l.page, l.pageSize, l.token)
+}
+
+// pages identifies the listing a page token was issued for.
+func (l eventListing) pages() string {
+	return fmt.Sprintf("events/%q/%d/%d", l.search, l.gamesID, l.venueID)
+}
+
+// listEvents returns a page of events in start time order. A page token
+// resumes after the last event of the previous page, so that events added in
+// the meantime neither shift nor repeat entries; otherwise the page number is
+// used.
+func (e *Event) listEvents(ctx context.Context, l eventListing) (*genprotos.GetAllEventsResponse, error) {
+	selected, err := eventColumns.selection(l.fields)
+	if err != nil {
+		return nil, err
+	}
+	// The start time is part of the next page token.
+	if !selected.has("start_time") {
+		startTime, _ := eventColumns.find("start_time")
+		selected = append(selected, startTime)
+	}
 
 	// Check cache first
-	cacheKey := fmt.Sprintf("events_page_%d_size_%d", page, pageSize)
-	cachedEvents, err := e.redisClient.Get(ctx, cacheKey).Result()
-	if err == nil {
-		var eventsResponse genprotos.GetAllEventsResponse
-		if err := json.Unmarshal([]byte(cachedEvents), &eventsResponse); err == nil {
-			return &eventsResponse, nil
+	cacheKey := l.cacheKey()
+	if cacheable(selected) {
+		cachedEvents, err := e.redisClient.Get(ctx, cacheKey).Result()
+		if err == nil {
+			var eventsResponse genprotos.GetAllEventsResponse
+			if err := json.Unmarshal([]byte(cachedEvents), &eventsResponse); err == nil {
+				return &eventsResponse, e.localize(ctx, eventsResponse.Events...)
+			}
 		}
 	}
 
-	// Get all events with pagination
-	query := "SELECT id, name, sport_type, start_time, end_time FROM events LIMIT $1 OFFSET $2"
-	rows, err := e.db.QueryContext(ctx, query, pageSize, offset)
+	query := e.queryBuilder.Select(selected.exprs()...).
+		From("events").
+		OrderBy("start_time", "id").
+		Limit(pageLimit(l.pageSize))
+	if l.token != "" {
+		var afterStart time.Time
+		var afterID int64
+		if err := e.pageTokens.Decode(l.token, l.pages(), &afterStart, &afterID); err != nil {
+			return nil, err
+		}
+		query = query.Where("(start_time, id) > (?::timestamptz, ?)", afterStart, afterID)
+	} else {
+		query = query.Offset(pageOffset(l.page, l.pageSize))
+	}
+
+	rows, err := filterEvents(query, l.search, l.gamesID, l.venueID).RunWith(e.db).QueryContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch events: %v", err)
 	}
@@ -189,101 +456,115 @@ func (e *Event) GetAllEvents(ctx context.Context, req *genprotos.GetAllEventsReq
 
 	var events []*genprotos.Event
 	for rows.Next() {
-		var event genprotos.Event
-		if err := rows.Scan(&event.Id, &event.Name, &event.SportType, &event.StartTime, &event.EndTime); err != nil {
+		event, err := selected.scan(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %v", err)
 		}
-		events = append(events, &event)
+		events = append(events, event)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
 	}
 
-	countQuery := "SELECT COUNT(*) FROM events"
+	var nextPageToken string
+	events, more := trimPage(events, l.pageSize)
+	if more {
+		last := events[len(events)-1]
+		nextPageToken, err = e.pageTokens.Encode(l.pages(), last.StartTime, last.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
 	var totalCount int32
-	err = e.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
+	err = filterEvents(e.queryBuilder.Select("COUNT(*)").From("events"), l.search, l.gamesID, l.venueID).
+		RunWith(e.db).
+		QueryRowContext(ctx).
+		Scan(&totalCount)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count events: %v", err)
 	}
 
 	eventsResponse := &genprotos.GetAllEventsResponse{
-		Events:     events,
-		TotalCount: totalCount,
+		Events:        events,
+		TotalCount:    totalCount,
+		NextPageToken: nextPageToken,
 	}
 
 	// Cache the result
-	eventsJSON, err := json.Marshal(eventsResponse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal events response: %v", err)
+	if cacheable(selected) {
+		eventsJSON, err := json.Marshal(eventsResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal events response: %v", err)
+		}
+		e.redisClient.Set(ctx, cacheKey, eventsJSON, 10*time.Minute)
 	}
-	e.redisClient.Set(ctx, cacheKey, eventsJSON, 10*time.Minute)
 
-	return eventsResponse, nil
+	return eventsResponse, e.localize(ctx, eventsResponse.Events...)
 }
 
-func (e *Event) SearchEvents(ctx context.Context, req *genprotos.SearchEventsRequest) (*genprotos.GetAllEventsResponse, error) {
-	// Determine pagination offsets
-	page := req.Page
-	pageSize := req.PageSize
-	offset := (page - 1) * pageSize
-
-	// Check cache first
-	cacheKey := fmt.Sprintf("events_search_%s_page_%d_size_%d", req.Query, page, pageSize)
-	cachedEvents, err := e.redisClient.Get(ctx, cacheKey).Result()
-	if err == nil {
-		var eventsResponse genprotos.GetAllEventsResponse
-		if err := json.Unmarshal([]byte(cachedEvents), &eventsResponse); err == nil {
-			return &eventsResponse, nil
-		}
-	}
+// ExportEvents sends every event of req.GamesId, or only those matching
+// req.Query, without paging or caching.
+func (e *Event) ExportEvents(ctx context.Context, req *genprotos.SearchEventsRequest, send func(*genprotos.Event) error) error {
+	query := e.queryBuilder.Select(eventColumns.exprs()...).
+		From("events").
+		OrderBy("id")
 
-	// Get events with search query and pagination
-	query := `SELECT id, name, sport_type, start_time, end_time 
-			  FROM events 
-			  WHERE name ILIKE '%' || $1 || '%' 
-			  OR sport_type ILIKE '%' || $1 || '%' 
-			  LIMIT $2 OFFSET $3`
-	rows, err := e.db.QueryContext(ctx, query, req.Query, pageSize, offset)
+	rows, err := filterEvents(query, req.Query, req.GamesId, 0).RunWith(e.db).QueryContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch events: %v", err)
+		return fmt.Errorf("failed to fetch events: %v", err)
 	}
 	defer rows.Close()
 
-	var events []*genprotos.Event
 	for rows.Next() {
-		var event genprotos.Event
-		if err := rows.Scan(&event.Id, &event.Name, &event.SportType, &event.StartTime, &event.EndTime); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %v", err)
+		event, err := eventColumns.scan(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan row: %v", err)
+		}
+		if err := send(event); err != nil {
+			return err
 		}
-		events = append(events, &event)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+		return fmt.Errorf("error occurred during rows iteration: %v", err)
 	}
+	return nil
+}
 
-	countQuery := `SELECT COUNT(*) 
-				   FROM events 
-				   WHERE name ILIKE '%' || $1 || '%' 
-				   OR sport_type ILIKE '%' || $1 || '%'`
-	var totalCount int32
-	err = e.db.QueryRowContext(ctx, countQuery, req.Query).Scan(&totalCount)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count events: %v", err)
+// localize replaces the names and sport types of events with their
+// translations into the caller's locales. Cached events stay in English, and
+// fields left out of a field mask stay empty.
+func (e *Event) localize(ctx context.Context, events ...*genprotos.Event) error {
+	locales := locale.FromContext(ctx)
+	if len(locales) == 0 || len(events) == 0 {
+		return nil
 	}
 
-	eventsResponse := &genprotos.GetAllEventsResponse{
-		Events:     events,
-		TotalCount: totalCount,
+	ids := make([]string, len(events))
+	sports := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = strconv.FormatInt(event.Id, 10)
+		sports[i] = event.SportType
 	}
 
-	// Cache the result
-	eventsJSON, err := json.Marshal(eventsResponse)
+	names, err := translatedNames(ctx, e.db, "event", ids, locales)
+	if err != nil {
+		return err
+	}
+	sportNames, err := translatedNames(ctx, e.db, "sport", sports, locales)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal events response: %v", err)
+		return err
 	}
-	e.redisClient.Set(ctx, cacheKey, eventsJSON, 10*time.Minute)
 
-	return eventsResponse, nil
+	for i, event := range events {
+		if name, ok := names[ids[i]]; ok && event.Name != "" {
+			event.Name = name
+		}
+		if name, ok := sportNames[event.SportType]; ok {
+			event.SportType = name
+		}
+	}
+	return nil
 }
@@ -49,8 +49,8 @@ func (s *EventRepositoryTestSuite) TestEventCRUD() {
 	event := &genprotos.Event{
 		Name:      "Olympic Games",
 		SportType: "Multi-sport",
-		StartTime: time.Now().Format(time.RFC3339),
-		EndTime:   time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		StartTime: fromNow(0),
+		EndTime:   fromNow(24 * time.Hour),
 	}
 	addReq := &genprotos.AddEventRequest{Event: event}
 	addResp, err := s.repo.AddEvent(ctx, addReq)
@@ -105,3 +105,18 @@ func (s *EventRepositoryTestSuite) TestEventCRUD() {
 func TestEventRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(EventRepositoryTestSuite))
 }
+
+// fromNow returns the time d from now, to the second as stored.
+func fromNow(d time.Duration) *time.Time {
+	t := time.Now().Add(d).Truncate(time.Second)
+	return &t
+}
+
+// at parses an RFC 3339 time.
+func at(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return &t
+}
@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	gamesproto "olympy/event-service/genproto/games_service"
+	"olympy/event-service/internal/config"
+	"olympy/pkg/pagetoken"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrGamesExists is returned for a second edition with the same year
+	// and season.
+	ErrGamesExists = errors.New("games of that year and season already exist")
+	// ErrGamesInUse is returned when deleting an edition that events,
+	// athletes or medals still refer to.
+	ErrGamesInUse = errors.New("games still have events, athletes or medals")
+)
+
+type Games struct {
+	db           *sql.DB
+	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
+}
+
+func NewGamesService(config *config.Config) (*Games, error) {
+	db, err := ConnectDB(*config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to DB: %v", err)
+	}
+
+	return &Games{
+		db:           db,
+		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
+	}, nil
+}
+
+func (g *Games) Close() error {
+	return g.db.Close()
+}
+
+var gamesColumns = []string{
+	"id", "name", "year", "season", "host_city",
+	"to_char(start_date, 'YYYY-MM-DD')", "to_char(end_date, 'YYYY-MM-DD')",
+	"status", "created_at", "updated_at",
+}
+
+func scanGames(row squirrel.RowScanner) (*gamesproto.Games, error) {
+	var games gamesproto.Games
+	err := row.Scan(&games.Id, &games.Name, &games.Year, &games.Season, &games.HostCity,
+		&games.StartDate, &games.EndDate, &games.Status, &games.CreatedAt, &games.UpdatedAt)
+	return &games, err
+}
+
+// gamesError translates constraint violations into ErrGamesExists and
+// ErrGamesInUse.
+func gamesError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return ErrGamesExists
+		case "23503":
+			return ErrGamesInUse
+		}
+	}
+	return fmt.Errorf("failed to execute SQL query: %v", err)
+}
+
+func (g *Games) AddGames(ctx context.Context, req *gamesproto.Games) (*gamesproto.Games, error) {
+	data := map[string]interface{}{
+		"name":       req.Name,
+		"year":       req.Year,
+		"season":     req.Season,
+		"host_city":  req.HostCity,
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+		"status":     req.Status,
+		"created_at": time.Now(),
+		"updated_at": time.Now(),
+	}
+
+	query, args, err := g.queryBuilder.Insert("games").
+		SetMap(data).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	var id int64
+
+	if err := g.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return nil, gamesError(err)
+	}
+
+	return g.GetGames(ctx, &gamesproto.GetSingleRequest{Id: id})
+}
+
+func (g *Games) EditGames(ctx context.Context, req *gamesproto.Games) (*gamesproto.Games, error) {
+	data := map[string]interface{}{
+		"name":       req.Name,
+		"year":       req.Year,
+		"season":     req.Season,
+		"host_city":  req.HostCity,
+		"start_date": req.StartDate,
+		"end_date":   req.EndDate,
+		"status":     req.Status,
+		"updated_at": time.Now(),
+	}
+
+	query, args, err := g.queryBuilder.Update("games").
+		SetMap(data).
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := g.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, gamesError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("games with ID %d not found", req.Id)
+	}
+
+	return g.GetGames(ctx, &gamesproto.GetSingleRequest{Id: req.Id})
+}
+
+func (g *Games) DeleteGames(ctx context.Context, req *gamesproto.GetSingleRequest) (*gamesproto.Message, error) {
+	query, args, err := g.queryBuilder.Delete("games").
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := g.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, gamesError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("games with ID %d not found", req.Id)
+	}
+
+	return &gamesproto.Message{Message: fmt.Sprintf("Games with ID %d deleted successfully", req.Id)}, nil
+}
+
+func (g *Games) GetGames(ctx context.Context, req *gamesproto.GetSingleRequest) (*gamesproto.Games, error) {
+	games, err := scanGames(g.queryBuilder.Select(gamesColumns...).
+		From("games").
+		Where(squirrel.Eq{"id": req.Id}).
+		RunWith(g.db).
+		QueryRowContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %v", err)
+	}
+
+	return games, nil
+}
+
+// ListGames returns the editions newest first. A page token resumes after
+// the last edition of the previous page; otherwise the page number is used.
+func (g *Games) ListGames(ctx context.Context, req *gamesproto.ListRequest) (*gamesproto.ListResponse, error) {
+	var games []*gamesproto.Games
+	var total int64
+
+	filters := squirrel.And{}
+	if req.Season != "" {
+		filters = append(filters, squirrel.Eq{"season": req.Season})
+	}
+	if req.Status != "" {
+		filters = append(filters, squirrel.Eq{"status": req.Status})
+	}
+
+	query := g.queryBuilder.Select(gamesColumns...).
+		From("games").
+		Where(filters).
+		OrderBy("start_date DESC", "id DESC").
+		Limit(pageLimit(req.Limit))
+	if req.PageToken != "" {
+		var beforeStart string
+		var beforeID int64
+		if err := g.pageTokens.Decode(req.PageToken, gamesPages(req), &beforeStart, &beforeID); err != nil {
+			return nil, err
+		}
+		query = query.Where("(start_date, id) < (?::date, ?)", beforeStart, beforeID)
+	} else {
+		query = query.Offset(pageOffset(req.Page, req.Limit))
+	}
+
+	rows, err := query.RunWith(g.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch games: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		edition, err := scanGames(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan games row: %v", err)
+		}
+		games = append(games, edition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	var nextPageToken string
+	games, more := trimPage(games, req.Limit)
+	if more {
+		last := games[len(games)-1]
+		nextPageToken, err = g.pageTokens.Encode(gamesPages(req), last.StartDate, last.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	err = g.queryBuilder.Select("COUNT(*)").
+		From("games").
+		Where(filters).
+		RunWith(g.db).
+		QueryRowContext(ctx).
+		Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total number of games: %v", err)
+	}
+
+	return &gamesproto.ListResponse{
+		Count:         total,
+		Games:         games,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// gamesPages identifies the listing a games page token was issued for.
+func gamesPages(req *gamesproto.ListRequest) string {
+	return fmt.Sprintf("games/%q/%q", req.Season, req.Status)
+}
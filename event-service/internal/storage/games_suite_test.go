@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	gamesproto "olympy/event-service/genproto/games_service"
+	"olympy/event-service/internal/config"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GamesRepositoryTestSuite struct {
+	suite.Suite
+	repo        *Games
+	CleanUpFunc func()
+}
+
+func (s *GamesRepositoryTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gamesService, err := NewGamesService(configs)
+	s.Require().NoError(err)
+	s.repo = gamesService
+
+	s.CleanUpFunc = func() {
+		gamesService.Close()
+	}
+}
+
+func (s *GamesRepositoryTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *GamesRepositoryTestSuite) TestGamesCRUD() {
+	ctx := context.Background()
+
+	// Add Games
+	games := &gamesproto.Games{
+		Name:      "Brisbane 2032",
+		Year:      2032,
+		Season:    "summer",
+		HostCity:  "Brisbane",
+		StartDate: "2032-07-23",
+		EndDate:   "2032-08-08",
+		Status:    "planned",
+	}
+	addResp, err := s.repo.AddGames(ctx, games)
+	s.Require().NoError(err)
+	s.Require().NotNil(addResp)
+	s.Equal(games.Name, addResp.Name)
+	s.Equal(games.StartDate, addResp.StartDate)
+
+	// A second edition of the same year and season is rejected
+	_, err = s.repo.AddGames(ctx, games)
+	s.ErrorIs(err, ErrGamesExists)
+
+	// Edit Games
+	games.Id = addResp.Id
+	games.Status = "ongoing"
+	editResp, err := s.repo.EditGames(ctx, games)
+	s.Require().NoError(err)
+	s.Equal("ongoing", editResp.Status)
+
+	// Get Games
+	getResp, err := s.repo.GetGames(ctx, &gamesproto.GetSingleRequest{Id: games.Id})
+	s.Require().NoError(err)
+	s.Equal(games.HostCity, getResp.HostCity)
+
+	// List Games
+	listResp, err := s.repo.ListGames(ctx, &gamesproto.ListRequest{Page: 1, Limit: 10, Season: "summer"})
+	s.Require().NoError(err)
+	s.NotZero(listResp.Count)
+	s.NotEmpty(listResp.Games)
+
+	// Delete Games
+	delResp, err := s.repo.DeleteGames(ctx, &gamesproto.GetSingleRequest{Id: games.Id})
+	s.Require().NoError(err)
+	s.Contains(delResp.Message, "deleted successfully")
+}
+
+func TestGamesRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(GamesRepositoryTestSuite))
+}
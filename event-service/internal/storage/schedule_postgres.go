@@ -0,0 +1,374 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ConflictError is returned by AddEvent and EditEvent for events that clash
+// with the schedule; Conflicts list the events they clash with.
+type ConflictError struct {
+	Conflicts []*genprotos.ScheduleConflict
+}
+
+func (e *ConflictError) Error() string {
+	messages := make([]string, len(e.Conflicts))
+	for i, conflict := range e.Conflicts {
+		messages[i] = conflict.Message
+	}
+	return "schedule conflict: " + strings.Join(messages, "; ")
+}
+
+// scheduleLock serializes the conflict checks of event writes, so that two
+// writes cannot both pass them and clash with each other.
+const scheduleLock = 4747
+
+// sportRule limits the number of events of a sport that may run at once and
+// sets the changeover buffer between its events at the same location.
+type sportRule struct {
+	concurrency int
+	changeover  time.Duration
+}
+
+type scheduleRules struct {
+	fallback sportRule
+	sports   map[string]sportRule // by lower-case sport type
+}
+
+func newScheduleRules(c config.ScheduleConfig) (scheduleRules, error) {
+	rules := scheduleRules{
+		fallback: sportRule{concurrency: c.Concurrency, changeover: c.Changeover},
+		sports:   make(map[string]sportRule),
+	}
+	for _, spec := range c.SportRules {
+		parts := strings.Split(spec, ":")
+		sport := strings.TrimSpace(parts[0])
+		if len(parts) < 2 || len(parts) > 3 || sport == "" {
+			return rules, fmt.Errorf("invalid sport rule %q: want SPORT:CONCURRENCY[:CHANGEOVER]", spec)
+		}
+		rule := rules.fallback
+		n, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || n < 1 {
+			return rules, fmt.Errorf("invalid sport rule %q: concurrency must be a positive integer", spec)
+		}
+		rule.concurrency = n
+		if len(parts) == 3 {
+			d, err := time.ParseDuration(strings.TrimSpace(parts[2]))
+			if err != nil || d < 0 {
+				return rules, fmt.Errorf("invalid sport rule %q: changeover must be a duration such as 15m", spec)
+			}
+			rule.changeover = d
+		}
+		rules.sports[strings.ToLower(sport)] = rule
+	}
+	return rules, nil
+}
+
+func (r scheduleRules) sport(sportType string) sportRule {
+	if rule, ok := r.sports[strings.ToLower(sportType)]; ok {
+		return rule
+	}
+	return r.fallback
+}
+
+// changeover is the buffer needed between a and b, the longer of their
+// sports'.
+func (r scheduleRules) changeover(a, b *genprotos.Event) time.Duration {
+	return max(r.sport(a.SportType).changeover, r.sport(b.SportType).changeover)
+}
+
+// maxChangeover is the longest buffer any two events may need.
+func (r scheduleRules) maxChangeover() time.Duration {
+	longest := r.fallback.changeover
+	for _, rule := range r.sports {
+		longest = max(longest, rule.changeover)
+	}
+	return longest
+}
+
+// slot is an event with its times parsed.
+type slot struct {
+	event      *genprotos.Event
+	start, end time.Time
+}
+
+func overlaps(a, b slot) bool {
+	return a.start.Before(b.end) && b.start.Before(a.end)
+}
+
+// sharesLocation reports whether a and b are held at the same place: at the
+// same venue with the same location, or where either takes the whole venue;
+// or, without a venue, at the same location.
+func sharesLocation(a, b *genprotos.Event) bool {
+	if a.VenueId != b.VenueId {
+		return false
+	}
+	la, lb := strings.TrimSpace(a.Location), strings.TrimSpace(b.Location)
+	if a.VenueId == 0 {
+		return la != "" && strings.EqualFold(la, lb)
+	}
+	return la == "" || lb == "" || strings.EqualFold(la, lb)
+}
+
+// locationConflict returns the conflict of a and b when they share a
+// location without the changeover buffer between them, or nil. The
+// conflict lists others.
+func (r scheduleRules) locationConflict(a, b slot, others ...*genprotos.Event) *genprotos.ScheduleConflict {
+	if !sharesLocation(a.event, b.event) {
+		return nil
+	}
+	gap := r.changeover(a.event, b.event)
+	if !a.start.Before(b.end.Add(gap)) || !b.start.Before(a.end.Add(gap)) {
+		return nil
+	}
+	message := fmt.Sprintf("%q and %q overlap at the same location", a.event.Name, b.event.Name)
+	if !overlaps(a, b) {
+		message = fmt.Sprintf("%q and %q are less than the %s changeover apart at the same location", a.event.Name, b.event.Name, gap)
+	}
+	return &genprotos.ScheduleConflict{Kind: "location", Message: message, Events: others}
+}
+
+// sportConflict returns the conflict of events of a sport running at once,
+// or nil when its rules allow that many.
+func (r scheduleRules) sportConflict(sportType string, running int, events []*genprotos.Event) *genprotos.ScheduleConflict {
+	rule := r.sport(sportType)
+	if running <= rule.concurrency {
+		return nil
+	}
+	return &genprotos.ScheduleConflict{
+		Kind:    "sport",
+		Message: fmt.Sprintf("%d %s events would run at once, at most %d may", running, sportType, rule.concurrency),
+		Events:  events,
+	}
+}
+
+// conflictsWith returns the conflicts of candidate with scheduled, the
+// events already scheduled around it.
+func (r scheduleRules) conflictsWith(candidate slot, scheduled []slot) []*genprotos.ScheduleConflict {
+	var conflicts []*genprotos.ScheduleConflict
+	var sameSport []slot
+	for _, s := range scheduled {
+		if s.event.Id == candidate.event.Id {
+			continue
+		}
+		if conflict := r.locationConflict(candidate, s, s.event); conflict != nil {
+			conflicts = append(conflicts, conflict)
+		}
+		if strings.EqualFold(s.event.SportType, candidate.event.SportType) && overlaps(candidate, s) {
+			sameSport = append(sameSport, s)
+		}
+	}
+
+	// The most events of the sport running at once during the candidate
+	// are counted at the starts within it.
+	peak := 0
+	for _, at := range append([]slot{candidate}, sameSport...) {
+		if at.start.Before(candidate.start) {
+			continue
+		}
+		running := 0
+		for _, s := range sameSport {
+			if !s.start.After(at.start) && at.start.Before(s.end) {
+				running++
+			}
+		}
+		peak = max(peak, running)
+	}
+	events := make([]*genprotos.Event, len(sameSport))
+	for i, s := range sameSport {
+		events[i] = s.event
+	}
+	if conflict := r.sportConflict(candidate.event.SportType, peak+1, events); conflict != nil {
+		conflicts = append(conflicts, conflict)
+	}
+	return conflicts
+}
+
+// conflicts returns all conflicts among slots, which must be ordered by
+// start time: each pair of events clashing at a location, and each period
+// in which more events of a sport run than its rules allow.
+func (r scheduleRules) conflicts(slots []slot) []*genprotos.ScheduleConflict {
+	var conflicts []*genprotos.ScheduleConflict
+	longest := r.maxChangeover()
+	for i, a := range slots {
+		for _, b := range slots[i+1:] {
+			if !b.start.Before(a.end.Add(longest)) {
+				break
+			}
+			if conflict := r.locationConflict(a, b, a.event, b.event); conflict != nil {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+
+	bySport := make(map[string][]slot)
+	var sports []string
+	for _, s := range slots {
+		key := strings.ToLower(s.event.SportType)
+		if _, ok := bySport[key]; !ok {
+			sports = append(sports, key)
+		}
+		bySport[key] = append(bySport[key], s)
+	}
+	sort.Strings(sports)
+	for _, key := range sports {
+		conflicts = append(conflicts, r.sportConflicts(bySport[key])...)
+	}
+	return conflicts
+}
+
+// sportConflicts sweeps the events of a sport in start order. Events that
+// run while the sport is over its limit are grouped into one conflict until
+// the number running falls back within it.
+func (r scheduleRules) sportConflicts(slots []slot) []*genprotos.ScheduleConflict {
+	var conflicts []*genprotos.ScheduleConflict
+	var running []slot
+	var group []*genprotos.Event
+	inGroup := make(map[int64]bool)
+	peak := 0
+	flush := func() {
+		if conflict := r.sportConflict(slots[0].event.SportType, peak, group); conflict != nil {
+			conflicts = append(conflicts, conflict)
+		}
+		group, inGroup, peak = nil, make(map[int64]bool), 0
+	}
+
+	limit := r.sport(slots[0].event.SportType).concurrency
+	for _, s := range slots {
+		still := running[:0]
+		for _, other := range running {
+			if other.end.After(s.start) {
+				still = append(still, other)
+			}
+		}
+		running = append(still, s)
+		if len(running)-1 <= limit && group != nil {
+			flush()
+		}
+		if len(running) > limit {
+			peak = max(peak, len(running))
+			for _, other := range running {
+				if !inGroup[other.event.Id] {
+					inGroup[other.event.Id] = true
+					group = append(group, other.event)
+				}
+			}
+		}
+	}
+	if group != nil {
+		flush()
+	}
+	return conflicts
+}
+
+var slotColumns = []string{
+	"id", "name", "sport_type", "start_time", "end_time",
+	"COALESCE(games_id, 0)", "COALESCE(venue_id, 0)", "location",
+}
+
+func scanSlot(row squirrel.RowScanner) (slot, error) {
+	var event genprotos.Event
+	var s slot
+	err := row.Scan(&event.Id, &event.Name, &event.SportType, &s.start, &s.end,
+		&event.GamesId, &event.VenueId, &event.Location)
+	event.StartTime, event.EndTime = &s.start, &s.end
+	s.event = &event
+	return s, err
+}
+
+// querySlots returns the events that query selects by start time.
+func querySlots(ctx context.Context, db squirrel.BaseRunner, query squirrel.SelectBuilder) ([]slot, error) {
+	rows, err := query.OrderBy("start_time", "id").RunWith(db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %v", err)
+	}
+	defer rows.Close()
+
+	var slots []slot
+	for rows.Next() {
+		s, err := scanSlot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event row: %v", err)
+		}
+		slots = append(slots, s)
+	}
+	return slots, rows.Err()
+}
+
+// checkSchedule locks the schedule for the rest of tx and returns a
+// ConflictError when event clashes with the events around it. Cancelled
+// events are left out.
+func (e *Event) checkSchedule(ctx context.Context, tx *sql.Tx, event *genprotos.Event) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", scheduleLock); err != nil {
+		return fmt.Errorf("failed to lock the schedule: %v", err)
+	}
+
+	candidate := slot{event: event, start: *event.StartTime, end: *event.EndTime}
+
+	longest := e.schedule.maxChangeover()
+	places := squirrel.Or{squirrel.Expr("lower(sport_type) = lower(?)", event.SportType)}
+	if event.VenueId != 0 {
+		places = append(places, squirrel.Eq{"venue_id": event.VenueId})
+	} else if strings.TrimSpace(event.Location) != "" {
+		places = append(places, squirrel.Expr("venue_id IS NULL AND lower(trim(location)) = lower(?)", strings.TrimSpace(event.Location)))
+	}
+	scheduled, err := querySlots(ctx, tx, e.queryBuilder.Select(slotColumns...).
+		From("events").
+		Where(squirrel.NotEq{"id": event.Id}).
+		Where(squirrel.NotEq{"status": eventstatus.Cancelled}).
+		Where(squirrel.Lt{"start_time": candidate.end.Add(longest)}).
+		Where(squirrel.Gt{"end_time": candidate.start.Add(-longest)}).
+		Where(places))
+	if err != nil {
+		return err
+	}
+
+	if conflicts := e.schedule.conflictsWith(candidate, scheduled); len(conflicts) > 0 {
+		return &ConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
+
+// ValidateSchedule reports the conflicts among the events of a day, of an
+// edition, or of an edition's day.
+func (e *Event) ValidateSchedule(ctx context.Context, req *genprotos.ValidateScheduleRequest) (*genprotos.ValidateScheduleResponse, error) {
+	// Cancelled events no longer hold their place.
+	query := e.queryBuilder.Select(slotColumns...).
+		From("events").
+		Where(squirrel.NotEq{"status": eventstatus.Cancelled})
+	if req.GamesId != 0 {
+		query = query.Where(squirrel.Eq{"games_id": req.GamesId})
+	}
+	if req.Date != "" {
+		day, err := time.Parse(time.DateOnly, req.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q: %v", req.Date, err)
+		}
+		// Events just outside the day are included for the changeovers.
+		longest := e.schedule.maxChangeover()
+		query = query.
+			Where(squirrel.Lt{"start_time": day.AddDate(0, 0, 1).Add(longest)}).
+			Where(squirrel.Gt{"end_time": day.Add(-longest)})
+	}
+
+	slots, err := querySlots(ctx, e.db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genprotos.ValidateScheduleResponse{
+		Checked:   int32(len(slots)),
+		Conflicts: e.schedule.conflicts(slots),
+	}, nil
+}
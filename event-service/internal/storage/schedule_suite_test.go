@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	venueproto "olympy/event-service/genproto/venue_service"
+	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
+	"olympy/pkg/importer"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleTestSuite struct {
+	suite.Suite
+	events      *Event
+	venues      *Venue
+	CleanUpFunc func()
+}
+
+func (s *ScheduleTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// One event of a sport at a time, 30 minutes apart at a location.
+	configs.Schedule = config.ScheduleConfig{Changeover: 30 * time.Minute, Concurrency: 1}
+
+	eventService, err := NewEventService(configs)
+	s.Require().NoError(err)
+	s.events = eventService
+
+	venueService, err := NewVenueService(configs)
+	s.Require().NoError(err)
+	s.venues = venueService
+
+	s.CleanUpFunc = func() {
+		eventService.CloseDB()
+		eventService.CloseRedis()
+		venueService.Close()
+	}
+}
+
+func (s *ScheduleTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *ScheduleTestSuite) TestConflicts() {
+	ctx := context.Background()
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 10)
+	sport := "Test Sport " + suffix
+
+	venue, err := s.venues.AddVenue(ctx, &venueproto.Venue{Name: "Test Arena " + suffix, City: "Paris", Timezone: "Europe/Paris"})
+	s.Require().NoError(err)
+
+	first, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Round 1",
+		SportType: sport,
+		StartTime: at("2099-07-27T10:00:00Z"),
+		EndTime:   at("2099-07-27T11:00:00Z"),
+		VenueId:   venue.Id,
+		Location:  "Court 1",
+	}})
+	s.Require().NoError(err)
+	s.Equal("Court 1", first.Event.Location)
+	s.Equal("Europe/Paris", first.Event.Timezone)
+
+	// Too soon after the first at the same court
+	_, err = s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Round 2",
+		SportType: "Other " + sport,
+		StartTime: at("2099-07-27T11:10:00Z"),
+		EndTime:   at("2099-07-27T12:00:00Z"),
+		VenueId:   venue.Id,
+		Location:  "court 1",
+	}})
+	var conflict *ConflictError
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Len(conflict.Conflicts, 1)
+	s.Equal("location", conflict.Conflicts[0].Kind)
+	s.Equal(first.Event.Id, conflict.Conflicts[0].Events[0].Id)
+
+	// Another court, but the sport allows one event at a time
+	_, err = s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Round 3",
+		SportType: sport,
+		StartTime: at("2099-07-27T10:30:00Z"),
+		EndTime:   at("2099-07-27T11:30:00Z"),
+		VenueId:   venue.Id,
+		Location:  "Court 2",
+	}})
+	s.Require().ErrorAs(err, &conflict)
+	s.Require().Len(conflict.Conflicts, 1)
+	s.Equal("sport", conflict.Conflicts[0].Kind)
+
+	// After the changeover
+	second, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Round 2",
+		SportType: sport,
+		StartTime: at("2099-07-27T11:30:00Z"),
+		EndTime:   at("2099-07-27T12:30:00Z"),
+		VenueId:   venue.Id,
+		Location:  "Court 1",
+	}})
+	s.Require().NoError(err)
+
+	// Moving the second onto the first is a conflict
+	moved := *second.Event
+	moved.StartTime = at("2099-07-27T10:45:00Z")
+	_, err = s.events.EditEvent(ctx, &genprotos.EditEventRequest{Event: &moved})
+	s.Require().ErrorAs(err, &conflict)
+
+	report, err := s.events.ValidateSchedule(ctx, &genprotos.ValidateScheduleRequest{Date: "2099-07-27"})
+	s.Require().NoError(err)
+	s.GreaterOrEqual(report.Checked, int32(2))
+	for _, c := range report.Conflicts {
+		for _, event := range c.Events {
+			s.NotContains([]int64{first.Event.Id, second.Event.Id}, event.Id)
+		}
+	}
+
+	for _, id := range []int64{first.Event.Id, second.Event.Id} {
+		_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(id, 10)})
+		s.Require().NoError(err)
+	}
+	_, err = s.venues.DeleteVenue(ctx, &venueproto.GetSingleRequest{Id: venue.Id})
+	s.Require().NoError(err)
+}
+
+func (s *ScheduleTestSuite) TestCancelledEventsFreeTheirSlot() {
+	ctx := context.Background()
+	sport := "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	event := &genprotos.Event{
+		Name:      "Test Final",
+		SportType: sport,
+		StartTime: at("2099-07-28T10:00:00Z"),
+		EndTime:   at("2099-07-28T11:00:00Z"),
+		Location:  "Test Pool " + sport,
+	}
+
+	cancelled, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: event})
+	s.Require().NoError(err)
+	_, err = s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: event})
+	var conflict *ConflictError
+	s.Require().ErrorAs(err, &conflict)
+
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: cancelled.Event.Id, Status: eventstatus.Cancelled}, "7")
+	s.Require().NoError(err)
+
+	// The same slot is free once the first is cancelled
+	replacement, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: event})
+	s.Require().NoError(err)
+
+	report, err := s.events.ValidateSchedule(ctx, &genprotos.ValidateScheduleRequest{Date: "2099-07-28"})
+	s.Require().NoError(err)
+	for _, c := range report.Conflicts {
+		for _, e := range c.Events {
+			s.NotContains([]int64{cancelled.Event.Id, replacement.Event.Id}, e.Id)
+		}
+	}
+
+	for _, id := range []int64{cancelled.Event.Id, replacement.Event.Id} {
+		_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(id, 10)})
+		s.Require().NoError(err)
+	}
+}
+
+func (s *ScheduleTestSuite) TestImportedEventsAreChecked() {
+	ctx := context.Background()
+	sport := "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	csv := "name,sport_type,start_time,end_time,location\n" +
+		"Test Heat 1," + sport + ",2099-07-29T10:00:00Z,2099-07-29T11:00:00Z,Test Track\n" +
+		"Test Heat 2," + sport + ",2099-07-29T10:30:00Z,2099-07-29T11:30:00Z,Test Track\n"
+
+	// The second row clashes with the first, imported in the same file
+	result, err := s.events.ImportEvents(ctx, importer.Options{Format: "csv", Chunked: true}, 0, strings.NewReader(csv))
+	s.Require().NoError(err)
+	s.Equal(1, result.Imported)
+	s.Equal(1, result.Failed)
+	s.Require().Len(result.Errors, 1)
+	s.Equal(3, result.Errors[0].Row)
+	s.Contains(result.Errors[0].Message, "schedule conflict")
+
+	imported, err := s.events.SearchEvents(ctx, &genprotos.SearchEventsRequest{Query: sport, Page: 1, PageSize: 10})
+	s.Require().NoError(err)
+	s.Require().Len(imported.Events, 1)
+	s.Equal("Test Heat 1", imported.Events[0].Name)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(imported.Events[0].Id, 10)})
+	s.Require().NoError(err)
+}
+
+func (s *ScheduleTestSuite) TestDryRunReportsConflicts() {
+	ctx := context.Background()
+	sport := "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10)
+	csv := "name,sport_type,start_time,end_time,location\n" +
+		"Test Heat 1," + sport + ",2099-07-30T10:00:00Z,2099-07-30T11:00:00Z,Test Track\n" +
+		"Test Heat 2," + sport + ",2099-07-30T10:30:00Z,2099-07-30T11:30:00Z,Test Track\n"
+
+	result, err := s.events.ImportEvents(ctx, importer.Options{Format: "csv", DryRun: true}, 0, strings.NewReader(csv))
+	s.Require().NoError(err)
+	s.Equal(0, result.Imported)
+	s.Equal(1, result.Failed)
+	s.Require().Len(result.Errors, 1)
+	s.Equal(3, result.Errors[0].Row)
+	s.Contains(result.Errors[0].Message, "schedule conflict")
+
+	// Nothing was written
+	imported, err := s.events.SearchEvents(ctx, &genprotos.SearchEventsRequest{Query: sport, Page: 1, PageSize: 10})
+	s.Require().NoError(err)
+	s.Empty(imported.Events)
+}
+
+func (s *ScheduleTestSuite) TestSportRules() {
+	_, err := newScheduleRules(config.ScheduleConfig{Concurrency: 1, SportRules: []string{"Tennis"}})
+	s.Error(err)
+
+	rules, err := newScheduleRules(config.ScheduleConfig{Changeover: 30 * time.Minute, Concurrency: 1, SportRules: []string{"Tennis:12", "Athletics:4:15m"}})
+	s.Require().NoError(err)
+	s.Equal(12, rules.sport("tennis").concurrency)
+	s.Equal(30*time.Minute, rules.sport("Tennis").changeover)
+	s.Equal(15*time.Minute, rules.sport("Athletics").changeover)
+	s.Equal(1, rules.sport("Judo").concurrency)
+}
+
+func TestScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleTestSuite))
+}
@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	"olympy/event-service/internal/pkg/eventstatus"
+	"olympy/event-service/internal/pkg/webhook"
+)
+
+var (
+	// ErrEventNotFound is returned for transitions of events that do not
+	// exist.
+	ErrEventNotFound = errors.New("event not found")
+	// ErrStatusTransition is returned for transitions the lifecycle does not
+	// allow.
+	ErrStatusTransition = errors.New("status transition not allowed")
+)
+
+// statusChange is the webhook payload of a transition.
+type statusChange struct {
+	*genprotos.Event
+	PreviousStatus string `json:"previous_status"`
+	Reason         string `json:"reason"`
+	Actor          string `json:"actor"`
+}
+
+// StatusChanged receives after transitions are committed, so that they can
+// be published without waiting for the next round of the worker.
+func (e *Event) StatusChanged() <-chan struct{} {
+	return e.statusChanged
+}
+
+func (e *Event) notifyStatusChanged() {
+	select {
+	case e.statusChanged <- struct{}{}:
+	default:
+	}
+}
+
+// TransitionEventStatus moves an event to req.Status on behalf of actor
+// and records the transition.
+func (e *Event) TransitionEventStatus(ctx context.Context, req *genprotos.TransitionEventStatusRequest, actor string) (*genprotos.TransitionEventStatusResponse, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var from string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM events WHERE id = $1 FOR UPDATE", req.EventId).Scan(&from)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %d", ErrEventNotFound, req.EventId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event: %v", err)
+	}
+	if !eventstatus.Allowed(from, req.Status) {
+		next := eventstatus.Next(from)
+		if len(next) == 0 {
+			return nil, fmt.Errorf("%w: %s events are final", ErrStatusTransition, from)
+		}
+		return nil, fmt.Errorf("%w: %s events may only become %s", ErrStatusTransition, from, strings.Join(next, ", "))
+	}
+
+	event, transition, err := e.transition(ctx, tx, req.EventId, from, req.Status, req.Reason, actor)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	e.uncacheStatus(ctx, req.EventId)
+	e.notifyStatusChanged()
+
+	return &genprotos.TransitionEventStatusResponse{Event: event, Transition: transition}, nil
+}
+
+// transition moves an event from one status to another in tx, records the
+// transition and queues its webhook.
+func (e *Event) transition(ctx context.Context, tx *sql.Tx, eventID int64, from, to, reason, actor string) (*genprotos.Event, *genprotos.StatusTransition, error) {
+	// Cancellations are passed on to calendar apps as a new revision.
+	bump := 0
+	if to == eventstatus.Cancelled {
+		bump = 1
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE events SET status = $1, sequence = sequence + $2 WHERE id = $3", to, bump, eventID); err != nil {
+		return nil, nil, fmt.Errorf("failed to update event status: %v", err)
+	}
+
+	transition := &genprotos.StatusTransition{EventId: eventID, FromStatus: from, ToStatus: to, Reason: reason, Actor: actor}
+	err := tx.QueryRowContext(ctx, `INSERT INTO event_status_history (event_id, from_status, to_status, reason, actor)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`, eventID, from, to, reason, actor).
+		Scan(&transition.Id, &transition.CreatedAt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record status transition: %v", err)
+	}
+
+	event, err := eventColumns.scan(tx.QueryRowContext(ctx, "SELECT "+eventColumns.sql()+" FROM events WHERE id = $1", eventID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch event: %v", err)
+	}
+	change := statusChange{Event: event, PreviousStatus: from, Reason: reason, Actor: actor}
+	if err := webhook.Enqueue(ctx, tx, webhook.EventStatusChanged, change); err != nil {
+		return nil, nil, err
+	}
+	return event, transition, nil
+}
+
+// automatic are the transitions made as event times pass. Delayed and
+// suspended events are left to be moved on by hand.
+var automatic = []struct {
+	from, to, column, reason string
+}{
+	{eventstatus.Scheduled, eventstatus.Live, "start_time", "start time reached"},
+	{eventstatus.Live, eventstatus.Completed, "end_time", "end time reached"},
+}
+
+// AutoTransition moves scheduled events whose start time has passed to
+// live, and live events whose end time has passed to completed. Events
+// that are over are moved through both.
+func (e *Event) AutoTransition(ctx context.Context, now time.Time) (int, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var moved []int64
+	for _, a := range automatic {
+		rows, err := tx.QueryContext(ctx, "SELECT id FROM events WHERE status = $1 AND "+a.column+" <= $2 ORDER BY id FOR UPDATE SKIP LOCKED",
+			a.from, now.UTC())
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch due events: %v", err)
+		}
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return 0, fmt.Errorf("failed to scan event row: %v", err)
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return 0, fmt.Errorf("failed to fetch due events: %v", err)
+		}
+
+		for _, id := range ids {
+			if _, _, err := e.transition(ctx, tx, id, a.from, a.to, a.reason, eventstatus.System); err != nil {
+				return 0, err
+			}
+		}
+		moved = append(moved, ids...)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	if len(moved) > 0 {
+		e.uncacheStatus(ctx, moved...)
+	}
+	return len(moved), nil
+}
+
+// uncacheStatus drops the cached copies of events whose status changed:
+// the events themselves and every cached list page, since pages include
+// the status of each event.
+func (e *Event) uncacheStatus(ctx context.Context, ids ...int64) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, strconv.FormatInt(id, 10))
+	}
+	iter := e.redisClient.Scan(ctx, 0, "events_*", 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	e.redisClient.Del(ctx, keys...)
+}
+
+// PublishChanges passes up to limit unpublished transitions, oldest first,
+// to publish, and marks those it accepts as published. It stops at the
+// first failure, which is retried on the next call.
+func (e *Event) PublishChanges(ctx context.Context, limit int, publish func(context.Context, eventstatus.Change) error) (int, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, event_id, from_status, to_status, reason FROM event_status_history
+		WHERE NOT published ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch status changes: %v", err)
+	}
+	var changes []eventstatus.Change
+	for rows.Next() {
+		var c eventstatus.Change
+		if err := rows.Scan(&c.ID, &c.EventID, &c.From, &c.To, &c.Reason); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan status change row: %v", err)
+		}
+		changes = append(changes, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to fetch status changes: %v", err)
+	}
+
+	published := 0
+	var publishErr error
+	for _, c := range changes {
+		if publishErr = publish(ctx, c); publishErr != nil {
+			break
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE event_status_history SET published = TRUE WHERE id = $1", c.ID); err != nil {
+			return 0, fmt.Errorf("failed to mark status change published: %v", err)
+		}
+		published++
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return published, publishErr
+}
+
+// ListStatusTransitions returns the transitions of an event, oldest first.
+func (e *Event) ListStatusTransitions(ctx context.Context, req *genprotos.ListStatusTransitionsRequest) (*genprotos.ListStatusTransitionsResponse, error) {
+	rows, err := e.queryBuilder.Select("id", "event_id", "from_status", "to_status", "reason", "actor", "created_at").
+		From("event_status_history").
+		Where("event_id = ?", req.EventId).
+		OrderBy("id").
+		RunWith(e.db).
+		QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status transitions: %v", err)
+	}
+	defer rows.Close()
+
+	var transitions []*genprotos.StatusTransition
+	for rows.Next() {
+		var t genprotos.StatusTransition
+		if err := rows.Scan(&t.Id, &t.EventId, &t.FromStatus, &t.ToStatus, &t.Reason, &t.Actor, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan status transition row: %v", err)
+		}
+		transitions = append(transitions, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to fetch status transitions: %v", err)
+	}
+
+	return &genprotos.ListStatusTransitionsResponse{Transitions: transitions}, nil
+}
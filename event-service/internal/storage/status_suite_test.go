@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"testing"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	"olympy/event-service/internal/config"
+	"olympy/event-service/internal/pkg/eventstatus"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusTestSuite struct {
+	suite.Suite
+	events      *Event
+	CleanUpFunc func()
+}
+
+func (s *StatusTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	eventService, err := NewEventService(configs)
+	s.Require().NoError(err)
+	s.events = eventService
+
+	s.CleanUpFunc = func() {
+		eventService.CloseDB()
+		eventService.CloseRedis()
+	}
+}
+
+func (s *StatusTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *StatusTestSuite) addEvent(ctx context.Context, start, end string) *genprotos.Event {
+	resp, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Final",
+		SportType: "Test Sport " + strconv.FormatInt(time.Now().UnixNano(), 10),
+		StartTime: at(start),
+		EndTime:   at(end),
+	}})
+	s.Require().NoError(err)
+	s.Equal(eventstatus.Scheduled, resp.Event.Status)
+	return resp.Event
+}
+
+func (s *StatusTestSuite) TestTransitions() {
+	ctx := context.Background()
+	event := s.addEvent(ctx, "2099-07-27T10:00:00Z", "2099-07-27T11:00:00Z")
+
+	resp, err := s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: event.Id, Status: eventstatus.Delayed, Reason: "Rain"}, "7")
+	s.Require().NoError(err)
+	s.Equal(eventstatus.Delayed, resp.Event.Status)
+	s.Equal(eventstatus.Scheduled, resp.Transition.FromStatus)
+	s.Equal("7", resp.Transition.Actor)
+
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: event.Id, Status: eventstatus.Completed}, "7")
+	s.ErrorIs(err, ErrStatusTransition)
+
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: event.Id, Status: eventstatus.Cancelled}, "7")
+	s.Require().NoError(err)
+
+	// Cancelled events are final
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: event.Id, Status: eventstatus.Scheduled}, "7")
+	s.ErrorIs(err, ErrStatusTransition)
+
+	_, err = s.events.TransitionEventStatus(ctx, &genprotos.TransitionEventStatusRequest{EventId: -1, Status: eventstatus.Live}, "7")
+	s.ErrorIs(err, ErrEventNotFound)
+
+	history, err := s.events.ListStatusTransitions(ctx, &genprotos.ListStatusTransitionsRequest{EventId: event.Id})
+	s.Require().NoError(err)
+	s.Require().Len(history.Transitions, 2)
+	s.Equal("Rain", history.Transitions[0].Reason)
+	s.Equal(eventstatus.Cancelled, history.Transitions[1].ToStatus)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(event.Id, 10)})
+	s.Require().NoError(err)
+}
+
+func (s *StatusTestSuite) TestAutoTransition() {
+	ctx := context.Background()
+	event := s.addEvent(ctx, "2099-07-28T10:00:00Z", "2099-07-28T11:00:00Z")
+
+	_, err := s.events.AutoTransition(ctx, time.Date(2099, 7, 28, 10, 30, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	history, err := s.events.ListStatusTransitions(ctx, &genprotos.ListStatusTransitionsRequest{EventId: event.Id})
+	s.Require().NoError(err)
+	s.Require().Len(history.Transitions, 1)
+	s.Equal(eventstatus.Live, history.Transitions[0].ToStatus)
+	s.Equal(eventstatus.System, history.Transitions[0].Actor)
+
+	_, err = s.events.AutoTransition(ctx, time.Date(2099, 7, 28, 12, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	history, err = s.events.ListStatusTransitions(ctx, &genprotos.ListStatusTransitionsRequest{EventId: event.Id})
+	s.Require().NoError(err)
+	s.Require().Len(history.Transitions, 2)
+	s.Equal(eventstatus.Completed, history.Transitions[1].ToStatus)
+
+	// Changes are published once
+	var published []eventstatus.Change
+	for {
+		n, err := s.events.PublishChanges(ctx, 100, func(_ context.Context, c eventstatus.Change) error {
+			if c.EventID == event.Id {
+				published = append(published, c)
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+		if n < 100 {
+			break
+		}
+	}
+	s.Len(published, 2)
+	_, err = s.events.PublishChanges(ctx, 100, func(_ context.Context, c eventstatus.Change) error {
+		s.NotEqual(event.Id, c.EventID)
+		return nil
+	})
+	s.Require().NoError(err)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(event.Id, 10)})
+	s.Require().NoError(err)
+}
+
+func TestStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTestSuite))
+}
@@ -0,0 +1,223 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	translationproto "olympy/event-service/genproto/translation_service"
+	"olympy/event-service/internal/config"
+	"olympy/pkg/pagetoken"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// ErrTranslationTarget is returned for a translation of a country or event
+// that does not exist.
+var ErrTranslationTarget = errors.New("no country or event with that ID")
+
+type translationTable struct {
+	table string
+	key   string
+}
+
+// translationTables maps each translated entity to its table and key
+// column. Countries and events are keyed by ID, sports by their English
+// sport type.
+var translationTables = map[string]translationTable{
+	"country": {table: "country_translations", key: "country_id"},
+	"event":   {table: "event_translations", key: "event_id"},
+	"sport":   {table: "sport_translations", key: "sport_type"},
+}
+
+type Translation struct {
+	db           *sql.DB
+	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
+}
+
+func NewTranslationService(config *config.Config) (*Translation, error) {
+	db, err := ConnectDB(*config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to DB: %v", err)
+	}
+
+	return &Translation{
+		db:           db,
+		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
+	}, nil
+}
+
+func (t *Translation) Close() error {
+	return t.db.Close()
+}
+
+func (t *Translation) SetTranslation(ctx context.Context, req *translationproto.Translation) (*translationproto.Translation, error) {
+	table := translationTables[req.Entity]
+
+	query, args, err := t.queryBuilder.Insert(table.table).
+		Columns(table.key, "locale", "name", "updated_at").
+		Values(req.Key, req.Locale, req.Name, squirrel.Expr("NOW()")).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, locale) DO UPDATE SET name = EXCLUDED.name, updated_at = EXCLUDED.updated_at RETURNING updated_at", table.key)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	translation := &translationproto.Translation{
+		Entity: req.Entity,
+		Key:    req.Key,
+		Locale: req.Locale,
+		Name:   req.Name,
+	}
+	if err := t.db.QueryRowContext(ctx, query, args...).Scan(&translation.UpdatedAt); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return nil, ErrTranslationTarget
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+
+	return translation, nil
+}
+
+func (t *Translation) DeleteTranslation(ctx context.Context, req *translationproto.TranslationKey) (*translationproto.Message, error) {
+	table := translationTables[req.Entity]
+
+	query, args, err := t.queryBuilder.Delete(table.table).
+		Where(squirrel.Eq{table.key: req.Key, "locale": req.Locale}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := t.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("%s translation of %s into %s not found", req.Entity, req.Key, req.Locale)
+	}
+
+	return &translationproto.Message{Message: fmt.Sprintf("Translation of %s %s into %s deleted successfully", req.Entity, req.Key, req.Locale)}, nil
+}
+
+// ListTranslations returns the translations of an entity by key and locale.
+// A page token resumes after the last translation of the previous page;
+// otherwise the page number is used.
+func (t *Translation) ListTranslations(ctx context.Context, req *translationproto.ListRequest) (*translationproto.ListResponse, error) {
+	var translations []*translationproto.Translation
+	var total int64
+
+	table := translationTables[req.Entity]
+	filters := squirrel.And{}
+	if req.Key != "" {
+		filters = append(filters, squirrel.Eq{table.key: req.Key})
+	}
+	if req.Locale != "" {
+		filters = append(filters, squirrel.Eq{"locale": req.Locale})
+	}
+
+	query := t.queryBuilder.Select(table.key+"::text", "locale", "name", "updated_at").
+		From(table.table).
+		Where(filters).
+		OrderBy(table.key, "locale").
+		Limit(pageLimit(req.Limit))
+	if req.PageToken != "" {
+		var afterKey, afterLocale string
+		if err := t.pageTokens.Decode(req.PageToken, translationPages(req), &afterKey, &afterLocale); err != nil {
+			return nil, err
+		}
+		query = query.Where(fmt.Sprintf("(%s, locale) > (?, ?)", table.key), afterKey, afterLocale)
+	} else {
+		query = query.Offset(pageOffset(req.Page, req.Limit))
+	}
+
+	rows, err := query.RunWith(t.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch translations: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		translation := &translationproto.Translation{Entity: req.Entity}
+		if err := rows.Scan(&translation.Key, &translation.Locale, &translation.Name, &translation.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan translation row: %v", err)
+		}
+		translations = append(translations, translation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	var nextPageToken string
+	translations, more := trimPage(translations, req.Limit)
+	if more {
+		last := translations[len(translations)-1]
+		nextPageToken, err = t.pageTokens.Encode(translationPages(req), last.Key, last.Locale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	err = t.queryBuilder.Select("COUNT(*)").
+		From(table.table).
+		Where(filters).
+		RunWith(t.db).
+		QueryRowContext(ctx).
+		Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total number of translations: %v", err)
+	}
+
+	return &translationproto.ListResponse{
+		Count:         total,
+		Translations:  translations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// translationPages identifies the listing a translation page token was
+// issued for.
+func translationPages(req *translationproto.ListRequest) string {
+	return fmt.Sprintf("translations/%s/%q/%q", req.Entity, req.Key, req.Locale)
+}
+
+// translatedNames returns, for each of keys that has a translation into one
+// of locales, the name in the earliest such locale.
+func translatedNames(ctx context.Context, db *sql.DB, entity string, keys, locales []string) (map[string]string, error) {
+	names := make(map[string]string)
+	if len(keys) == 0 || len(locales) == 0 {
+		return names, nil
+	}
+
+	table := translationTables[entity]
+	query := fmt.Sprintf(`SELECT DISTINCT ON (%[2]s) %[2]s::text, name
+		FROM %[1]s
+		WHERE %[2]s = ANY($1) AND locale = ANY($2::text[])
+		ORDER BY %[2]s, array_position($2::text[], locale::text)`, table.table, table.key)
+	rows, err := db.QueryContext(ctx, query, pq.Array(keys), pq.Array(locales))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s translations: %v", entity, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key, name string
+		if err := rows.Scan(&key, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan translation row: %v", err)
+		}
+		names[key] = name
+	}
+	return names, rows.Err()
+}
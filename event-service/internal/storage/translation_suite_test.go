@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	translationproto "olympy/event-service/genproto/translation_service"
+	"olympy/event-service/internal/config"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TranslationRepositoryTestSuite struct {
+	suite.Suite
+	repo        *Translation
+	CleanUpFunc func()
+}
+
+func (s *TranslationRepositoryTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	translationService, err := NewTranslationService(configs)
+	s.Require().NoError(err)
+	s.repo = translationService
+
+	s.CleanUpFunc = func() {
+		translationService.Close()
+	}
+}
+
+func (s *TranslationRepositoryTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *TranslationRepositoryTestSuite) TestTranslationCRUD() {
+	ctx := context.Background()
+
+	// Set Translation
+	translation := &translationproto.Translation{
+		Entity: "sport",
+		Key:    "Fencing",
+		Locale: "fr",
+		Name:   "Escrime",
+	}
+	setResp, err := s.repo.SetTranslation(ctx, translation)
+	s.Require().NoError(err)
+	s.Equal("Escrime", setResp.Name)
+	s.NotEmpty(setResp.UpdatedAt)
+
+	// Setting it again replaces the name
+	translation.Name = "L'escrime"
+	setResp, err = s.repo.SetTranslation(ctx, translation)
+	s.Require().NoError(err)
+	s.Equal("L'escrime", setResp.Name)
+
+	// Lookups fall back along the locales
+	names, err := translatedNames(ctx, s.repo.db, "sport", []string{"Fencing"}, []string{"fr-ca", "fr"})
+	s.Require().NoError(err)
+	s.Equal("L'escrime", names["Fencing"])
+
+	// Translations of missing events are rejected
+	_, err = s.repo.SetTranslation(ctx, &translationproto.Translation{Entity: "event", Key: "0", Locale: "fr", Name: "Rien"})
+	s.ErrorIs(err, ErrTranslationTarget)
+
+	// List Translations
+	listResp, err := s.repo.ListTranslations(ctx, &translationproto.ListRequest{Page: 1, Limit: 10, Entity: "sport", Key: "Fencing"})
+	s.Require().NoError(err)
+	s.Equal(int64(1), listResp.Count)
+
+	// Delete Translation
+	delResp, err := s.repo.DeleteTranslation(ctx, &translationproto.TranslationKey{Entity: "sport", Key: "Fencing", Locale: "fr"})
+	s.Require().NoError(err)
+	s.Contains(delResp.Message, "deleted successfully")
+}
+
+func TestTranslationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslationRepositoryTestSuite))
+}
@@ -0,0 +1,308 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	venueproto "olympy/event-service/genproto/venue_service"
+	"olympy/event-service/internal/config"
+	"olympy/pkg/pagetoken"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrVenueExists is returned for a second venue with the same name in
+	// the same city.
+	ErrVenueExists = errors.New("a venue of that name already exists in the city")
+	// ErrVenueInUse is returned when deleting a venue events are held at.
+	ErrVenueInUse = errors.New("venue still has events")
+	// ErrVenueNotFound is returned for events assigned to a venue that does
+	// not exist.
+	ErrVenueNotFound = errors.New("venue not found")
+	// ErrVenueSport is returned for events assigned to a venue that cannot
+	// host their sport.
+	ErrVenueSport = errors.New("venue cannot host the sport")
+)
+
+type Venue struct {
+	db           *sql.DB
+	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
+}
+
+func NewVenueService(config *config.Config) (*Venue, error) {
+	db, err := ConnectDB(*config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to DB: %v", err)
+	}
+
+	return &Venue{
+		db:           db,
+		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
+	}, nil
+}
+
+func (v *Venue) Close() error {
+	return v.db.Close()
+}
+
+var venueColumns = []string{
+	"id", "name", "city", "capacity", "timezone", "latitude", "longitude",
+	"sports", "created_at", "updated_at",
+}
+
+func scanVenue(row squirrel.RowScanner) (*venueproto.Venue, error) {
+	var venue venueproto.Venue
+	err := row.Scan(&venue.Id, &venue.Name, &venue.City, &venue.Capacity, &venue.Timezone,
+		&venue.Latitude, &venue.Longitude, pq.Array(&venue.Sports), &venue.CreatedAt, &venue.UpdatedAt)
+	return &venue, err
+}
+
+// venueError translates constraint violations into ErrVenueExists and
+// ErrVenueInUse.
+func venueError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return ErrVenueExists
+		case "23503":
+			return ErrVenueInUse
+		}
+	}
+	return fmt.Errorf("failed to execute SQL query: %v", err)
+}
+
+func (v *Venue) AddVenue(ctx context.Context, req *venueproto.Venue) (*venueproto.Venue, error) {
+	data := map[string]interface{}{
+		"name":       req.Name,
+		"city":       req.City,
+		"capacity":   req.Capacity,
+		"timezone":   req.Timezone,
+		"latitude":   req.Latitude,
+		"longitude":  req.Longitude,
+		"sports":     pq.Array(nonNil(req.Sports)),
+		"created_at": time.Now(),
+		"updated_at": time.Now(),
+	}
+
+	query, args, err := v.queryBuilder.Insert("venues").
+		SetMap(data).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	var id int64
+
+	if err := v.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return nil, venueError(err)
+	}
+
+	return v.GetVenue(ctx, &venueproto.GetSingleRequest{Id: id})
+}
+
+func (v *Venue) EditVenue(ctx context.Context, req *venueproto.Venue) (*venueproto.Venue, error) {
+	data := map[string]interface{}{
+		"name":       req.Name,
+		"city":       req.City,
+		"capacity":   req.Capacity,
+		"timezone":   req.Timezone,
+		"latitude":   req.Latitude,
+		"longitude":  req.Longitude,
+		"sports":     pq.Array(nonNil(req.Sports)),
+		"updated_at": time.Now(),
+	}
+
+	query, args, err := v.queryBuilder.Update("venues").
+		SetMap(data).
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := v.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, venueError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("venue with ID %d not found", req.Id)
+	}
+
+	return v.GetVenue(ctx, &venueproto.GetSingleRequest{Id: req.Id})
+}
+
+func (v *Venue) DeleteVenue(ctx context.Context, req *venueproto.GetSingleRequest) (*venueproto.Message, error) {
+	query, args, err := v.queryBuilder.Delete("venues").
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := v.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, venueError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("venue with ID %d not found", req.Id)
+	}
+
+	return &venueproto.Message{Message: fmt.Sprintf("Venue with ID %d deleted successfully", req.Id)}, nil
+}
+
+func (v *Venue) GetVenue(ctx context.Context, req *venueproto.GetSingleRequest) (*venueproto.Venue, error) {
+	venue, err := scanVenue(v.queryBuilder.Select(venueColumns...).
+		From("venues").
+		Where(squirrel.Eq{"id": req.Id}).
+		RunWith(v.db).
+		QueryRowContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch venue: %v", err)
+	}
+
+	return venue, nil
+}
+
+// ListVenues returns the venues by city and name.
+// ListVenues returns the venues by name. A page token resumes after the last
+// venue of the previous page; otherwise the page number is used.
+func (v *Venue) ListVenues(ctx context.Context, req *venueproto.ListRequest) (*venueproto.ListResponse, error) {
+	var venues []*venueproto.Venue
+	var total int64
+
+	filters := squirrel.And{}
+	if req.City != "" {
+		filters = append(filters, squirrel.ILike{"city": req.City})
+	}
+	if req.Sport != "" {
+		filters = append(filters, squirrel.Expr(venueHosts, req.Sport))
+	}
+
+	query := v.queryBuilder.Select(venueColumns...).
+		From("venues").
+		Where(filters).
+		OrderBy("name", "id").
+		Limit(pageLimit(req.Limit))
+	if req.PageToken != "" {
+		var afterName string
+		var afterID int64
+		if err := v.pageTokens.Decode(req.PageToken, venuePages(req), &afterName, &afterID); err != nil {
+			return nil, err
+		}
+		query = query.Where("(name, id) > (?, ?)", afterName, afterID)
+	} else {
+		query = query.Offset(pageOffset(req.Page, req.Limit))
+	}
+
+	rows, err := query.RunWith(v.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch venues: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		venue, err := scanVenue(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan venue row: %v", err)
+		}
+		venues = append(venues, venue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	var nextPageToken string
+	venues, more := trimPage(venues, req.Limit)
+	if more {
+		last := venues[len(venues)-1]
+		nextPageToken, err = v.pageTokens.Encode(venuePages(req), last.Name, last.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	err = v.queryBuilder.Select("COUNT(*)").
+		From("venues").
+		Where(filters).
+		RunWith(v.db).
+		QueryRowContext(ctx).
+		Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total number of venues: %v", err)
+	}
+
+	return &venueproto.ListResponse{
+		Count:         total,
+		Venues:        venues,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// venuePages identifies the listing a venue page token was issued for.
+func venuePages(req *venueproto.ListRequest) string {
+	return fmt.Sprintf("venues/%q/%q", req.City, req.Sport)
+}
+
+// rowQuerier is implemented by *sql.DB and *sql.Tx.
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// venueHosts is true for venues that can host the sport type it takes.
+const venueHosts = "(cardinality(sports) = 0 OR lower(?) = ANY (SELECT lower(s) FROM unnest(sports) s))"
+
+// checkVenue verifies that the venue an event is assigned to exists and can
+// host its sport. Events without a venue pass.
+func checkVenue(ctx context.Context, q rowQuerier, venueID int64, sport string) error {
+	if venueID == 0 {
+		return nil
+	}
+	var sports []string
+	err := q.QueryRowContext(ctx, "SELECT sports FROM venues WHERE id = $1", venueID).Scan(pq.Array(&sports))
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: %d", ErrVenueNotFound, venueID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch venue: %v", err)
+	}
+	if len(sports) == 0 {
+		return nil
+	}
+	for _, s := range sports {
+		if strings.EqualFold(s, sport) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: venue %d hosts %s", ErrVenueSport, venueID, strings.Join(sports, ", "))
+}
+
+// nonNil stores an unset list as an empty array rather than NULL.
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
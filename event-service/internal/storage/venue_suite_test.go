@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"testing"
+	"time"
+
+	genprotos "olympy/event-service/genproto/event_service"
+	venueproto "olympy/event-service/genproto/venue_service"
+	"olympy/event-service/internal/config"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type VenueRepositoryTestSuite struct {
+	suite.Suite
+	repo        *Venue
+	events      *Event
+	CleanUpFunc func()
+}
+
+func (s *VenueRepositoryTestSuite) SetupSuite() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	venueService, err := NewVenueService(configs)
+	s.Require().NoError(err)
+	s.repo = venueService
+
+	eventService, err := NewEventService(configs)
+	s.Require().NoError(err)
+	s.events = eventService
+
+	s.CleanUpFunc = func() {
+		venueService.Close()
+		eventService.CloseDB()
+		eventService.CloseRedis()
+	}
+}
+
+func (s *VenueRepositoryTestSuite) TearDownSuite() {
+	s.CleanUpFunc()
+}
+
+func (s *VenueRepositoryTestSuite) TestVenueCRUD() {
+	ctx := context.Background()
+
+	// Add Venue
+	venue := &venueproto.Venue{
+		Name:      "Test Aquatics Centre " + strconv.FormatInt(time.Now().UnixNano(), 10),
+		City:      "Saint-Denis",
+		Capacity:  5000,
+		Timezone:  "Europe/Paris",
+		Latitude:  48.9244,
+		Longitude: 2.3555,
+		Sports:    []string{"Swimming"},
+	}
+	addResp, err := s.repo.AddVenue(ctx, venue)
+	s.Require().NoError(err)
+	s.Require().NotNil(addResp)
+	s.Equal(venue.Name, addResp.Name)
+	s.Equal(venue.Sports, addResp.Sports)
+
+	// A second venue of the same name in the city is rejected
+	_, err = s.repo.AddVenue(ctx, venue)
+	s.ErrorIs(err, ErrVenueExists)
+
+	// Edit Venue
+	venue.Id = addResp.Id
+	venue.Capacity = 5500
+	editResp, err := s.repo.EditVenue(ctx, venue)
+	s.Require().NoError(err)
+	s.Equal(int32(5500), editResp.Capacity)
+
+	// Get Venue
+	getResp, err := s.repo.GetVenue(ctx, &venueproto.GetSingleRequest{Id: venue.Id})
+	s.Require().NoError(err)
+	s.Equal(venue.Timezone, getResp.Timezone)
+
+	// List Venues by sport, case-insensitively
+	listResp, err := s.repo.ListVenues(ctx, &venueproto.ListRequest{Page: 1, Limit: 100, Sport: "swimming"})
+	s.Require().NoError(err)
+	s.NotZero(listResp.Count)
+	s.NotEmpty(listResp.Venues)
+
+	// Events at the venue must be of a sport it hosts
+	event := &genprotos.Event{
+		Name:      "Test 400m Freestyle",
+		SportType: "Swimming",
+		StartTime: fromNow(0),
+		EndTime:   fromNow(time.Hour),
+		VenueId:   venue.Id,
+	}
+	eventResp, err := s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: event})
+	s.Require().NoError(err)
+	s.Equal(venue.Id, eventResp.Event.VenueId)
+
+	_, err = s.events.AddEvent(ctx, &genprotos.AddEventRequest{Event: &genprotos.Event{
+		Name:      "Test Judo",
+		SportType: "Judo",
+		StartTime: event.StartTime,
+		EndTime:   event.EndTime,
+		VenueId:   venue.Id,
+	}})
+	s.ErrorIs(err, ErrVenueSport)
+
+	// List Events By Venue
+	byVenue, err := s.events.ListEventsByVenue(ctx, &genprotos.ListEventsByVenueRequest{VenueId: venue.Id, Page: 1, PageSize: 10})
+	s.Require().NoError(err)
+	s.Require().Len(byVenue.Events, 1)
+	s.Equal(eventResp.Event.Id, byVenue.Events[0].Id)
+
+	// A venue with events cannot be deleted
+	_, err = s.repo.DeleteVenue(ctx, &venueproto.GetSingleRequest{Id: venue.Id})
+	s.ErrorIs(err, ErrVenueInUse)
+
+	_, err = s.events.DeleteEvent(ctx, &genprotos.DeleteEventRequest{Id: strconv.FormatInt(eventResp.Event.Id, 10)})
+	s.Require().NoError(err)
+
+	// Delete Venue
+	delResp, err := s.repo.DeleteVenue(ctx, &venueproto.GetSingleRequest{Id: venue.Id})
+	s.Require().NoError(err)
+	s.Contains(delResp.Message, "deleted successfully")
+}
+
+func TestVenueRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(VenueRepositoryTestSuite))
+}
@@ -1,41 +1,64 @@
 package api
 
 import (
+	"context"
 	"log"
 	"net"
 
 	countryservice "olympy/medal-service/genproto/country_service"
 	modelservice "olympy/medal-service/genproto/medal_service"
+	webhookservice "olympy/medal-service/genproto/webhook_service"
 	"olympy/medal-service/internal/config"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type (
 	API struct {
 		medalservice   modelservice.MedalServiceServer
 		countryservice countryservice.CountryServiceServer
+		webhookservice webhookservice.WebhookServiceServer
+		health         *health.Checker
+		server         *grpc.Server
 	}
 )
 
-func New(medalservice modelservice.MedalServiceServer, countryservice countryservice.CountryServiceServer) *API {
+func New(medalservice modelservice.MedalServiceServer, countryservice countryservice.CountryServiceServer, webhookservice webhookservice.WebhookServiceServer, creds credentials.TransportCredentials, signer *identity.Signer, health *health.Checker, zapLogger *zap.Logger) *API {
 	return &API{
 		medalservice:   medalservice,
 		countryservice: countryservice,
+		webhookservice: webhookservice,
+		health:         health,
+		server: grpc.NewServer(
+			grpc.Creds(creds),
+			grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(zapLogger), identity.UnaryServerInterceptor(signer, permissions)),
+			grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(zapLogger), identity.StreamServerInterceptor(signer, permissions)),
+		),
 	}
 }
 
 func (a *API) RUN(config *config.Config) error {
-	listener, err := net.Listen("tcp", config.Server.Port)
+	listener, err := net.Listen("tcp", config.Server.Addr())
 	if err != nil {
 		return err
 	}
 
-	serverRegisterer := grpc.NewServer()
-	modelservice.RegisterMedalServiceServer(serverRegisterer, a.medalservice)
-	countryservice.RegisterCountryServiceServer(serverRegisterer, a.countryservice)
+	modelservice.RegisterMedalServiceServer(a.server, a.medalservice)
+	countryservice.RegisterCountryServiceServer(a.server, a.countryservice)
+	webhookservice.RegisterWebhookServiceServer(a.server, a.webhookservice)
+	a.health.Register(a.server)
 
-	log.Println("server has started running on port", config.Server.Port)
+	log.Println("server has started running on port", config.Server.Addr())
 
-	return serverRegisterer.Serve(listener)
+	return a.server.Serve(listener)
+}
+
+func (a *API) Shutdown(ctx context.Context) error {
+	return lifecycle.GracefulStop(a.server)(ctx)
 }
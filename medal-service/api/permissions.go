@@ -0,0 +1,32 @@
+package api
+
+import "olympy/pkg/identity"
+
+// permissions are the scopes the caller's identity needs for each RPC.
+var permissions = identity.Permissions{
+	"/medal_service.MedalService/AddMedal":           "medals:write",
+	"/medal_service.MedalService/EditMedal":          "medals:write",
+	"/medal_service.MedalService/DeleteMedal":        "medals:write",
+	"/medal_service.MedalService/ImportMedals":       "medals:write",
+	"/medal_service.MedalService/ListMedals":         "",
+	"/medal_service.MedalService/GetMedal":           "",
+	"/medal_service.MedalService/GetMedalRanking":    "",
+	"/medal_service.MedalService/ExportMedals":       "",
+	"/medal_service.MedalService/ExportMedalRanking": "",
+
+	"/service_service.CountryService/AddCountry":      "countries:write",
+	"/service_service.CountryService/EditCountry":     "countries:write",
+	"/service_service.CountryService/DeleteCountry":   "countries:write",
+	"/service_service.CountryService/ImportCountries": "countries:write",
+	"/service_service.CountryService/ListCountries":   "",
+	"/service_service.CountryService/GetCountry":      "",
+
+	"/webhook_service.WebhookService/AddWebhook":     "webhooks:manage",
+	"/webhook_service.WebhookService/EditWebhook":    "webhooks:manage",
+	"/webhook_service.WebhookService/DeleteWebhook":  "webhooks:manage",
+	"/webhook_service.WebhookService/GetWebhook":     "webhooks:manage",
+	"/webhook_service.WebhookService/ListWebhooks":   "webhooks:manage",
+	"/webhook_service.WebhookService/ListDeliveries": "webhooks:manage",
+	"/webhook_service.WebhookService/GetDelivery":    "webhooks:manage",
+	"/webhook_service.WebhookService/Redeliver":      "webhooks:manage",
+}
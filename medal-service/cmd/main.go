@@ -1,17 +1,24 @@
 package main
 
 import (
+	"context"
 	"log"
 	"olympy/medal-service/api"
 	"olympy/medal-service/internal/config"
+	"olympy/medal-service/internal/pkg/webhook"
 	"olympy/medal-service/internal/service"
 	"olympy/medal-service/internal/storage"
-	"os"
+	"olympy/pkg/configloader"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
+	"olympy/pkg/mtls"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/k0kubun/pp"
+	"go.uber.org/zap"
 )
 
 func main() {
@@ -20,7 +27,15 @@ func main() {
 		log.Fatal(err)
 	}
 
-	migrateDB()
+	zapLogger, err := logger.New(configs.Log.Level, configs.Log.Environment, configs.Log.File)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer zapLogger.Sync()
+	logger.SetDefault(zapLogger)
+	zapLogger.Info("effective config", zap.Any("config", configloader.Redacted(configs)))
+
+	migrateDB(configs.Database.MigrationURL())
 
 	medalstorage, err := storage.NewMedalService(configs)
 	if err != nil {
@@ -32,17 +47,51 @@ func main() {
 		log.Fatal(err)
 	}
 
-	api := api.New(service.NewMedalService(*medalstorage), service.NewCountryService(*countrystorage))
+	webhookstorage, err := storage.NewWebhookService(configs)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	log.Fatal(api.RUN(configs))
-}
+	checker := health.New(map[string]health.Check{
+		"postgres": medalstorage.Ping,
+	}, "medal_service.MedalService", "service_service.CountryService", "webhook_service.WebhookService")
 
-func migrateDB() {
-	databaseURL, ok := os.LookupEnv("PG_URL")
-	if !ok || len(databaseURL) == 0 {
-		log.Fatalf("migrate: environment variable PG_URL not declared or empty")
+	certs, err := mtls.NewStore(mtls.Files{Cert: configs.TLS.CertFile, Key: configs.TLS.KeyFile, CA: configs.TLS.CAFile})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	api := api.New(service.NewMedalService(*medalstorage), service.NewCountryService(*countrystorage), service.NewWebhookService(*webhookstorage), mtls.ServerCredentials(certs, configs.TLS.AllowedClients), identity.NewSigner(configs.Identity.Secret), checker, zapLogger)
+
+	dispatcher := webhook.NewDispatcher(webhookstorage.DB(), webhook.Options{
+		Workers:      configs.Webhook.Workers,
+		PollInterval: configs.Webhook.PollInterval,
+		Timeout:      configs.Webhook.Timeout,
+		MaxAttempts:  configs.Webhook.MaxAttempts,
+		MinBackoff:   configs.Webhook.MinBackoff,
+		MaxBackoff:   configs.Webhook.MaxBackoff,
+	}, zapLogger.Named("webhook"))
+
+	runner := lifecycle.New(configs.Server.ShutdownTimeout)
+	runner.Go("grpc server", func(context.Context) error { return api.RUN(configs) }, api.Shutdown)
+	runner.Go("health checker", func(ctx context.Context) error {
+		checker.Run(ctx)
+		return nil
+	}, nil)
+	runner.Go("webhook dispatcher", func(ctx context.Context) error {
+		dispatcher.Run(ctx)
+		return nil
+	}, nil)
+	runner.Close("postgres (medals)", medalstorage.Close)
+	runner.Close("postgres (countries)", countrystorage.Close)
+	runner.Close("postgres (webhooks)", webhookstorage.Close)
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatal(err)
 	}
+}
 
+func migrateDB(databaseURL string) {
 	m, err := migrate.New("file://migrations", databaseURL)
 	if err != nil {
 		log.Fatalf("Migrate: error creating migrate instance: %v", err)
@@ -56,6 +105,6 @@ func migrateDB() {
 			log.Fatalf("Migrate: error applying migrations: %v", err)
 		}
 	} else {
-		pp.Printf("Migrate: up success")
+		log.Println("Migrate: up success")
 	}
 }
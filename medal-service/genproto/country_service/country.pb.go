@@ -6,6 +6,7 @@ package service_service
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -32,6 +33,7 @@ type Country struct {
 	Flag                 string   `protobuf:"bytes,3,opt,name=flag,proto3" json:"flag"`
 	CreatedAt            string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	NocCode              string   `protobuf:"bytes,6,opt,name=noc_code,json=nocCode,proto3" json:"noc_code"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -105,11 +107,19 @@ func (m *Country) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *Country) GetNocCode() string {
+	if m != nil {
+		return m.NocCode
+	}
+	return ""
+}
+
 type GetSingleRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Fields               *types.FieldMask `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetSingleRequest) Reset()         { *m = GetSingleRequest{} }
@@ -152,12 +162,22 @@ func (m *GetSingleRequest) GetId() int64 {
 	return 0
 }
 
+func (m *GetSingleRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 type ListRequest struct {
-	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page                 int32            `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int32            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	Ids                  []int64          `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids"`
+	Fields               *types.FieldMask `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields"`
+	PageToken            string           `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
@@ -207,9 +227,31 @@ func (m *ListRequest) GetLimit() int32 {
 	return 0
 }
 
+func (m *ListRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ListRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListResponse struct {
 	Count                int64      `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Countries            []*Country `protobuf:"bytes,2,rep,name=countries,proto3" json:"countries"`
+	NextPageToken        string     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -262,6 +304,13 @@ func (m *ListResponse) GetCountries() []*Country {
 	return nil
 }
 
+func (m *ListResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Message struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -309,42 +358,343 @@ func (m *Message) GetMessage() string {
 	return ""
 }
 
+type ImportOptions struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Chunked              bool     `protobuf:"varint,3,opt,name=chunked,proto3" json:"chunked"`
+	ChunkSize            int32    `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOptions) Reset()         { *m = ImportOptions{} }
+func (m *ImportOptions) String() string { return proto.CompactTextString(m) }
+func (*ImportOptions) ProtoMessage()    {}
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4256015bd8361e31, []int{5}
+}
+func (m *ImportOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOptions.Merge(m, src)
+}
+func (m *ImportOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOptions proto.InternalMessageInfo
+
+func (m *ImportOptions) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunked() bool {
+	if m != nil {
+		return m.Chunked
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+type ImportRequest struct {
+	Options              *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options"`
+	Data                 []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4256015bd8361e31, []int{6}
+}
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetOptions() *ImportOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	Row                  int32    `protobuf:"varint,1,opt,name=row,proto3" json:"row"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowError) Reset()         { *m = ImportRowError{} }
+func (m *ImportRowError) String() string { return proto.CompactTextString(m) }
+func (*ImportRowError) ProtoMessage()    {}
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4256015bd8361e31, []int{7}
+}
+func (m *ImportRowError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRowError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowError.Merge(m, src)
+}
+func (m *ImportRowError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowError proto.InternalMessageInfo
+
+func (m *ImportRowError) GetRow() int32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportRowError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ImportRowError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportReport struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Valid                int32             `protobuf:"varint,2,opt,name=valid,proto3" json:"valid"`
+	Imported             int32             `protobuf:"varint,3,opt,name=imported,proto3" json:"imported"`
+	Failed               int32             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed"`
+	DryRun               bool              `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Errors               []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportReport) Reset()         { *m = ImportReport{} }
+func (m *ImportReport) String() string { return proto.CompactTextString(m) }
+func (*ImportReport) ProtoMessage()    {}
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4256015bd8361e31, []int{8}
+}
+func (m *ImportReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportReport.Merge(m, src)
+}
+func (m *ImportReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportReport proto.InternalMessageInfo
+
+func (m *ImportReport) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImportReport) GetValid() int32 {
+	if m != nil {
+		return m.Valid
+	}
+	return 0
+}
+
+func (m *ImportReport) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportReport) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportReport) GetErrors() []*ImportRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Country)(nil), "service_service.Country")
 	proto.RegisterType((*GetSingleRequest)(nil), "service_service.GetSingleRequest")
 	proto.RegisterType((*ListRequest)(nil), "service_service.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "service_service.ListResponse")
 	proto.RegisterType((*Message)(nil), "service_service.Message")
+	proto.RegisterType((*ImportOptions)(nil), "service_service.ImportOptions")
+	proto.RegisterType((*ImportRequest)(nil), "service_service.ImportRequest")
+	proto.RegisterType((*ImportRowError)(nil), "service_service.ImportRowError")
+	proto.RegisterType((*ImportReport)(nil), "service_service.ImportReport")
 }
 
 func init() { proto.RegisterFile("country_service/country.proto", fileDescriptor_4256015bd8361e31) }
 
 var fileDescriptor_4256015bd8361e31 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x4f, 0xfa, 0x40,
-	0x10, 0xfd, 0xb5, 0xd0, 0x1f, 0xe9, 0x20, 0x48, 0x36, 0x1e, 0x1a, 0x22, 0x0d, 0xd6, 0x0b, 0x27,
-	0x4c, 0x30, 0xd1, 0xab, 0x15, 0x0d, 0x31, 0xc1, 0x4b, 0xb9, 0x9a, 0x90, 0xca, 0x8e, 0x64, 0x93,
-	0xd2, 0xd6, 0xee, 0x62, 0xe2, 0xc9, 0xaf, 0xe1, 0x47, 0xf2, 0xe8, 0x47, 0x30, 0xf8, 0x35, 0x3c,
-	0x98, 0xee, 0x9f, 0x68, 0x40, 0x4e, 0xdc, 0x66, 0xde, 0x7b, 0xf3, 0x76, 0xfa, 0x26, 0x85, 0xce,
-	0x2c, 0x5b, 0xa6, 0xa2, 0x78, 0x9e, 0x72, 0x2c, 0x9e, 0xd8, 0x0c, 0x4f, 0x74, 0xdf, 0xcf, 0x8b,
-	0x4c, 0x64, 0x64, 0x5f, 0xc3, 0x86, 0x0e, 0x5e, 0xa0, 0x36, 0x54, 0x0a, 0xd2, 0x04, 0x9b, 0x51,
-	0xcf, 0xea, 0x5a, 0xbd, 0x4a, 0x64, 0x33, 0x4a, 0x08, 0x54, 0xd3, 0x78, 0x81, 0x9e, 0xdd, 0xb5,
-	0x7a, 0x6e, 0x24, 0xeb, 0x12, 0x7b, 0x48, 0xe2, 0xb9, 0x57, 0x51, 0x58, 0x59, 0x93, 0x0e, 0xc0,
-	0xac, 0xc0, 0x58, 0x20, 0x9d, 0xc6, 0xc2, 0xab, 0x4a, 0xc6, 0xd5, 0x48, 0x28, 0x4a, 0x7a, 0x99,
-	0x53, 0x43, 0x3b, 0x8a, 0xd6, 0x48, 0x28, 0x82, 0x00, 0x5a, 0x23, 0x14, 0x13, 0x96, 0xce, 0x13,
-	0x8c, 0xf0, 0x71, 0x89, 0x5c, 0xac, 0x6f, 0x12, 0x9c, 0x43, 0x7d, 0xcc, 0xb8, 0x30, 0x34, 0x81,
-	0x6a, 0x1e, 0xcf, 0x51, 0x0a, 0x9c, 0x48, 0xd6, 0xe4, 0x00, 0x9c, 0x84, 0x2d, 0x98, 0x90, 0xdb,
-	0x3a, 0x91, 0x6a, 0x82, 0x3b, 0xd8, 0x53, 0x83, 0x3c, 0xcf, 0x52, 0x2e, 0x55, 0x32, 0x0f, 0xed,
-	0xad, 0x1a, 0x72, 0x06, 0xae, 0x4a, 0x89, 0x21, 0xf7, 0xec, 0x6e, 0xa5, 0x57, 0x1f, 0x78, 0xfd,
-	0xb5, 0xa0, 0xfa, 0x3a, 0xa5, 0xe8, 0x47, 0x1a, 0x1c, 0x43, 0xed, 0x16, 0x39, 0x2f, 0x9f, 0xf7,
-	0xa0, 0xb6, 0x50, 0xa5, 0xb4, 0x76, 0x23, 0xd3, 0x0e, 0xbe, 0x6c, 0x68, 0xea, 0xd9, 0x89, 0xb2,
-	0x22, 0x17, 0x00, 0x21, 0xa5, 0x26, 0xf6, 0xad, 0x4f, 0xb5, 0xb7, 0x32, 0x24, 0x84, 0xfa, 0x35,
-	0x65, 0x62, 0x17, 0x8b, 0x31, 0x34, 0xae, 0x30, 0x41, 0x81, 0x06, 0x38, 0xda, 0x90, 0xae, 0xdf,
-	0xe5, 0x0f, 0x37, 0xf3, 0xfd, 0x63, 0x68, 0x94, 0x41, 0x0f, 0x4d, 0x36, 0xe4, 0x70, 0x43, 0xfa,
-	0xeb, 0x82, 0xed, 0xce, 0x16, 0x56, 0x9f, 0xe9, 0x06, 0x60, 0x84, 0x62, 0xa7, 0xc5, 0xf4, 0xf0,
-	0x65, 0xeb, 0x6d, 0xe5, 0x5b, 0xef, 0x2b, 0xdf, 0xfa, 0x58, 0xf9, 0xd6, 0xeb, 0xa7, 0xff, 0xef,
-	0xfe, 0xbf, 0xfc, 0x13, 0x4e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x28, 0xb1, 0x6f, 0x2a,
-	0x03, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0x13, 0x3b,
+	0x10, 0x3e, 0xdb, 0xcd, 0xef, 0xa4, 0x69, 0x2b, 0xeb, 0xe8, 0x9c, 0x3d, 0xd1, 0x69, 0x08, 0x41,
+	0x42, 0xb9, 0x4a, 0xa5, 0x20, 0x01, 0x97, 0x84, 0x52, 0xaa, 0x4a, 0xad, 0x40, 0x2e, 0xe2, 0x0e,
+	0xad, 0xb6, 0x6b, 0x27, 0x58, 0xd9, 0xac, 0x83, 0xed, 0x6d, 0x49, 0xaf, 0x79, 0x08, 0x84, 0x78,
+	0x18, 0x2e, 0xb9, 0xec, 0x23, 0xa0, 0xf2, 0x22, 0xc8, 0x7f, 0x6d, 0xda, 0x10, 0x09, 0xa9, 0x37,
+	0xab, 0x99, 0x6f, 0x66, 0xc7, 0xdf, 0x7c, 0x33, 0x36, 0x6c, 0xa7, 0xbc, 0xc8, 0x95, 0x98, 0xc7,
+	0x92, 0x8a, 0x53, 0x96, 0xd2, 0x1d, 0xe7, 0xf7, 0x67, 0x82, 0x2b, 0x8e, 0x36, 0x1d, 0xec, 0xc3,
+	0xad, 0xce, 0x98, 0xf3, 0x71, 0x46, 0x77, 0x4c, 0xf8, 0xa4, 0x18, 0xed, 0x8c, 0x18, 0xcd, 0x48,
+	0x3c, 0x4d, 0xe4, 0xc4, 0xfe, 0xd2, 0xfd, 0x12, 0x40, 0x75, 0xd7, 0x16, 0x41, 0x1b, 0xb0, 0xc6,
+	0x48, 0x14, 0x74, 0x82, 0x5e, 0x88, 0xd7, 0x18, 0x41, 0x08, 0x4a, 0x79, 0x32, 0xa5, 0xd1, 0x5a,
+	0x27, 0xe8, 0xd5, 0xb1, 0xb1, 0x35, 0x36, 0xca, 0x92, 0x71, 0x14, 0x5a, 0x4c, 0xdb, 0x68, 0x1b,
+	0x20, 0x15, 0x34, 0x51, 0x94, 0xc4, 0x89, 0x8a, 0x4a, 0x26, 0x52, 0x77, 0xc8, 0x50, 0xe9, 0x70,
+	0x31, 0x23, 0x3e, 0x5c, 0xb6, 0x61, 0x87, 0x0c, 0x15, 0xfa, 0x0f, 0x6a, 0x39, 0x4f, 0xe3, 0x94,
+	0x13, 0x1a, 0x55, 0x4c, 0xb0, 0x9a, 0xf3, 0x74, 0x97, 0x13, 0xda, 0x7d, 0x0b, 0x5b, 0xfb, 0x54,
+	0x1d, 0xb3, 0x7c, 0x9c, 0x51, 0x4c, 0x3f, 0x14, 0x54, 0xaa, 0x25, 0x92, 0x03, 0xa8, 0x98, 0xa6,
+	0xa4, 0xa1, 0xd9, 0x18, 0xb4, 0xfa, 0xb6, 0xe7, 0xbe, 0xef, 0xb9, 0xff, 0x52, 0x87, 0x8f, 0x12,
+	0x39, 0xc1, 0x2e, 0xb3, 0xfb, 0x35, 0x80, 0xc6, 0x21, 0x93, 0xca, 0xd7, 0x44, 0x50, 0x9a, 0x25,
+	0x63, 0x6a, 0xaa, 0x96, 0xb1, 0xb1, 0xd1, 0xdf, 0x50, 0xce, 0xd8, 0x94, 0x29, 0x53, 0xb6, 0x8c,
+	0xad, 0x83, 0xb6, 0x20, 0x64, 0x44, 0x46, 0x61, 0x27, 0xec, 0x85, 0x58, 0x9b, 0x0b, 0xe7, 0x97,
+	0xfe, 0xf4, 0x7c, 0xad, 0x88, 0x3e, 0x23, 0x56, 0x7c, 0x42, 0x73, 0xaf, 0x88, 0x46, 0xde, 0x68,
+	0xa0, 0xfb, 0x29, 0x80, 0x75, 0x4b, 0x4f, 0xce, 0x78, 0x2e, 0x0d, 0x17, 0x33, 0x68, 0xd7, 0xb6,
+	0x75, 0xd0, 0x63, 0xa8, 0x1b, 0x43, 0x30, 0xaa, 0x9b, 0x0f, 0x7b, 0x8d, 0x41, 0xd4, 0xbf, 0xb5,
+	0x01, 0x7d, 0x37, 0x5b, 0x7c, 0x9d, 0x8a, 0x1e, 0xc2, 0x66, 0x4e, 0x3f, 0xaa, 0x78, 0x81, 0x82,
+	0x9d, 0x66, 0x53, 0xc3, 0xaf, 0xaf, 0x68, 0x3c, 0x80, 0xea, 0x11, 0x95, 0x52, 0x8b, 0x11, 0x41,
+	0x75, 0x6a, 0x4d, 0x43, 0xa1, 0x8e, 0xbd, 0xdb, 0x9d, 0x43, 0xf3, 0x60, 0x3a, 0xe3, 0x42, 0xbd,
+	0x9a, 0x29, 0xc6, 0x73, 0x89, 0xfe, 0x81, 0xca, 0x88, 0x8b, 0x69, 0xa2, 0x5c, 0xa6, 0xf3, 0xd0,
+	0xbf, 0x50, 0x25, 0x62, 0x1e, 0x8b, 0x22, 0x37, 0x8a, 0xd6, 0x70, 0x85, 0x88, 0x39, 0x2e, 0x72,
+	0x5d, 0x3b, 0x7d, 0x5f, 0xe4, 0x13, 0x4a, 0x0c, 0x8d, 0x1a, 0xf6, 0xae, 0xd9, 0x2b, 0x6d, 0xc6,
+	0x92, 0x9d, 0x53, 0x23, 0x6f, 0x19, 0xd7, 0x0d, 0x72, 0xcc, 0xce, 0x69, 0xf7, 0x9d, 0x3f, 0xda,
+	0x8f, 0xf1, 0x29, 0x54, 0xb9, 0x65, 0x61, 0xce, 0x6e, 0x0c, 0xda, 0x4b, 0x72, 0xdc, 0xe0, 0x8a,
+	0x7d, 0xba, 0x5e, 0x00, 0x92, 0xa8, 0xc4, 0x30, 0x5b, 0xc7, 0xc6, 0xee, 0x62, 0xd8, 0x70, 0xe5,
+	0xf9, 0xd9, 0x9e, 0x10, 0x5c, 0xe8, 0xe1, 0x0b, 0x7e, 0xe6, 0xb6, 0x44, 0x9b, 0x7a, 0x30, 0x66,
+	0xa4, 0xee, 0x8a, 0x58, 0x67, 0x51, 0xad, 0xf0, 0xa6, 0x5a, 0xdf, 0x02, 0x58, 0xf7, 0x9c, 0xf5,
+	0x57, 0x17, 0x50, 0x5c, 0x25, 0x99, 0x2b, 0x6a, 0x1d, 0x8d, 0x9e, 0x26, 0x19, 0x23, 0x7e, 0xf7,
+	0x8c, 0x83, 0x5a, 0x50, 0x63, 0xe6, 0x5f, 0xa7, 0x54, 0x19, 0x5f, 0xf9, 0x46, 0xf5, 0x84, 0x65,
+	0x94, 0x38, 0x99, 0x9c, 0xb7, 0xa8, 0x7a, 0xf9, 0x86, 0xea, 0x4f, 0xa0, 0x42, 0x75, 0x53, 0x32,
+	0xaa, 0x98, 0xcd, 0xb9, 0xb7, 0x42, 0x2a, 0xdf, 0x3c, 0x76, 0xe9, 0x83, 0x8b, 0x10, 0x36, 0xdc,
+	0x52, 0x1d, 0xdb, 0x4c, 0xf4, 0x0c, 0x60, 0x48, 0x88, 0x03, 0xd1, 0xca, 0x1d, 0x6c, 0xad, 0x8c,
+	0xa0, 0x21, 0x34, 0xf6, 0x08, 0x53, 0x77, 0x29, 0x71, 0x08, 0xcd, 0x17, 0x34, 0xa3, 0x8a, 0x7a,
+	0xe0, 0xfe, 0x52, 0xea, 0xed, 0xb7, 0xe4, 0x37, 0xd5, 0xfc, 0xc2, 0x1f, 0x42, 0x53, 0xdf, 0xc0,
+	0xdd, 0xab, 0x4b, 0xf3, 0xff, 0x52, 0xea, 0xc2, 0x03, 0xd2, 0xda, 0x5e, 0x11, 0x75, 0xf7, 0xf7,
+	0x00, 0x60, 0x9f, 0xaa, 0x3b, 0x11, 0xf3, 0x3f, 0x63, 0xd8, 0xb4, 0x83, 0xb9, 0xa6, 0xb6, 0x6a,
+	0xcb, 0x57, 0x93, 0x5b, 0x5c, 0xc1, 0x5e, 0xf0, 0x7c, 0xeb, 0xfb, 0x65, 0x3b, 0xb8, 0xb8, 0x6c,
+	0x07, 0x3f, 0x2e, 0xdb, 0xc1, 0xe7, 0x9f, 0xed, 0xbf, 0x4e, 0x2a, 0xe6, 0xf1, 0x7a, 0xf4, 0x6b,
+	0x00, 0xec, 0x9b, 0x19, 0xe8, 0x70, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -364,6 +714,7 @@ type CountryServiceClient interface {
 	DeleteCountry(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Message, error)
 	ListCountries(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	GetCountry(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Country, error)
+	ImportCountries(ctx context.Context, opts ...grpc.CallOption) (CountryService_ImportCountriesClient, error)
 }
 
 type countryServiceClient struct {
@@ -419,6 +770,40 @@ func (c *countryServiceClient) GetCountry(ctx context.Context, in *GetSingleRequ
 	return out, nil
 }
 
+func (c *countryServiceClient) ImportCountries(ctx context.Context, opts ...grpc.CallOption) (CountryService_ImportCountriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CountryService_serviceDesc.Streams[0], "/service_service.CountryService/ImportCountries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &countryServiceImportCountriesClient{stream}
+	return x, nil
+}
+
+type CountryService_ImportCountriesClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportReport, error)
+	grpc.ClientStream
+}
+
+type countryServiceImportCountriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *countryServiceImportCountriesClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *countryServiceImportCountriesClient) CloseAndRecv() (*ImportReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CountryServiceServer is the server API for CountryService service.
 type CountryServiceServer interface {
 	AddCountry(context.Context, *Country) (*Country, error)
@@ -426,6 +811,7 @@ type CountryServiceServer interface {
 	DeleteCountry(context.Context, *GetSingleRequest) (*Message, error)
 	ListCountries(context.Context, *ListRequest) (*ListResponse, error)
 	GetCountry(context.Context, *GetSingleRequest) (*Country, error)
+	ImportCountries(CountryService_ImportCountriesServer) error
 }
 
 // UnimplementedCountryServiceServer can be embedded to have forward compatible implementations.
@@ -447,6 +833,9 @@ func (*UnimplementedCountryServiceServer) ListCountries(ctx context.Context, req
 func (*UnimplementedCountryServiceServer) GetCountry(ctx context.Context, req *GetSingleRequest) (*Country, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCountry not implemented")
 }
+func (*UnimplementedCountryServiceServer) ImportCountries(srv CountryService_ImportCountriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCountries not implemented")
+}
 
 func RegisterCountryServiceServer(s *grpc.Server, srv CountryServiceServer) {
 	s.RegisterService(&_CountryService_serviceDesc, srv)
@@ -542,6 +931,32 @@ func _CountryService_GetCountry_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CountryService_ImportCountries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CountryServiceServer).ImportCountries(&countryServiceImportCountriesServer{stream})
+}
+
+type CountryService_ImportCountriesServer interface {
+	SendAndClose(*ImportReport) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type countryServiceImportCountriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *countryServiceImportCountriesServer) SendAndClose(m *ImportReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *countryServiceImportCountriesServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _CountryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service_service.CountryService",
 	HandlerType: (*CountryServiceServer)(nil),
@@ -567,7 +982,13 @@ var _CountryService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _CountryService_GetCountry_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCountries",
+			Handler:       _CountryService_ImportCountries_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "country_service/country.proto",
 }
 
@@ -595,6 +1016,13 @@ func (m *Country) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NocCode) > 0 {
+		i -= len(m.NocCode)
+		copy(dAtA[i:], m.NocCode)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.NocCode)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.UpdatedAt) > 0 {
 		i -= len(m.UpdatedAt)
 		copy(dAtA[i:], m.UpdatedAt)
@@ -655,6 +1083,18 @@ func (m *GetSingleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Id != 0 {
 		i = encodeVarintCountry(dAtA, i, uint64(m.Id))
 		i--
@@ -687,6 +1127,44 @@ func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ids) > 0 {
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
+		for _, num1 := range m.Ids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintCountry(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Limit != 0 {
 		i = encodeVarintCountry(dAtA, i, uint64(m.Limit))
 		i--
@@ -724,6 +1202,13 @@ func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Countries) > 0 {
 		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -780,6 +1265,228 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ImportOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Chunked {
+		i--
+		if m.Chunked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRowError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRowError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRowError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintCountry(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Row != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Imported != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Valid != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.Valid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintCountry(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCountry(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCountry(v)
 	base := offset
@@ -816,6 +1523,10 @@ func (m *Country) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCountry(uint64(l))
 	}
+	l = len(m.NocCode)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -831,6 +1542,10 @@ func (m *GetSingleRequest) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovCountry(uint64(m.Id))
 	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovCountry(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -849,6 +1564,21 @@ func (m *ListRequest) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovCountry(uint64(m.Limit))
 	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovCountry(uint64(e))
+		}
+		n += 1 + sovCountry(uint64(l)) + l
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -870,6 +1600,10 @@ func (m *ListResponse) Size() (n int) {
 			n += 1 + l + sovCountry(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -892,6 +1626,107 @@ func (m *Message) Size() (n int) {
 	return n
 }
 
+func (m *ImportOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.Chunked {
+		n += 2
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovCountry(uint64(m.ChunkSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRowError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovCountry(uint64(m.Row))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCountry(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovCountry(uint64(m.Total))
+	}
+	if m.Valid != 0 {
+		n += 1 + sovCountry(uint64(m.Valid))
+	}
+	if m.Imported != 0 {
+		n += 1 + sovCountry(uint64(m.Imported))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovCountry(uint64(m.Failed))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovCountry(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCountry(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1074,14 +1909,46 @@ func (m *Country) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountry
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NocCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NocCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountry
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1144,6 +2011,42 @@ func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountry(dAtA[iNdEx:])
@@ -1233,6 +2136,150 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCountry
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCountry
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCountry
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCountry
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCountry
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountry(dAtA[iNdEx:])
@@ -1337,6 +2384,38 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountry(dAtA[iNdEx:])
@@ -1442,6 +2521,584 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ImportOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Chunked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &ImportOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportRowError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportRowError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportRowError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			m.Valid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
+			}
+			m.Imported = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Imported |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ImportRowError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCountry(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,9 @@ package medal_service
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -13,12 +16,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,16 +32,17 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Medal struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	CountryId            int64    `protobuf:"varint,2,opt,name=country_id,json=countryId,proto3" json:"country_id"`
-	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
-	EventId              int64    `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id"`
-	AthleteId            string   `protobuf:"bytes,5,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id"`
-	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	CountryId            int64      `protobuf:"varint,2,opt,name=country_id,json=countryId,proto3" json:"country_id"`
+	Type                 string     `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	EventId              int64      `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id"`
+	AthleteId            string     `protobuf:"bytes,5,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id"`
+	CreatedAt            *time.Time `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt            *time.Time `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	GamesId              int64      `protobuf:"varint,8,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Medal) Reset()         { *m = Medal{} }
@@ -107,25 +113,33 @@ func (m *Medal) GetAthleteId() string {
 	return ""
 }
 
-func (m *Medal) GetCreatedAt() string {
+func (m *Medal) GetCreatedAt() *time.Time {
 	if m != nil {
 		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *Medal) GetUpdatedAt() string {
+func (m *Medal) GetUpdatedAt() *time.Time {
 	if m != nil {
 		return m.UpdatedAt
 	}
-	return ""
+	return nil
+}
+
+func (m *Medal) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
 }
 
 type GetSingleRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Fields               *types.FieldMask `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetSingleRequest) Reset()         { *m = GetSingleRequest{} }
@@ -168,15 +182,27 @@ func (m *GetSingleRequest) GetId() int64 {
 	return 0
 }
 
+func (m *GetSingleRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 type ListRequest struct {
-	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	Country              int64    `protobuf:"varint,3,opt,name=country,proto3" json:"country"`
-	EventId              int64    `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id"`
-	AthleteId            string   `protobuf:"bytes,5,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page                 int32            `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                int32            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	Country              int64            `protobuf:"varint,3,opt,name=country,proto3" json:"country"`
+	EventId              int64            `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id"`
+	AthleteId            string           `protobuf:"bytes,5,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id"`
+	CountryIds           []int64          `protobuf:"varint,6,rep,packed,name=country_ids,json=countryIds,proto3" json:"country_ids"`
+	EventIds             []int64          `protobuf:"varint,7,rep,packed,name=event_ids,json=eventIds,proto3" json:"event_ids"`
+	GamesId              int64            `protobuf:"varint,8,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	Fields               *types.FieldMask `protobuf:"bytes,9,opt,name=fields,proto3" json:"fields"`
+	PageToken            string           `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
@@ -247,9 +273,45 @@ func (m *ListRequest) GetAthleteId() string {
 	return ""
 }
 
+func (m *ListRequest) GetCountryIds() []int64 {
+	if m != nil {
+		return m.CountryIds
+	}
+	return nil
+}
+
+func (m *ListRequest) GetEventIds() []int64 {
+	if m != nil {
+		return m.EventIds
+	}
+	return nil
+}
+
+func (m *ListRequest) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
+func (m *ListRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListResponse struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 	Medals               []*Medal `protobuf:"bytes,2,rep,name=medals,proto3" json:"medals"`
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +364,13 @@ func (m *ListResponse) GetMedals() []*Medal {
 	return nil
 }
 
+func (m *ListResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Message struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -388,6 +457,53 @@ func (m *Empty) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Empty proto.InternalMessageInfo
 
+type RankingRequest struct {
+	GamesId              int64    `protobuf:"varint,1,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RankingRequest) Reset()         { *m = RankingRequest{} }
+func (m *RankingRequest) String() string { return proto.CompactTextString(m) }
+func (*RankingRequest) ProtoMessage()    {}
+func (*RankingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51de6f17ebbb61b, []int{6}
+}
+func (m *RankingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RankingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RankingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RankingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankingRequest.Merge(m, src)
+}
+func (m *RankingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RankingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankingRequest proto.InternalMessageInfo
+
+func (m *RankingRequest) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
 type CountryMedalCount struct {
 	CountryId            int64    `protobuf:"varint,1,opt,name=country_id,json=countryId,proto3" json:"country_id"`
 	CountryName          string   `protobuf:"bytes,2,opt,name=country_name,json=countryName,proto3" json:"country_name"`
@@ -404,7 +520,7 @@ func (m *CountryMedalCount) Reset()         { *m = CountryMedalCount{} }
 func (m *CountryMedalCount) String() string { return proto.CompactTextString(m) }
 func (*CountryMedalCount) ProtoMessage()    {}
 func (*CountryMedalCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f51de6f17ebbb61b, []int{6}
+	return fileDescriptor_f51de6f17ebbb61b, []int{7}
 }
 func (m *CountryMedalCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +602,7 @@ func (m *MedalRankingResponse) Reset()         { *m = MedalRankingResponse{} }
 func (m *MedalRankingResponse) String() string { return proto.CompactTextString(m) }
 func (*MedalRankingResponse) ProtoMessage()    {}
 func (*MedalRankingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f51de6f17ebbb61b, []int{7}
+	return fileDescriptor_f51de6f17ebbb61b, []int{8}
 }
 func (m *MedalRankingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,6 +638,290 @@ func (m *MedalRankingResponse) GetCountryMedalCounts() []*CountryMedalCount {
 	return nil
 }
 
+type ImportOptions struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Chunked              bool     `protobuf:"varint,3,opt,name=chunked,proto3" json:"chunked"`
+	ChunkSize            int32    `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size"`
+	GamesId              int64    `protobuf:"varint,5,opt,name=games_id,json=gamesId,proto3" json:"games_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOptions) Reset()         { *m = ImportOptions{} }
+func (m *ImportOptions) String() string { return proto.CompactTextString(m) }
+func (*ImportOptions) ProtoMessage()    {}
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51de6f17ebbb61b, []int{9}
+}
+func (m *ImportOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOptions.Merge(m, src)
+}
+func (m *ImportOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOptions proto.InternalMessageInfo
+
+func (m *ImportOptions) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunked() bool {
+	if m != nil {
+		return m.Chunked
+	}
+	return false
+}
+
+func (m *ImportOptions) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *ImportOptions) GetGamesId() int64 {
+	if m != nil {
+		return m.GamesId
+	}
+	return 0
+}
+
+type ImportRequest struct {
+	Options              *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options"`
+	Data                 []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51de6f17ebbb61b, []int{10}
+}
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetOptions() *ImportOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	Row                  int32    `protobuf:"varint,1,opt,name=row,proto3" json:"row"`
+	Field                string   `protobuf:"bytes,2,opt,name=field,proto3" json:"field"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowError) Reset()         { *m = ImportRowError{} }
+func (m *ImportRowError) String() string { return proto.CompactTextString(m) }
+func (*ImportRowError) ProtoMessage()    {}
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51de6f17ebbb61b, []int{11}
+}
+func (m *ImportRowError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRowError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowError.Merge(m, src)
+}
+func (m *ImportRowError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowError proto.InternalMessageInfo
+
+func (m *ImportRowError) GetRow() int32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportRowError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ImportRowError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportReport struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Valid                int32             `protobuf:"varint,2,opt,name=valid,proto3" json:"valid"`
+	Imported             int32             `protobuf:"varint,3,opt,name=imported,proto3" json:"imported"`
+	Failed               int32             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed"`
+	DryRun               bool              `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Errors               []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportReport) Reset()         { *m = ImportReport{} }
+func (m *ImportReport) String() string { return proto.CompactTextString(m) }
+func (*ImportReport) ProtoMessage()    {}
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f51de6f17ebbb61b, []int{12}
+}
+func (m *ImportReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportReport.Merge(m, src)
+}
+func (m *ImportReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportReport proto.InternalMessageInfo
+
+func (m *ImportReport) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImportReport) GetValid() int32 {
+	if m != nil {
+		return m.Valid
+	}
+	return 0
+}
+
+func (m *ImportReport) GetImported() int32 {
+	if m != nil {
+		return m.Imported
+	}
+	return 0
+}
+
+func (m *ImportReport) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportReport) GetErrors() []*ImportRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Medal)(nil), "medal_service.Medal")
 	proto.RegisterType((*GetSingleRequest)(nil), "medal_service.GetSingleRequest")
@@ -529,49 +929,83 @@ func init() {
 	proto.RegisterType((*ListResponse)(nil), "medal_service.ListResponse")
 	proto.RegisterType((*Message)(nil), "medal_service.Message")
 	proto.RegisterType((*Empty)(nil), "medal_service.Empty")
+	proto.RegisterType((*RankingRequest)(nil), "medal_service.RankingRequest")
 	proto.RegisterType((*CountryMedalCount)(nil), "medal_service.CountryMedalCount")
 	proto.RegisterType((*MedalRankingResponse)(nil), "medal_service.MedalRankingResponse")
+	proto.RegisterType((*ImportOptions)(nil), "medal_service.ImportOptions")
+	proto.RegisterType((*ImportRequest)(nil), "medal_service.ImportRequest")
+	proto.RegisterType((*ImportRowError)(nil), "medal_service.ImportRowError")
+	proto.RegisterType((*ImportReport)(nil), "medal_service.ImportReport")
 }
 
 func init() { proto.RegisterFile("medal_service/medal.proto", fileDescriptor_f51de6f17ebbb61b) }
 
 var fileDescriptor_f51de6f17ebbb61b = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x8e, 0x12, 0x4d,
-	0x14, 0xfd, 0x1a, 0x68, 0x9a, 0xbe, 0xf0, 0xe9, 0x58, 0x21, 0x93, 0x1e, 0x8c, 0x88, 0x3d, 0x1b,
-	0x16, 0x06, 0x93, 0x31, 0xd1, 0x35, 0x2a, 0x4e, 0x26, 0x99, 0x71, 0x51, 0xf3, 0x00, 0xa4, 0x87,
-	0xba, 0xc1, 0xd2, 0xfe, 0xb3, 0xab, 0x20, 0xc1, 0x57, 0xf0, 0x05, 0xdc, 0xf9, 0x10, 0xbe, 0x81,
-	0x2b, 0x97, 0x3e, 0x82, 0xc1, 0x17, 0x31, 0x7d, 0xab, 0xda, 0x48, 0xc3, 0x42, 0xdd, 0xd5, 0x39,
-	0xa7, 0xea, 0x72, 0xcf, 0xb9, 0xb7, 0x81, 0x93, 0x04, 0x45, 0x14, 0xcf, 0x15, 0x16, 0x6b, 0xb9,
-	0xc0, 0x47, 0x84, 0x26, 0x79, 0x91, 0xe9, 0x8c, 0xfd, 0xbf, 0x23, 0x85, 0x5f, 0x1c, 0x70, 0xaf,
-	0x4a, 0x86, 0xdd, 0x82, 0x86, 0x14, 0x81, 0x33, 0x72, 0xc6, 0x4d, 0xde, 0x90, 0x82, 0xdd, 0x03,
-	0x58, 0x64, 0xab, 0x54, 0x17, 0x9b, 0xb9, 0x14, 0x41, 0x83, 0x78, 0xdf, 0x32, 0x17, 0x82, 0x31,
-	0x68, 0xe9, 0x4d, 0x8e, 0x41, 0x73, 0xe4, 0x8c, 0x7d, 0x4e, 0x67, 0x76, 0x02, 0x1d, 0x5c, 0x63,
-	0xaa, 0xcb, 0x07, 0x2d, 0x7a, 0xe0, 0x11, 0xbe, 0xa0, 0x6a, 0x91, 0x7e, 0x1d, 0xa3, 0xc6, 0x52,
-	0x74, 0xe9, 0x91, 0x6f, 0x19, 0x23, 0x2f, 0x0a, 0x8c, 0x34, 0x8a, 0x79, 0xa4, 0x83, 0xb6, 0x91,
-	0x2d, 0x33, 0xd5, 0xa5, 0xbc, 0xca, 0x45, 0x25, 0x7b, 0x46, 0xb6, 0xcc, 0x54, 0x87, 0x21, 0x1c,
-	0x9d, 0xa3, 0xbe, 0x96, 0xe9, 0x32, 0x46, 0x8e, 0xef, 0x56, 0xa8, 0x74, 0xdd, 0x4e, 0xf8, 0xc1,
-	0x81, 0xee, 0xa5, 0x54, 0xba, 0xd2, 0x19, 0xb4, 0xf2, 0x68, 0x89, 0x74, 0xc3, 0xe5, 0x74, 0x66,
-	0x7d, 0x70, 0x63, 0x99, 0x48, 0x4d, 0x6e, 0x5d, 0x6e, 0x00, 0x0b, 0xc0, 0xb3, 0xb6, 0xc9, 0x6c,
-	0x93, 0x57, 0xf0, 0xdf, 0xfd, 0x86, 0x1c, 0x7a, 0xa6, 0x19, 0x95, 0x67, 0xa9, 0xa2, 0x5f, 0xa6,
-	0xa2, 0xb6, 0x61, 0x03, 0xd8, 0x43, 0x68, 0xd3, 0xb4, 0x54, 0xd0, 0x18, 0x35, 0xc7, 0xdd, 0xb3,
-	0xfe, 0x64, 0x67, 0x78, 0x13, 0x1a, 0x1c, 0xb7, 0x77, 0xc2, 0x53, 0xf0, 0xae, 0x50, 0xa9, 0xd2,
-	0x48, 0x00, 0x5e, 0x62, 0x8e, 0x54, 0xd0, 0xe7, 0x15, 0x0c, 0x3d, 0x70, 0x67, 0x49, 0xae, 0x37,
-	0xe1, 0x67, 0x07, 0xee, 0x3c, 0x37, 0x3e, 0xa8, 0x0c, 0x9d, 0x6b, 0x43, 0x77, 0xea, 0x43, 0x7f,
-	0x00, 0xbd, 0x4a, 0x4e, 0xa3, 0x04, 0x29, 0x27, 0x9f, 0x77, 0x2d, 0xf7, 0x2a, 0x4a, 0xb0, 0xcc,
-	0x75, 0x99, 0xc5, 0x82, 0xa2, 0x72, 0x39, 0x9d, 0xd9, 0x31, 0xb4, 0x95, 0x8c, 0xd7, 0x58, 0x50,
-	0x4a, 0x2e, 0xb7, 0xa8, 0xe4, 0x6f, 0x8a, 0x2c, 0x7d, 0x8f, 0x14, 0x90, 0xcb, 0x2d, 0x2a, 0xdb,
-	0x2f, 0xa2, 0xf4, 0xad, 0x4c, 0x97, 0xb4, 0x0a, 0x2e, 0xaf, 0x60, 0xf8, 0x06, 0xfa, 0xc6, 0xb4,
-	0xc1, 0xbf, 0xf2, 0xe3, 0xd0, 0xaf, 0x1a, 0x33, 0x11, 0x11, 0x52, 0x81, 0x43, 0xb9, 0x8d, 0x6a,
-	0xb9, 0xed, 0xf9, 0xe6, 0x6c, 0x51, 0xa7, 0xd4, 0xd9, 0xa7, 0x26, 0xf4, 0x08, 0x5f, 0x9b, 0x67,
-	0xec, 0x09, 0x74, 0xa6, 0x42, 0x98, 0xaf, 0xe5, 0xe0, 0x28, 0x06, 0x07, 0x59, 0xf6, 0x14, 0xfc,
-	0x99, 0x90, 0xfa, 0xef, 0x1f, 0xbe, 0x84, 0xee, 0x0b, 0x2c, 0x37, 0xc6, 0xc0, 0xfb, 0xb5, 0x4b,
-	0xf5, 0x9d, 0x1f, 0x1c, 0xef, 0x55, 0x31, 0xeb, 0x30, 0x03, 0x28, 0xb7, 0x8d, 0xaa, 0x28, 0x36,
-	0xa8, 0xdd, 0xfa, 0xed, 0xab, 0x18, 0xdc, 0x3d, 0xa8, 0xd9, 0x90, 0xa7, 0xd0, 0x39, 0x47, 0xfd,
-	0x87, 0xbd, 0x1c, 0x76, 0x74, 0x09, 0xb7, 0xab, 0x12, 0x76, 0x84, 0x7b, 0x81, 0xd0, 0x7a, 0x0e,
-	0x4e, 0x0f, 0xae, 0xfa, 0xee, 0xd4, 0x9f, 0x1d, 0x7d, 0xdd, 0x0e, 0x9d, 0x6f, 0xdb, 0xa1, 0xf3,
-	0x7d, 0x3b, 0x74, 0x3e, 0xfe, 0x18, 0xfe, 0x77, 0xd3, 0xa6, 0x3f, 0xb9, 0xc7, 0x3f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xa5, 0x40, 0x00, 0x74, 0x01, 0x05, 0x00, 0x00,
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0x23, 0x45,
+	0x13, 0xfe, 0xc7, 0xf6, 0x78, 0x66, 0xca, 0x4e, 0x36, 0x7f, 0xcb, 0x5a, 0x66, 0xbd, 0x6c, 0x62,
+	0x66, 0x25, 0x64, 0x09, 0xe4, 0xac, 0x8c, 0x58, 0x8e, 0x28, 0x01, 0xef, 0xca, 0x0b, 0x01, 0xd4,
+	0x59, 0xe0, 0xc0, 0xc1, 0x9a, 0x64, 0x3a, 0xde, 0xc1, 0x33, 0xd3, 0x66, 0xba, 0x9d, 0x5d, 0xe7,
+	0x29, 0x10, 0xe2, 0xc0, 0x4b, 0x70, 0xe2, 0xc0, 0x8d, 0x33, 0x47, 0xde, 0x00, 0x14, 0x5e, 0x04,
+	0x75, 0x75, 0x77, 0xb0, 0x27, 0xde, 0x25, 0x88, 0x8b, 0x55, 0x5f, 0x75, 0x77, 0xb9, 0xbe, 0xfa,
+	0xaa, 0x6a, 0xe0, 0x4e, 0xce, 0x92, 0x38, 0x9b, 0x08, 0x56, 0x9e, 0xa7, 0xa7, 0x6c, 0x1f, 0xd1,
+	0x60, 0x5e, 0x72, 0xc9, 0xc9, 0xd6, 0xda, 0x51, 0xb7, 0x37, 0xe5, 0x7c, 0x9a, 0xb1, 0x7d, 0x3c,
+	0x3c, 0x59, 0x9c, 0xed, 0x9f, 0xa5, 0x2c, 0x4b, 0x26, 0x79, 0x2c, 0x66, 0xfa, 0x41, 0x77, 0xaf,
+	0x7a, 0x43, 0xa6, 0x39, 0x13, 0x32, 0xce, 0xe7, 0xe6, 0x42, 0x67, 0xca, 0xa7, 0x1c, 0xcd, 0x7d,
+	0x65, 0x69, 0x6f, 0xf4, 0x63, 0x0d, 0xdc, 0x23, 0xf5, 0x57, 0x64, 0x1b, 0x6a, 0x69, 0x12, 0x3a,
+	0x3d, 0xa7, 0x5f, 0xa7, 0xb5, 0x34, 0x21, 0xf7, 0x00, 0x4e, 0xf9, 0xa2, 0x90, 0xe5, 0x72, 0x92,
+	0x26, 0x61, 0x0d, 0xfd, 0x81, 0xf1, 0x8c, 0x13, 0x42, 0xa0, 0x21, 0x97, 0x73, 0x16, 0xd6, 0x7b,
+	0x4e, 0x3f, 0xa0, 0x68, 0x93, 0x3b, 0xe0, 0xb3, 0x73, 0x56, 0x48, 0xf5, 0xa0, 0x81, 0x0f, 0x3c,
+	0xc4, 0x63, 0x8c, 0x16, 0xcb, 0x67, 0x19, 0x93, 0x4c, 0x1d, 0xba, 0xf8, 0x28, 0x30, 0x9e, 0x71,
+	0x42, 0xde, 0x07, 0x38, 0x2d, 0x59, 0x2c, 0x59, 0x32, 0x89, 0x65, 0x18, 0xf4, 0x9c, 0x7e, 0x6b,
+	0xd8, 0x1d, 0x68, 0x4a, 0x03, 0x4b, 0x69, 0xf0, 0xd4, 0x52, 0x3a, 0x6c, 0x7c, 0xfb, 0xfb, 0x9e,
+	0x43, 0x03, 0xf3, 0xe6, 0x40, 0xaa, 0x00, 0x8b, 0x79, 0x62, 0x03, 0xc0, 0x4d, 0x03, 0x98, 0x37,
+	0x07, 0x52, 0xe5, 0x3e, 0x8d, 0x73, 0x26, 0x54, 0x7a, 0xbe, 0xce, 0x1d, 0xf1, 0x38, 0x79, 0xd2,
+	0xf0, 0x9b, 0x3b, 0xde, 0x93, 0x86, 0xef, 0xed, 0xf8, 0xd1, 0x17, 0xb0, 0xf3, 0x98, 0xc9, 0xe3,
+	0xb4, 0x98, 0x66, 0x8c, 0xb2, 0x6f, 0x16, 0x4c, 0xc8, 0x6b, 0x95, 0x1b, 0x42, 0x13, 0xe5, 0x11,
+	0x61, 0xed, 0x25, 0x79, 0x3c, 0x52, 0xc7, 0x47, 0xb1, 0x98, 0x51, 0x73, 0x33, 0xfa, 0xb9, 0x06,
+	0xad, 0x8f, 0x53, 0x21, 0x6d, 0x4c, 0x02, 0x8d, 0x79, 0x3c, 0x65, 0x18, 0xd5, 0xa5, 0x68, 0x93,
+	0x0e, 0xb8, 0x59, 0x9a, 0xa7, 0x12, 0xc3, 0xba, 0x54, 0x03, 0x12, 0x82, 0x67, 0x54, 0x41, 0x2d,
+	0xea, 0xd4, 0xc2, 0xff, 0x20, 0xc7, 0x1e, 0xb4, 0xfe, 0xd6, 0x5e, 0x84, 0xcd, 0x5e, 0xbd, 0x5f,
+	0xa7, 0x70, 0x25, 0xbe, 0x20, 0x77, 0x21, 0xb0, 0xa1, 0x45, 0xe8, 0xe1, 0xb1, 0x6f, 0x62, 0x8b,
+	0x57, 0x94, 0x72, 0xa5, 0x34, 0xc1, 0x4d, 0x4b, 0xa3, 0x72, 0x55, 0xf4, 0x27, 0x92, 0xcf, 0x58,
+	0x81, 0xd2, 0x06, 0x34, 0x50, 0x9e, 0xa7, 0xca, 0x11, 0x5d, 0x40, 0x5b, 0x17, 0x4e, 0xcc, 0x79,
+	0x21, 0xb0, 0x4a, 0x98, 0xa8, 0x11, 0x44, 0x03, 0xf2, 0x36, 0x34, 0x71, 0xa2, 0x94, 0x26, 0xf5,
+	0x7e, 0x6b, 0xd8, 0x19, 0xac, 0x0d, 0xd8, 0x00, 0x67, 0x80, 0x9a, 0x3b, 0xe4, 0x4d, 0xb8, 0x55,
+	0xb0, 0x17, 0x72, 0xb2, 0xf2, 0xbf, 0xba, 0xcf, 0xb7, 0x94, 0xfb, 0xb3, 0xab, 0xff, 0xbe, 0x0f,
+	0xde, 0x11, 0x13, 0x42, 0x89, 0x13, 0x82, 0x97, 0x6b, 0x13, 0xff, 0x38, 0xa0, 0x16, 0x46, 0x1e,
+	0xb8, 0xa3, 0x7c, 0x2e, 0x97, 0xd1, 0x5b, 0xb0, 0x4d, 0xe3, 0x62, 0x96, 0x16, 0x53, 0xab, 0xf2,
+	0x6a, 0xa5, 0x9c, 0xb5, 0x4a, 0x45, 0x3f, 0x39, 0xf0, 0xff, 0x0f, 0x74, 0xc1, 0x31, 0x37, 0xb4,
+	0x2b, 0x43, 0xe9, 0x54, 0x87, 0xf2, 0x0d, 0x68, 0xdb, 0xe3, 0x22, 0xce, 0x19, 0x36, 0x4a, 0x40,
+	0xad, 0x96, 0x9f, 0xc4, 0x39, 0x53, 0x8d, 0x35, 0xe5, 0x59, 0x82, 0x7c, 0x5c, 0x8a, 0x36, 0xb9,
+	0x0d, 0x4d, 0x91, 0x66, 0xe7, 0xac, 0xc4, 0x36, 0x71, 0xa9, 0x41, 0xca, 0x7f, 0x52, 0xf2, 0xe2,
+	0x82, 0x61, 0x87, 0xb8, 0xd4, 0x20, 0xc5, 0xb5, 0xd4, 0x44, 0xc2, 0x26, 0x1e, 0x58, 0x18, 0x7d,
+	0x0d, 0x1d, 0x5d, 0x49, 0xcb, 0xd3, 0x88, 0x42, 0xa1, 0x63, 0x13, 0xd3, 0x75, 0x47, 0x24, 0x42,
+	0x07, 0xc5, 0xe8, 0x55, 0xc4, 0xb8, 0xc6, 0x9b, 0x92, 0xd3, 0xaa, 0x4b, 0x44, 0xdf, 0x3b, 0xb0,
+	0x35, 0xce, 0xe7, 0xbc, 0x94, 0x9f, 0xce, 0x65, 0xca, 0x0b, 0xa1, 0xf2, 0x3d, 0xe3, 0x65, 0x1e,
+	0x4b, 0x23, 0x81, 0x41, 0xe4, 0x35, 0xf0, 0x92, 0x72, 0x39, 0x29, 0x17, 0x05, 0x56, 0xc4, 0xa7,
+	0xcd, 0xa4, 0x5c, 0xd2, 0x45, 0x81, 0xb3, 0xf3, 0x6c, 0x51, 0xcc, 0x98, 0xae, 0x87, 0x4f, 0x2d,
+	0xc4, 0x42, 0x2b, 0x73, 0x22, 0xd2, 0x0b, 0x66, 0xca, 0x12, 0xa0, 0xe7, 0x38, 0xbd, 0x60, 0x6b,
+	0xc2, 0xb9, 0xeb, 0xc2, 0x7d, 0x65, 0xb3, 0xb2, 0x22, 0x3f, 0x04, 0x8f, 0xeb, 0x04, 0x31, 0xad,
+	0xd6, 0xf0, 0xf5, 0x0a, 0xdd, 0x35, 0x12, 0xd4, 0x5e, 0x56, 0x4a, 0x25, 0xb1, 0x8c, 0x31, 0xe5,
+	0x36, 0x45, 0x3b, 0xa2, 0xb0, 0x6d, 0x82, 0xf3, 0xe7, 0xa3, 0xb2, 0xe4, 0x25, 0xd9, 0x81, 0x7a,
+	0xc9, 0x9f, 0x9b, 0x3d, 0xa1, 0x4c, 0x35, 0x00, 0x38, 0x39, 0x46, 0x7d, 0x0d, 0x56, 0xfb, 0xb3,
+	0xbe, 0xde, 0x9f, 0xbf, 0x38, 0xd0, 0xb6, 0x19, 0xab, 0x5f, 0x15, 0x40, 0x72, 0x19, 0x67, 0x26,
+	0xa8, 0x06, 0xca, 0x7b, 0x1e, 0x67, 0xe6, 0x53, 0xe0, 0x52, 0x0d, 0x48, 0x17, 0xfc, 0x14, 0xdf,
+	0x32, 0xdb, 0x52, 0x57, 0x18, 0xe5, 0x88, 0xd3, 0x8c, 0x25, 0xb6, 0xad, 0x34, 0x5a, 0x95, 0xc3,
+	0x5d, 0x93, 0xe3, 0x5d, 0x68, 0x32, 0x45, 0x4a, 0x6f, 0x9c, 0xd6, 0xf0, 0xde, 0xc6, 0x42, 0x59,
+	0xea, 0xd4, 0x5c, 0x1e, 0x7e, 0xe7, 0x42, 0x1b, 0x1b, 0xe3, 0x58, 0xdf, 0x23, 0x0f, 0xc1, 0x3f,
+	0x48, 0x12, 0x74, 0x91, 0x8d, 0x83, 0xde, 0xdd, 0xe8, 0x25, 0xef, 0x41, 0x30, 0x4a, 0x52, 0xf9,
+	0xef, 0x1f, 0x3e, 0x82, 0xd6, 0x87, 0x2c, 0x63, 0x92, 0x69, 0xb8, 0x57, 0xb9, 0x54, 0xfd, 0x62,
+	0x74, 0x6f, 0x5f, 0x8b, 0xa2, 0x97, 0xc8, 0x08, 0x40, 0xed, 0xb2, 0x23, 0xbd, 0x85, 0xba, 0x95,
+	0x5b, 0x2b, 0xdf, 0x87, 0xee, 0xdd, 0x8d, 0x67, 0x66, 0xda, 0x0e, 0xc0, 0x7f, 0xcc, 0xe4, 0x0d,
+	0x73, 0xd9, 0xcc, 0xe8, 0x73, 0xb8, 0x65, 0x43, 0x98, 0x59, 0x26, 0x55, 0x35, 0xd6, 0x77, 0x59,
+	0xf7, 0xfe, 0xc6, 0x8d, 0x5a, 0xd9, 0x03, 0x1f, 0xd9, 0x56, 0x33, 0x14, 0x37, 0x8f, 0xc2, 0xcb,
+	0x48, 0xae, 0x76, 0x69, 0xdf, 0x21, 0x87, 0xd0, 0x1e, 0xbd, 0x58, 0x09, 0xf6, 0xaa, 0x7a, 0x6d,
+	0x64, 0xf9, 0xc0, 0x21, 0x5f, 0x02, 0x59, 0x89, 0x71, 0x43, 0xaa, 0xff, 0xb8, 0xaf, 0x1e, 0x38,
+	0x87, 0x3b, 0xbf, 0x5e, 0xee, 0x3a, 0xbf, 0x5d, 0xee, 0x3a, 0x7f, 0x5c, 0xee, 0x3a, 0x3f, 0xfc,
+	0xb9, 0xfb, 0xbf, 0x93, 0x26, 0x7e, 0xe3, 0xde, 0xf9, 0x6b, 0x00, 0x07, 0x59, 0xea, 0xf6, 0xf6,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -591,7 +1025,10 @@ type MedalServiceClient interface {
 	DeleteMedal(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Message, error)
 	ListMedals(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	GetMedal(ctx context.Context, in *GetSingleRequest, opts ...grpc.CallOption) (*Medal, error)
-	GetMedalRanking(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MedalRankingResponse, error)
+	GetMedalRanking(ctx context.Context, in *RankingRequest, opts ...grpc.CallOption) (*MedalRankingResponse, error)
+	ImportMedals(ctx context.Context, opts ...grpc.CallOption) (MedalService_ImportMedalsClient, error)
+	ExportMedals(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MedalService_ExportMedalsClient, error)
+	ExportMedalRanking(ctx context.Context, in *RankingRequest, opts ...grpc.CallOption) (MedalService_ExportMedalRankingClient, error)
 }
 
 type medalServiceClient struct {
@@ -647,7 +1084,7 @@ func (c *medalServiceClient) GetMedal(ctx context.Context, in *GetSingleRequest,
 	return out, nil
 }
 
-func (c *medalServiceClient) GetMedalRanking(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MedalRankingResponse, error) {
+func (c *medalServiceClient) GetMedalRanking(ctx context.Context, in *RankingRequest, opts ...grpc.CallOption) (*MedalRankingResponse, error) {
 	out := new(MedalRankingResponse)
 	err := c.cc.Invoke(ctx, "/medal_service.MedalService/GetMedalRanking", in, out, opts...)
 	if err != nil {
@@ -656,14 +1093,115 @@ func (c *medalServiceClient) GetMedalRanking(ctx context.Context, in *Empty, opt
 	return out, nil
 }
 
-// MedalServiceServer is the server API for MedalService service.
-type MedalServiceServer interface {
+func (c *medalServiceClient) ImportMedals(ctx context.Context, opts ...grpc.CallOption) (MedalService_ImportMedalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MedalService_serviceDesc.Streams[0], "/medal_service.MedalService/ImportMedals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &medalServiceImportMedalsClient{stream}
+	return x, nil
+}
+
+type MedalService_ImportMedalsClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportReport, error)
+	grpc.ClientStream
+}
+
+type medalServiceImportMedalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *medalServiceImportMedalsClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *medalServiceImportMedalsClient) CloseAndRecv() (*ImportReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *medalServiceClient) ExportMedals(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MedalService_ExportMedalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MedalService_serviceDesc.Streams[1], "/medal_service.MedalService/ExportMedals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &medalServiceExportMedalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MedalService_ExportMedalsClient interface {
+	Recv() (*Medal, error)
+	grpc.ClientStream
+}
+
+type medalServiceExportMedalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *medalServiceExportMedalsClient) Recv() (*Medal, error) {
+	m := new(Medal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *medalServiceClient) ExportMedalRanking(ctx context.Context, in *RankingRequest, opts ...grpc.CallOption) (MedalService_ExportMedalRankingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MedalService_serviceDesc.Streams[2], "/medal_service.MedalService/ExportMedalRanking", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &medalServiceExportMedalRankingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MedalService_ExportMedalRankingClient interface {
+	Recv() (*CountryMedalCount, error)
+	grpc.ClientStream
+}
+
+type medalServiceExportMedalRankingClient struct {
+	grpc.ClientStream
+}
+
+func (x *medalServiceExportMedalRankingClient) Recv() (*CountryMedalCount, error) {
+	m := new(CountryMedalCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MedalServiceServer is the server API for MedalService service.
+type MedalServiceServer interface {
 	AddMedal(context.Context, *Medal) (*Medal, error)
 	EditMedal(context.Context, *Medal) (*Medal, error)
 	DeleteMedal(context.Context, *GetSingleRequest) (*Message, error)
 	ListMedals(context.Context, *ListRequest) (*ListResponse, error)
 	GetMedal(context.Context, *GetSingleRequest) (*Medal, error)
-	GetMedalRanking(context.Context, *Empty) (*MedalRankingResponse, error)
+	GetMedalRanking(context.Context, *RankingRequest) (*MedalRankingResponse, error)
+	ImportMedals(MedalService_ImportMedalsServer) error
+	ExportMedals(*ListRequest, MedalService_ExportMedalsServer) error
+	ExportMedalRanking(*RankingRequest, MedalService_ExportMedalRankingServer) error
 }
 
 // UnimplementedMedalServiceServer can be embedded to have forward compatible implementations.
@@ -685,9 +1223,18 @@ func (*UnimplementedMedalServiceServer) ListMedals(ctx context.Context, req *Lis
 func (*UnimplementedMedalServiceServer) GetMedal(ctx context.Context, req *GetSingleRequest) (*Medal, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMedal not implemented")
 }
-func (*UnimplementedMedalServiceServer) GetMedalRanking(ctx context.Context, req *Empty) (*MedalRankingResponse, error) {
+func (*UnimplementedMedalServiceServer) GetMedalRanking(ctx context.Context, req *RankingRequest) (*MedalRankingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMedalRanking not implemented")
 }
+func (*UnimplementedMedalServiceServer) ImportMedals(srv MedalService_ImportMedalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMedals not implemented")
+}
+func (*UnimplementedMedalServiceServer) ExportMedals(req *ListRequest, srv MedalService_ExportMedalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMedals not implemented")
+}
+func (*UnimplementedMedalServiceServer) ExportMedalRanking(req *RankingRequest, srv MedalService_ExportMedalRankingServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMedalRanking not implemented")
+}
 
 func RegisterMedalServiceServer(s *grpc.Server, srv MedalServiceServer) {
 	s.RegisterService(&_MedalService_serviceDesc, srv)
@@ -784,7 +1331,7 @@ func _MedalService_GetMedal_Handler(srv interface{}, ctx context.Context, dec fu
 }
 
 func _MedalService_GetMedalRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(RankingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -796,11 +1343,79 @@ func _MedalService_GetMedalRanking_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/medal_service.MedalService/GetMedalRanking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MedalServiceServer).GetMedalRanking(ctx, req.(*Empty))
+		return srv.(MedalServiceServer).GetMedalRanking(ctx, req.(*RankingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MedalService_ImportMedals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MedalServiceServer).ImportMedals(&medalServiceImportMedalsServer{stream})
+}
+
+type MedalService_ImportMedalsServer interface {
+	SendAndClose(*ImportReport) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type medalServiceImportMedalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *medalServiceImportMedalsServer) SendAndClose(m *ImportReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *medalServiceImportMedalsServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MedalService_ExportMedals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MedalServiceServer).ExportMedals(m, &medalServiceExportMedalsServer{stream})
+}
+
+type MedalService_ExportMedalsServer interface {
+	Send(*Medal) error
+	grpc.ServerStream
+}
+
+type medalServiceExportMedalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *medalServiceExportMedalsServer) Send(m *Medal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MedalService_ExportMedalRanking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RankingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MedalServiceServer).ExportMedalRanking(m, &medalServiceExportMedalRankingServer{stream})
+}
+
+type MedalService_ExportMedalRankingServer interface {
+	Send(*CountryMedalCount) error
+	grpc.ServerStream
+}
+
+type medalServiceExportMedalRankingServer struct {
+	grpc.ServerStream
+}
+
+func (x *medalServiceExportMedalRankingServer) Send(m *CountryMedalCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _MedalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "medal_service.MedalService",
 	HandlerType: (*MedalServiceServer)(nil),
@@ -830,7 +1445,23 @@ var _MedalService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _MedalService_GetMedalRanking_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportMedals",
+			Handler:       _MedalService_ImportMedals_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportMedals",
+			Handler:       _MedalService_ExportMedals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportMedalRanking",
+			Handler:       _MedalService_ExportMedalRanking_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "medal_service/medal.proto",
 }
 
@@ -858,19 +1489,30 @@ func (m *Medal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintMedal(dAtA, i, uint64(len(m.UpdatedAt)))
+	if m.UpdatedAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMedal(dAtA, i, uint64(n1))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x52
+	}
+	if m.CreatedAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMedal(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintMedal(dAtA, i, uint64(len(m.CreatedAt)))
+	if m.GamesId != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.GamesId))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x40
 	}
 	if len(m.AthleteId) > 0 {
 		i -= len(m.AthleteId)
@@ -928,6 +1570,18 @@ func (m *GetSingleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMedal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Id != 0 {
 		i = encodeVarintMedal(dAtA, i, uint64(m.Id))
 		i--
@@ -960,6 +1614,68 @@ func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMedal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GamesId != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EventIds) > 0 {
+		dAtA6 := make([]byte, len(m.EventIds)*10)
+		var j5 int
+		for _, num1 := range m.EventIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintMedal(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CountryIds) > 0 {
+		dAtA8 := make([]byte, len(m.CountryIds)*10)
+		var j7 int
+		for _, num1 := range m.CountryIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintMedal(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.AthleteId) > 0 {
 		i -= len(m.AthleteId)
 		copy(dAtA[i:], m.AthleteId)
@@ -1014,6 +1730,13 @@ func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Medals) > 0 {
 		for iNdEx := len(m.Medals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1097,6 +1820,38 @@ func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RankingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RankingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RankingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GamesId != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CountryMedalCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1197,82 +1952,316 @@ func (m *MedalRankingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMedal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMedal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ImportOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Medal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ImportOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMedal(uint64(m.Id))
-	}
-	if m.CountryId != 0 {
-		n += 1 + sovMedal(uint64(m.CountryId))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovMedal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EventId != 0 {
-		n += 1 + sovMedal(uint64(m.EventId))
+	if m.GamesId != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.GamesId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.AthleteId)
-	if l > 0 {
-		n += 1 + l + sovMedal(uint64(l))
+	if m.ChunkSize != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovMedal(uint64(l))
+	if m.Chunked {
+		i--
+		if m.Chunked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovMedal(uint64(l))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetSingleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMedal(uint64(m.Id))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		n += 1 + sovMedal(uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovMedal(uint64(m.Limit))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Country != 0 {
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMedal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRowError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRowError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRowError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintMedal(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Row != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMedal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Imported != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Valid != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.Valid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintMedal(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMedal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMedal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Medal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMedal(uint64(m.Id))
+	}
+	if m.CountryId != 0 {
+		n += 1 + sovMedal(uint64(m.CountryId))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.EventId != 0 {
+		n += 1 + sovMedal(uint64(m.EventId))
+	}
+	l = len(m.AthleteId)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.GamesId != 0 {
+		n += 1 + sovMedal(uint64(m.GamesId))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSingleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMedal(uint64(m.Id))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovMedal(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMedal(uint64(m.Limit))
+	}
+	if m.Country != 0 {
 		n += 1 + sovMedal(uint64(m.Country))
 	}
 	if m.EventId != 0 {
@@ -1282,6 +2271,31 @@ func (m *ListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMedal(uint64(l))
 	}
+	if len(m.CountryIds) > 0 {
+		l = 0
+		for _, e := range m.CountryIds {
+			l += sovMedal(uint64(e))
+		}
+		n += 1 + sovMedal(uint64(l)) + l
+	}
+	if len(m.EventIds) > 0 {
+		l = 0
+		for _, e := range m.EventIds {
+			l += sovMedal(uint64(e))
+		}
+		n += 1 + sovMedal(uint64(l)) + l
+	}
+	if m.GamesId != 0 {
+		n += 1 + sovMedal(uint64(m.GamesId))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1303,6 +2317,10 @@ func (m *ListResponse) Size() (n int) {
 			n += 1 + l + sovMedal(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1337,6 +2355,21 @@ func (m *Empty) Size() (n int) {
 	return n
 }
 
+func (m *RankingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GamesId != 0 {
+		n += 1 + sovMedal(uint64(m.GamesId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CountryMedalCount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1380,19 +2413,1160 @@ func (m *MedalRankingResponse) Size() (n int) {
 			n += 1 + l + sovMedal(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.Chunked {
+		n += 2
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovMedal(uint64(m.ChunkSize))
+	}
+	if m.GamesId != 0 {
+		n += 1 + sovMedal(uint64(m.GamesId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportRowError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovMedal(uint64(m.Row))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMedal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovMedal(uint64(m.Total))
+	}
+	if m.Valid != 0 {
+		n += 1 + sovMedal(uint64(m.Valid))
+	}
+	if m.Imported != 0 {
+		n += 1 + sovMedal(uint64(m.Imported))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovMedal(uint64(m.Failed))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovMedal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMedal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMedal(x uint64) (n int) {
+	return sovMedal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Medal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Medal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Medal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountryId", wireType)
+			}
+			m.CountryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountryId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AthleteId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AthleteId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
+			}
+			m.GamesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSingleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSingleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			m.Country = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Country |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AthleteId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AthleteId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMedal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CountryIds = append(m.CountryIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMedal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMedal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMedal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CountryIds) == 0 {
+					m.CountryIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMedal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CountryIds = append(m.CountryIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountryIds", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMedal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EventIds = append(m.EventIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMedal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMedal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMedal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EventIds) == 0 {
+					m.EventIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMedal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EventIds = append(m.EventIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIds", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
+			}
+			m.GamesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Medals = append(m.Medals, &Medal{})
+			if err := m.Medals[len(m.Medals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovMedal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMedal(x uint64) (n int) {
-	return sovMedal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Medal) Unmarshal(dAtA []byte) error {
+func (m *RankingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,17 +3589,17 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Medal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RankingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Medal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RankingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
 			}
-			m.Id = 0
+			m.GamesId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1435,12 +3609,63 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.GamesId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMedal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMedal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountryMedalCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountryMedalCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CountryId", wireType)
 			}
@@ -1459,9 +3684,9 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CountryName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,13 +3714,13 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.CountryName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gold", wireType)
 			}
-			m.EventId = 0
+			m.Gold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1505,16 +3730,16 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventId |= int64(b&0x7F) << shift
+				m.Gold |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AthleteId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Silver", wireType)
 			}
-			var stringLen uint64
+			m.Silver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1524,29 +3749,16 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Silver |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMedal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AthleteId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bronze", wireType)
 			}
-			var stringLen uint64
+			m.Bronze = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1556,29 +3768,16 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Bronze |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMedal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
 			}
-			var stringLen uint64
+			m.Ranking = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1588,24 +3787,11 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Ranking |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMedal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMedal(dAtA[iNdEx:])
@@ -1628,7 +3814,7 @@ func (m *Medal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
+func (m *MedalRankingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,17 +3837,17 @@ func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSingleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MedalRankingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSingleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MedalRankingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountryMedalCounts", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1671,11 +3857,26 @@ func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CountryMedalCounts = append(m.CountryMedalCounts, &CountryMedalCount{})
+			if err := m.CountryMedalCounts[len(m.CountryMedalCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMedal(dAtA[iNdEx:])
@@ -1698,7 +3899,7 @@ func (m *GetSingleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,17 +3922,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1741,16 +3942,29 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.Limit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1760,16 +3974,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunked", wireType)
 			}
-			m.Country = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1779,16 +3994,17 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Country |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Chunked = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
 			}
-			m.EventId = 0
+			m.ChunkSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1798,16 +4014,16 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventId |= int64(b&0x7F) << shift
+				m.ChunkSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AthleteId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesId", wireType)
 			}
-			var stringLen uint64
+			m.GamesId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1817,24 +4033,11 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GamesId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMedal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AthleteId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMedal(dAtA[iNdEx:])
@@ -1857,7 +4060,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1880,17 +4083,17 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1900,16 +4103,33 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMedal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &ImportOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Medals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -1919,24 +4139,24 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMedal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMedal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Medals = append(m.Medals, &Medal{})
-			if err := m.Medals[len(m.Medals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1961,7 +4181,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *ImportRowError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1984,15 +4204,34 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportRowError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportRowError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,59 +4259,40 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMedal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMedal
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMedal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMedal
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Empty) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMedal
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMedal
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMedal(dAtA[iNdEx:])
@@ -2095,7 +4315,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
+func (m *ImportReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,17 +4338,17 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CountryMedalCount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CountryMedalCount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.CountryId = 0
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -2138,16 +4358,16 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CountryId |= int64(b&0x7F) << shift
+				m.Total |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountryName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var stringLen uint64
+			m.Valid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -2157,29 +4377,16 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Valid |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMedal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CountryName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
 			}
-			m.Gold = 0
+			m.Imported = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -2189,16 +4396,16 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gold |= int32(b&0x7F) << shift
+				m.Imported |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Silver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			m.Silver = 0
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -2208,16 +4415,16 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Silver |= int32(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bronze", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.Bronze = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMedal
@@ -2227,84 +4434,15 @@ func (m *CountryMedalCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Bronze |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
-			}
-			m.Ranking = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMedal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Ranking |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMedal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMedal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MedalRankingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMedal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MedalRankingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MedalRankingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CountryMedalCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2331,8 +4469,8 @@ func (m *MedalRankingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CountryMedalCounts = append(m.CountryMedalCounts, &CountryMedalCount{})
-			if err := m.CountryMedalCounts[len(m.CountryMedalCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, &ImportRowError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,86 +1,100 @@
 package config
 
 import (
-	"log"
-	"os"
-	"strconv"
+	"net"
+	"net/url"
 	"time"
 
-	"github.com/joho/godotenv"
-
-	_ "github.com/lib/pq"
+	"olympy/pkg/configloader"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
+	Server     ServerConfig     `yaml:"server"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Log        LogConfig        `yaml:"log"`
+	Pagination PaginationConfig `yaml:"pagination"`
+	Webhook    WebhookConfig    `yaml:"webhook"`
+	TLS        TLSConfig        `yaml:"tls"`
+	Identity   IdentityConfig   `yaml:"identity"`
 }
 
 type ServerConfig struct {
-	Port string
+	Port            string        `yaml:"port" env:"SERVER_PORT" default:"5555" validate:"required,port"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"15s" validate:"positive"`
 }
 
-type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
+// Addr is the listen address of the gRPC server.
+func (s ServerConfig) Addr() string {
+	return ":" + s.Port
 }
 
-type RedisConfig struct {
-	Addr     string
-	Password string
-	DB       int
+type LogConfig struct {
+	Level       string `yaml:"level" env:"LOG_LEVEL" default:"info" validate:"oneof=debug|info|warn|error"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT" default:"production" validate:"oneof=production|development"`
+	File        string `yaml:"file" env:"LOG_FILE"`
 }
 
-type JWTConfig struct {
-	Secret          string
-	AccessTokenExp  time.Duration
-	RefreshTokenExp time.Duration
+// TLSConfig locates the certificates for mutual TLS with the other services
+// and names the services allowed to call this one.
+type TLSConfig struct {
+	CertFile       string   `yaml:"cert_file" env:"TLS_CERT_FILE" default:"certs/medal-service.pem" validate:"required"`
+	KeyFile        string   `yaml:"key_file" env:"TLS_KEY_FILE" default:"certs/medal-service-key.pem" validate:"required"`
+	CAFile         string   `yaml:"ca_file" env:"TLS_CA_FILE" default:"certs/ca.pem" validate:"required"`
+	AllowedClients []string `yaml:"allowed_clients" env:"TLS_ALLOWED_CLIENTS" default:"api-gateway,health-probe,olympyctl" validate:"required"`
 }
 
-func (c *Config) Load() error {
-	if err := godotenv.Load(); err != nil {
-		// Log a warning but don't fail if the .env file is not found
-		log.Println("Warning: .env file not found or failed to load.")
-	}
-
-	c.Server.Port = ":" + getEnv("SERVER_PORT", "4444")
-	c.Database.Host = getEnv("DB_HOST", "localhost")
-	c.Database.Port = getEnv("DB_PORT", "5544")
-	c.Database.User = getEnv("DB_USER", "postgres")
-	c.Database.Password = getEnv("DB_PASSWORD", "1234")
-	c.Database.DBName = getEnv("DB_NAME", "olympydb")
+// IdentityConfig holds the key that the gateway signs caller identities with;
+// it must match the gateway's.
+type IdentityConfig struct {
+	Secret string `yaml:"secret" env:"INTERNAL_IDENTITY_SECRET" validate:"required" secret:"true"`
+}
 
-	c.Redis.Addr = getEnv("REDIS_ADDR", "localhost:6379")
-	c.Redis.Password = getEnv("REDIS_PASSWORD", "")
-	c.Redis.DB = 0
+// PaginationConfig holds the key that list page tokens are signed with.
+type PaginationConfig struct {
+	TokenSecret string `yaml:"token_secret" env:"PAGE_TOKEN_SECRET" validate:"required" secret:"true"`
+}
 
-	ac := getEnv("JWT_ACCESS_TOKEN_EXP", "15")
-	intacc, _ := strconv.Atoi(ac)
-	re := getEnv("JWT_REFRESH_TOKEN_EXP", "10080") // Default to 7 days in minutes
-	intref, _ := strconv.Atoi(re)
-	c.JWT.Secret = getEnv("JWT_SECRET", "your_jwt_secret")
-	c.JWT.AccessTokenExp = time.Duration(intacc) * time.Minute
-	c.JWT.RefreshTokenExp = time.Duration(intref) * time.Minute
+// WebhookConfig tunes the dispatcher that sends queued webhook deliveries.
+type WebhookConfig struct {
+	Workers      int           `yaml:"workers" env:"WEBHOOK_WORKERS" default:"4" validate:"positive"`
+	PollInterval time.Duration `yaml:"poll_interval" env:"WEBHOOK_POLL_INTERVAL" default:"2s" validate:"positive"`
+	Timeout      time.Duration `yaml:"timeout" env:"WEBHOOK_TIMEOUT" default:"10s" validate:"positive"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"WEBHOOK_MAX_ATTEMPTS" default:"10" validate:"positive"`
+	MinBackoff   time.Duration `yaml:"min_backoff" env:"WEBHOOK_MIN_BACKOFF" default:"30s" validate:"positive"`
+	MaxBackoff   time.Duration `yaml:"max_backoff" env:"WEBHOOK_MAX_BACKOFF" default:"6h" validate:"positive"`
+}
 
-	return nil
+type DatabaseConfig struct {
+	Host     string `yaml:"host" env:"DB_HOST" default:"postgres" validate:"required"`
+	Port     string `yaml:"port" env:"DB_PORT" default:"5432" validate:"required,port"`
+	User     string `yaml:"user" env:"DB_USER" default:"postgres" validate:"required"`
+	Password string `yaml:"password" env:"DB_PASSWORD" default:"aaaa" validate:"required" secret:"true"`
+	DBName   string `yaml:"name" env:"DB_NAME" default:"olympydb" validate:"required"`
+	URL      string `yaml:"url" env:"PG_URL" validate:"url" secret:"true"`
 }
 
-func getEnv(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// MigrationURL returns PG_URL, or a URL built from the connection fields.
+func (d DatabaseConfig) MigrationURL() string {
+	if d.URL != "" {
+		return d.URL
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(d.User, d.Password),
+		Host:     net.JoinHostPort(d.Host, d.Port),
+		Path:     d.DBName,
+		RawQuery: "sslmode=disable",
 	}
-	return defaultValue
+	return u.String()
+}
+
+func (c *Config) Load() error {
+	return configloader.Load(c)
 }
 
 func New() (*Config, error) {
 	config := &Config{}
-	err := config.Load()
-	if err != nil {
+	if err := config.Load(); err != nil {
 		return nil, err
 	}
 	return config, nil
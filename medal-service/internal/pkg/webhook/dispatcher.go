@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Delivery statuses.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+type Options struct {
+	Workers      int           // Deliveries sent concurrently
+	PollInterval time.Duration // Wait between polls when the queue is empty
+	Timeout      time.Duration // HTTP timeout per attempt
+	MaxAttempts  int           // Attempts before a delivery is marked failed
+	MinBackoff   time.Duration // Delay after the first failed attempt
+	MaxBackoff   time.Duration
+}
+
+// Dispatcher sends queued deliveries. Several dispatchers may share the
+// queue: deliveries are claimed with SKIP LOCKED and leased until their
+// attempt is recorded, so a delivery interrupted by a crash is retried once
+// the lease runs out.
+type Dispatcher struct {
+	db     *sql.DB
+	client *http.Client
+	opts   Options
+	logger *zap.Logger
+}
+
+func NewDispatcher(db *sql.DB, opts Options, logger *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		db:     db,
+		client: &http.Client{Timeout: opts.Timeout},
+		opts:   opts,
+		logger: logger,
+	}
+}
+
+type delivery struct {
+	id        int64
+	eventType string
+	payload   []byte
+	attempts  int
+	url       string
+	secret    string
+}
+
+// Run sends due deliveries until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		n, err := d.dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.logger.Error("webhook dispatch failed", zap.Error(err))
+		}
+		if n > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.opts.PollInterval):
+		}
+	}
+}
+
+// dispatch claims up to Workers due deliveries and sends them, returning
+// how many were claimed.
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {
+	lease := d.opts.Timeout + time.Minute
+	rows, err := d.db.QueryContext(ctx, `
+		UPDATE webhook_deliveries d
+		SET attempts = d.attempts + 1,
+			next_attempt_at = NOW() + $2::bigint * INTERVAL '1 millisecond',
+			updated_at = NOW()
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT wd.id FROM webhook_deliveries wd
+			JOIN webhooks ww ON ww.id = wd.webhook_id
+			WHERE wd.status = 'pending' AND wd.next_attempt_at <= NOW() AND NOT ww.disabled
+			ORDER BY wd.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF wd SKIP LOCKED
+		)
+		RETURNING d.id, d.event_type, d.payload, d.attempts, w.url, w.secret`,
+		d.opts.Workers, lease.Milliseconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim deliveries: %v", err)
+	}
+
+	var batch []delivery
+	for rows.Next() {
+		var dl delivery
+		if err := rows.Scan(&dl.id, &dl.eventType, &dl.payload, &dl.attempts, &dl.url, &dl.secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan delivery: %v", err)
+		}
+		batch = append(batch, dl)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	// Claimed deliveries are finished even during shutdown; the HTTP timeout
+	// bounds how long that takes.
+	sendCtx := context.WithoutCancel(ctx)
+	var wg sync.WaitGroup
+	for _, dl := range batch {
+		wg.Add(1)
+		go func(dl delivery) {
+			defer wg.Done()
+			d.deliver(sendCtx, dl)
+		}(dl)
+	}
+	wg.Wait()
+	return len(batch), nil
+}
+
+// deliver makes one attempt and records its outcome.
+func (d *Dispatcher) deliver(ctx context.Context, dl delivery) {
+	start := time.Now()
+	statusCode, sendErr := d.send(ctx, dl)
+	duration := time.Since(start)
+
+	status, errText := StatusSucceeded, ""
+	var retryIn time.Duration
+	if sendErr != nil {
+		errText = sendErr.Error()
+		status = StatusFailed
+		if dl.attempts < d.opts.MaxAttempts {
+			status = StatusPending
+			retryIn = d.backoff(dl.attempts)
+		}
+	}
+
+	logger := d.logger.With(
+		zap.Int64("delivery_id", dl.id),
+		zap.String("event_type", dl.eventType),
+		zap.Int("attempt", dl.attempts),
+		zap.Int("status_code", statusCode),
+		zap.Duration("duration", duration),
+	)
+	switch {
+	case sendErr == nil:
+		logger.Info("webhook delivered")
+	case status == StatusPending:
+		logger.Warn("webhook delivery failed, will retry", zap.Error(sendErr), zap.Duration("retry_in", retryIn))
+	default:
+		logger.Error("webhook delivery failed permanently", zap.Error(sendErr))
+	}
+
+	if err := d.record(ctx, dl, status, statusCode, errText, duration, retryIn); err != nil {
+		logger.Error("failed to record webhook attempt", zap.Error(err))
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, dl delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dl.url, bytes.NewReader(dl.payload))
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "olympy-webhooks/1.0")
+	req.Header.Set(EventHeader, dl.eventType)
+	req.Header.Set(DeliveryHeader, fmt.Sprint(dl.id))
+	req.Header.Set(TimestampHeader, fmt.Sprint(now.Unix()))
+	req.Header.Set(SignatureHeader, Sign(dl.secret, now, dl.payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// record adds the attempt to the delivery log and updates the delivery.
+func (d *Dispatcher) record(ctx context.Context, dl delivery, status string, statusCode int, errText string, duration, retryIn time.Duration) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms)
+		VALUES ($1, (SELECT COUNT(*) + 1 FROM webhook_delivery_attempts WHERE delivery_id = $1), $2, $3, $4)`,
+		dl.id, statusCode, errText, duration.Milliseconds())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, last_status_code = $3, last_error = $4,
+			next_attempt_at = NOW() + $5::bigint * INTERVAL '1 millisecond', updated_at = NOW()
+		WHERE id = $1`,
+		dl.id, status, statusCode, errText, retryIn.Milliseconds())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// backoff doubles the delay with every failed attempt, up to MaxBackoff,
+// and randomises the second half of it so that retries of many deliveries
+// to one endpoint are spread out.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.opts.MinBackoff
+	for i := 1; i < attempt && delay < d.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.opts.MaxBackoff {
+		delay = d.opts.MaxBackoff
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,114 @@
+// Package webhook queues and delivers notifications to partner endpoints.
+//
+// Changes are queued with Enqueue in the transaction that makes them; the
+// webhook_deliveries table is the queue. A Dispatcher then posts each
+// delivery, signed with the webhook's secret, and retries failures with
+// exponential backoff.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event types partners can subscribe to.
+const (
+	MedalCreated       = "medal.created"
+	MedalUpdated       = "medal.updated"
+	MedalDeleted       = "medal.deleted"
+	EventUpdated       = "event.updated"
+	EventRescheduled   = "event.rescheduled"
+	EventStatusChanged = "event.status_changed"
+)
+
+var EventTypes = []string{MedalCreated, MedalUpdated, MedalDeleted, EventUpdated, EventRescheduled, EventStatusChanged}
+
+func IsEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Headers set on every delivery.
+const (
+	SignatureHeader = "X-Olympy-Signature"
+	TimestampHeader = "X-Olympy-Timestamp"
+	EventHeader     = "X-Olympy-Event"
+	DeliveryHeader  = "X-Olympy-Delivery"
+)
+
+// Event is the JSON body of a delivery.
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// Execer is satisfied by *sql.DB and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// Enqueue queues an event for every enabled webhook subscribed to
+// eventType. Pass the transaction of the change so that partners are only
+// notified of committed changes.
+func Enqueue(ctx context.Context, db Execer, eventType string, data interface{}) error {
+	id, err := NewSecret(16)
+	if err != nil {
+		return err
+	}
+	eventID := "evt_" + id
+	payload, err := json.Marshal(Event{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook event: %v", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+		SELECT id, $1, $2::text, $3
+		FROM webhooks
+		WHERE NOT disabled AND (cardinality(event_types) = 0 OR $2::text = ANY(event_types))`,
+		eventID, eventType, string(payload))
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook event: %v", err)
+	}
+	return nil
+}
+
+// Sign returns the signature header value for body sent at timestamp:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">".
+// Receivers should recompute it and reject old timestamps to prevent
+// replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := fmt.Sprint(timestamp.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// NewSecret returns n random bytes, hex encoded.
+func NewSecret(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	at := time.Date(2024, 7, 26, 8, 0, 0, 0, time.UTC)
+	body := []byte(`{"type":"medal.awarded"}`)
+
+	tests := []struct {
+		name   string
+		secret string
+		at     time.Time
+		body   []byte
+		want   string
+	}{
+		{"known signature", "whsec", at, body, "t=1721980800,v1=eb1abb163752659b6559f575e2d871d7de7da4748a98364e9694a9e07b5aa9bd"},
+		{"timestamp is in UTC seconds", "whsec", at.In(time.FixedZone("CEST", 2*60*60)).Add(500 * time.Millisecond), body, "t=1721980800,v1=eb1abb163752659b6559f575e2d871d7de7da4748a98364e9694a9e07b5aa9bd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.at, tt.body); got != tt.want {
+				t.Errorf("Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Each part of the signed message must change the signature.
+	_, want, _ := strings.Cut(Sign("whsec", at, body), ",v1=")
+	for name, got := range map[string]string{
+		"secret":    Sign("other", at, body),
+		"timestamp": Sign("whsec", at.Add(time.Second), body),
+		"body":      Sign("whsec", at, []byte(`{"type":"medal.revoked"}`)),
+	} {
+		if _, mac, _ := strings.Cut(got, ",v1="); mac == want {
+			t.Errorf("changing the %s kept the signature %q", name, got)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := &Dispatcher{opts: Options{MinBackoff: 10 * time.Second, MaxBackoff: 5 * time.Minute}}
+
+	// Each delay is randomised between half the scheduled one and all of it.
+	tests := []struct {
+		attempt int
+		delay   time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{4, 80 * time.Second},
+		{5, 160 * time.Second},
+		{6, 5 * time.Minute},
+		{7, 5 * time.Minute},
+		{50, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if got := d.backoff(tt.attempt); got < tt.delay/2 || got > tt.delay {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.delay/2, tt.delay)
+			}
+		}
+	}
+}
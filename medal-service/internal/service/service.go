@@ -2,11 +2,21 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"net/url"
 	countryservice "olympy/medal-service/genproto/country_service"
 	modelservice "olympy/medal-service/genproto/medal_service"
+	webhookservice "olympy/medal-service/genproto/webhook_service"
+	"olympy/medal-service/internal/pkg/webhook"
 	"olympy/medal-service/internal/storage"
-	"os"
+	"olympy/pkg/importer"
+	"olympy/pkg/pagetoken"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type (
@@ -20,19 +30,31 @@ type (
 		countryStorage storage.Country
 		logger         *log.Logger
 	}
+	WebhookService struct {
+		webhookservice.UnimplementedWebhookServiceServer
+		webhookStorage storage.Webhook
+		logger         *log.Logger
+	}
 )
 
 func NewMedalService(modelservice storage.Medal) *MedalService {
 	return &MedalService{
 		medalStorage: modelservice,
-		logger:       log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile),
+		logger:       log.Default(),
 	}
 }
 
 func NewCountryService(countryservice storage.Country) *CountryService {
 	return &CountryService{
 		countryStorage: countryservice,
-		logger:         log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile),
+		logger:         log.Default(),
+	}
+}
+
+func NewWebhookService(webhookservice storage.Webhook) *WebhookService {
+	return &WebhookService{
+		webhookStorage: webhookservice,
+		logger:         log.Default(),
 	}
 }
 
@@ -53,19 +75,79 @@ func (s *MedalService) DeleteMedal(ctx context.Context, req *modelservice.GetSin
 
 func (s *MedalService) GetMedal(ctx context.Context, req *modelservice.GetSingleRequest) (*modelservice.Medal, error) {
 	s.logger.Println("Get Medal Request")
-	return s.medalStorage.GetMedal(ctx, req)
+	return readResult(s.medalStorage.GetMedal(ctx, req))
 }
 
 func (s *MedalService) ListMedals(ctx context.Context, req *modelservice.ListRequest) (*modelservice.ListResponse, error) {
 	s.logger.Println("List Medals Request")
-	return s.medalStorage.ListMedals(ctx, req)
+	return readResult(s.medalStorage.ListMedals(ctx, req))
 }
 
-func (s *MedalService) GetMedalRanking(ctx context.Context, req *modelservice.Empty) (*modelservice.MedalRankingResponse, error) {
+func (s *MedalService) GetMedalRanking(ctx context.Context, req *modelservice.RankingRequest) (*modelservice.MedalRankingResponse, error) {
 	s.logger.Println("Get Medal Ranking Request")
 	return s.medalStorage.GetMedalRanking(ctx, req)
 }
 
+func (s *MedalService) ExportMedals(req *modelservice.ListRequest, stream modelservice.MedalService_ExportMedalsServer) error {
+	s.logger.Println("Export Medals Request")
+	return s.medalStorage.ExportMedals(stream.Context(), req, stream.Send)
+}
+
+func (s *MedalService) ExportMedalRanking(req *modelservice.RankingRequest, stream modelservice.MedalService_ExportMedalRankingServer) error {
+	s.logger.Println("Export Medal Ranking Request")
+	return s.medalStorage.ExportMedalRanking(stream.Context(), req, stream.Send)
+}
+
+func (s *MedalService) ImportMedals(stream modelservice.MedalService_ImportMedalsServer) error {
+	s.logger.Println("Import Medals Request")
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "import is empty")
+	}
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	opts := importer.Options{
+		Format:    options.GetFormat(),
+		DryRun:    options.GetDryRun(),
+		Chunked:   options.GetChunked(),
+		ChunkSize: int(options.GetChunkSize()),
+	}
+	src := importer.StreamReader(first.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	result, err := s.medalStorage.ImportMedals(stream.Context(), opts, options.GetGamesId(), src)
+	if errors.Is(err, importer.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	report := &modelservice.ImportReport{
+		Total:    int32(result.Total),
+		Valid:    int32(result.Valid),
+		Imported: int32(result.Imported),
+		Failed:   int32(result.Failed),
+		DryRun:   opts.DryRun,
+	}
+	for _, e := range result.Errors {
+		report.Errors = append(report.Errors, &modelservice.ImportRowError{
+			Row:     int32(e.Row),
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return stream.SendAndClose(report)
+}
+
 // country service
 func (s *CountryService) AddCountry(ctx context.Context, req *countryservice.Country) (*countryservice.Country, error) {
 	s.logger.Println("Add Country Request")
@@ -84,10 +166,136 @@ func (s *CountryService) DeleteCountry(ctx context.Context, req *countryservice.
 
 func (s *CountryService) GetCountry(ctx context.Context, req *countryservice.GetSingleRequest) (*countryservice.Country, error) {
 	s.logger.Println("Get Country Request")
-	return s.countryStorage.GetCountry(ctx, req)
+	return readResult(s.countryStorage.GetCountry(ctx, req))
 }
 
 func (s *CountryService) ListCountries(ctx context.Context, req *countryservice.ListRequest) (*countryservice.ListResponse, error) {
 	s.logger.Println("List Countries Request")
-	return s.countryStorage.ListCountries(ctx, req)
+	return readResult(s.countryStorage.ListCountries(ctx, req))
+}
+
+func (s *CountryService) ImportCountries(stream countryservice.CountryService_ImportCountriesServer) error {
+	s.logger.Println("Import Countries Request")
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "import is empty")
+	}
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	opts := importer.Options{
+		Format:    options.GetFormat(),
+		DryRun:    options.GetDryRun(),
+		Chunked:   options.GetChunked(),
+		ChunkSize: int(options.GetChunkSize()),
+	}
+	src := importer.StreamReader(first.Data, func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	result, err := s.countryStorage.ImportCountries(stream.Context(), opts, src)
+	if errors.Is(err, importer.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	report := &countryservice.ImportReport{
+		Total:    int32(result.Total),
+		Valid:    int32(result.Valid),
+		Imported: int32(result.Imported),
+		Failed:   int32(result.Failed),
+		DryRun:   opts.DryRun,
+	}
+	for _, e := range result.Errors {
+		report.Errors = append(report.Errors, &countryservice.ImportRowError{
+			Row:     int32(e.Row),
+			Field:   e.Field,
+			Message: e.Message,
+		})
+	}
+	return stream.SendAndClose(report)
+}
+
+// webhook service
+func (s *WebhookService) AddWebhook(ctx context.Context, req *webhookservice.Webhook) (*webhookservice.Webhook, error) {
+	s.logger.Println("Add Webhook Request")
+	if err := validateWebhook(req); err != nil {
+		return nil, err
+	}
+	if req.Secret == "" {
+		secret, err := webhook.NewSecret(32)
+		if err != nil {
+			return nil, err
+		}
+		req.Secret = "whsec_" + secret
+	}
+	return s.webhookStorage.AddWebhook(ctx, req)
+}
+
+func (s *WebhookService) EditWebhook(ctx context.Context, req *webhookservice.Webhook) (*webhookservice.Webhook, error) {
+	s.logger.Println("Edit Webhook Request")
+	if err := validateWebhook(req); err != nil {
+		return nil, err
+	}
+	return s.webhookStorage.EditWebhook(ctx, req)
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *webhookservice.GetSingleRequest) (*webhookservice.Message, error) {
+	s.logger.Println("Delete Webhook Request")
+	return s.webhookStorage.DeleteWebhook(ctx, req)
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, req *webhookservice.GetSingleRequest) (*webhookservice.Webhook, error) {
+	s.logger.Println("Get Webhook Request")
+	return s.webhookStorage.GetWebhook(ctx, req)
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *webhookservice.ListRequest) (*webhookservice.ListResponse, error) {
+	s.logger.Println("List Webhooks Request")
+	return readResult(s.webhookStorage.ListWebhooks(ctx, req))
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, req *webhookservice.ListDeliveriesRequest) (*webhookservice.ListDeliveriesResponse, error) {
+	s.logger.Println("List Webhook Deliveries Request")
+	return readResult(s.webhookStorage.ListDeliveries(ctx, req))
+}
+
+func (s *WebhookService) GetDelivery(ctx context.Context, req *webhookservice.GetSingleRequest) (*webhookservice.Delivery, error) {
+	s.logger.Println("Get Webhook Delivery Request")
+	return s.webhookStorage.GetDelivery(ctx, req)
+}
+
+func (s *WebhookService) Redeliver(ctx context.Context, req *webhookservice.GetSingleRequest) (*webhookservice.Delivery, error) {
+	s.logger.Println("Redeliver Webhook Request")
+	return s.webhookStorage.Redeliver(ctx, req)
+}
+
+func validateWebhook(req *webhookservice.Webhook) error {
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Error(codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+	for _, t := range req.EventTypes {
+		if !webhook.IsEventType(t) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("unknown event type %q, expected one of %v", t, webhook.EventTypes))
+		}
+	}
+	return nil
+}
+
+// readResult reports field masks naming unknown fields and page tokens that
+// fail verification as invalid arguments.
+func readResult[T any](resp T, err error) (T, error) {
+	if errors.Is(err, storage.ErrUnknownField) || errors.Is(err, pagetoken.ErrInvalid) {
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, err
 }
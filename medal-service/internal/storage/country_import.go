@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	countryproto "olympy/medal-service/genproto/country_service"
+	"olympy/pkg/importer"
+)
+
+// ImportCountries validates the countries read from src and inserts the
+// valid ones. Rows have name, flag and an optional noc_code column.
+func (c *Country) ImportCountries(ctx context.Context, opts importer.Options, src io.Reader) (*importer.Result, error) {
+	existing, err := importer.LoadCountries(ctx, c.db)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]int)
+	codes := make(map[string]int)
+
+	parse := func(row importer.Row) (*countryproto.Country, []importer.RowError) {
+		var problems importer.Problems
+		country := &countryproto.Country{
+			Name:    problems.Text("name", row.Get("name"), 50),
+			Flag:    problems.Text("flag", row.Get("flag"), 255),
+			NocCode: strings.ToUpper(row.Get("noc_code", "noc")),
+		}
+
+		if country.Name != "" {
+			if existing.HasName(country.Name) {
+				problems.Add("name", "country %q already exists", country.Name)
+			} else if line, ok := names[strings.ToLower(country.Name)]; ok {
+				problems.Add("name", "duplicates row %d", line)
+			} else {
+				names[strings.ToLower(country.Name)] = row.Line
+			}
+		}
+		if code := country.NocCode; code != "" {
+			if !isNOCCode(code) {
+				problems.Add("noc_code", "must be three letters, got %q", code)
+			} else if existing.HasNOC(code) {
+				problems.Add("noc_code", "NOC code %q is already used", code)
+			} else if line, ok := codes[code]; ok {
+				problems.Add("noc_code", "duplicates row %d", line)
+			} else {
+				codes[code] = row.Line
+			}
+		}
+		return country, problems
+	}
+
+	return importer.Run(ctx, c.db, opts, src, parse, c.insertCountry)
+}
+
+func isNOCCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Country) insertCountry(ctx context.Context, tx *sql.Tx, country *countryproto.Country) error {
+	_, err := c.queryBuilder.Insert("countries").
+		SetMap(map[string]interface{}{
+			"name":       country.Name,
+			"flag":       country.Flag,
+			"noc_code":   nocCode(country.NocCode),
+			"created_at": time.Now(),
+			"updated_at": time.Now(),
+		}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert country: %v", err)
+	}
+	return nil
+}
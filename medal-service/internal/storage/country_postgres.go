@@ -5,16 +5,21 @@ import (
 	"database/sql"
 	"fmt"
 	"olympy/medal-service/internal/config"
+	"olympy/medal-service/internal/pkg/locale"
+	"olympy/pkg/pagetoken"
+	"strings"
 	"time"
 
 	countryproto "olympy/medal-service/genproto/country_service"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
 type Country struct {
 	db           *sql.DB
 	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
 }
 
 func NewCountryService(config *config.Config) (*Country, error) {
@@ -26,13 +31,39 @@ func NewCountryService(config *config.Config) (*Country, error) {
 	return &Country{
 		db:           db,
 		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
 	}, nil
 }
 
+func (c *Country) Close() error {
+	return c.db.Close()
+}
+
+// nocCode stores missing NOC codes as NULL, so that the unique constraint
+// only applies to countries that have one.
+func nocCode(code string) interface{} {
+	if code == "" {
+		return nil
+	}
+	return strings.ToUpper(code)
+}
+
+// countryColumns are the fields a country read may select. Countries without
+// a NOC code report it as empty.
+var countryColumns = columns[countryproto.Country]{
+	{"id", "id", func(c *countryproto.Country) interface{} { return &c.Id }},
+	{"name", "name", func(c *countryproto.Country) interface{} { return &c.Name }},
+	{"flag", "flag", func(c *countryproto.Country) interface{} { return &c.Flag }},
+	{"noc_code", "COALESCE(noc_code, '')", func(c *countryproto.Country) interface{} { return &c.NocCode }},
+	{"created_at", "created_at", func(c *countryproto.Country) interface{} { return &c.CreatedAt }},
+	{"updated_at", "updated_at", func(c *countryproto.Country) interface{} { return &c.UpdatedAt }},
+}
+
 func (c *Country) AddCountry(ctx context.Context, req *countryproto.Country) (*countryproto.Country, error) {
 	data := map[string]interface{}{
 		"name":       req.Name,
 		"flag":       req.Flag,
+		"noc_code":   nocCode(req.NocCode),
 		"created_at": time.Now(),
 		"updated_at": time.Now(),
 	}
@@ -55,8 +86,9 @@ func (c *Country) AddCountry(ctx context.Context, req *countryproto.Country) (*c
 		Id:        id,
 		Name:      req.Name,
 		Flag:      req.Flag,
-		CreatedAt: data["created_at"].(time.Time).String(),
-		UpdatedAt: data["updated_at"].(time.Time).String(),
+		NocCode:   strings.ToUpper(req.NocCode),
+		CreatedAt: data["created_at"].(time.Time).UTC().Format(time.RFC3339Nano),
+		UpdatedAt: data["updated_at"].(time.Time).UTC().Format(time.RFC3339Nano),
 	}, nil
 }
 
@@ -64,6 +96,7 @@ func (c *Country) EditCountry(ctx context.Context, req *countryproto.Country) (*
 	data := map[string]interface{}{
 		"name":       req.Name,
 		"flag":       req.Flag,
+		"noc_code":   nocCode(req.NocCode),
 		"updated_at": time.Now(),
 	}
 
@@ -79,14 +112,12 @@ func (c *Country) EditCountry(ctx context.Context, req *countryproto.Country) (*
 		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
 	}
 
-	var updatedCountry countryproto.Country
-	err = c.db.QueryRowContext(ctx, "SELECT id, name, flag, created_at, updated_at FROM countries WHERE id = $1", req.Id).
-		Scan(&updatedCountry.Id, &updatedCountry.Name, &updatedCountry.Flag, &updatedCountry.CreatedAt, &updatedCountry.UpdatedAt)
+	updatedCountry, err := countryColumns.scan(c.db.QueryRowContext(ctx, "SELECT "+countryColumns.sql()+" FROM countries WHERE id = $1", req.Id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch updated country: %v", err)
 	}
 
-	return &updatedCountry, nil
+	return updatedCountry, nil
 }
 
 func (c *Country) DeleteCountry(ctx context.Context, req *countryproto.GetSingleRequest) (*countryproto.Message, error) {
@@ -115,24 +146,53 @@ func (c *Country) DeleteCountry(ctx context.Context, req *countryproto.GetSingle
 }
 
 func (c *Country) GetCountry(ctx context.Context, req *countryproto.GetSingleRequest) (*countryproto.Country, error) {
-	var country countryproto.Country
-	err := c.db.QueryRowContext(ctx, "SELECT id, name, flag, created_at, updated_at FROM countries WHERE id = $1", req.Id).
-		Scan(&country.Id, &country.Name, &country.Flag, &country.CreatedAt, &country.UpdatedAt)
+	selected, err := countryColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	country, err := selected.scan(c.db.QueryRowContext(ctx, "SELECT "+selected.sql()+" FROM countries WHERE id = $1", req.Id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch country: %v", err)
 	}
 
-	return &country, nil
+	if err := localizeCountries(ctx, c.db, country); err != nil {
+		return nil, err
+	}
+	return country, nil
 }
 
 func (c *Country) ListCountries(ctx context.Context, req *countryproto.ListRequest) (*countryproto.ListResponse, error) {
 	var countries []*countryproto.Country
 	var total int64
 
-	query := squirrel.Select("id", "name", "flag", "created_at", "updated_at").
+	selected, err := countryColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	query := squirrel.Select(selected.exprs()...).
 		From("countries").
-		Limit(uint64(req.Limit)).
-		Offset(uint64((req.Page - 1) * req.Limit))
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	// Batch lookups by id are not paginated.
+	if len(req.Ids) == 0 || req.Limit > 0 {
+		if req.PageToken != "" {
+			var after int64
+			if err := c.pageTokens.Decode(req.PageToken, countryPages(req), &after); err != nil {
+				return nil, err
+			}
+			query = query.Where(squirrel.Gt{"id": after})
+		} else {
+			query = query.Offset(pageOffset(req.Page, req.Limit))
+		}
+		query = query.Limit(pageLimit(req.Limit))
+	}
+
+	if len(req.Ids) > 0 {
+		query = query.Where(squirrel.Eq{"id": req.Ids})
+	}
 
 	rows, err := query.RunWith(c.db).QueryContext(ctx)
 	if err != nil {
@@ -141,12 +201,20 @@ func (c *Country) ListCountries(ctx context.Context, req *countryproto.ListReque
 	defer rows.Close()
 
 	for rows.Next() {
-		var country countryproto.Country
-		err := rows.Scan(&country.Id, &country.Name, &country.Flag, &country.CreatedAt, &country.UpdatedAt)
+		country, err := selected.scan(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan country row: %v", err)
 		}
-		countries = append(countries, &country)
+		countries = append(countries, country)
+	}
+
+	var nextPageToken string
+	countries, more := trimPage(countries, req.Limit)
+	if more {
+		nextPageToken, err = c.pageTokens.Encode(countryPages(req), countries[len(countries)-1].Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
 	}
 
 	err = c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM countries").Scan(&total)
@@ -154,8 +222,66 @@ func (c *Country) ListCountries(ctx context.Context, req *countryproto.ListReque
 		return nil, fmt.Errorf("failed to get total number of countries: %v", err)
 	}
 
+	if err := localizeCountries(ctx, c.db, countries...); err != nil {
+		return nil, err
+	}
+
 	return &countryproto.ListResponse{
-		Count:     total,
-		Countries: countries,
+		Count:         total,
+		Countries:     countries,
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// countryPages identifies the listing a country page token was issued for.
+func countryPages(req *countryproto.ListRequest) string {
+	return fmt.Sprintf("countries/%v", req.Ids)
+}
+
+// countryNames returns, for each of ids that has a translation into one of
+// the caller's locales, the name in the earliest such locale.
+func countryNames(ctx context.Context, db *sql.DB, ids []int64) (map[int64]string, error) {
+	names := make(map[int64]string)
+	locales := locale.FromContext(ctx)
+	if len(ids) == 0 || len(locales) == 0 {
+		return names, nil
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT DISTINCT ON (country_id) country_id, name
+		FROM country_translations
+		WHERE country_id = ANY($1) AND locale = ANY($2::text[])
+		ORDER BY country_id, array_position($2::text[], locale::text)`, pq.Array(ids), pq.Array(locales))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch country translations: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan translation row: %v", err)
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
+
+// localizeCountries replaces the names of countries with their translations
+// into the caller's locales. Names left out of a field mask stay empty.
+func localizeCountries(ctx context.Context, db *sql.DB, countries ...*countryproto.Country) error {
+	ids := make([]int64, len(countries))
+	for i, country := range countries {
+		ids[i] = country.Id
+	}
+	names, err := countryNames(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	for _, country := range countries {
+		if name, ok := names[country.Id]; ok && country.Name != "" {
+			country.Name = name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package storage
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain fills in the secrets the services require, which the suites do
+// not depend on, unless the environment sets them.
+func TestMain(m *testing.M) {
+	for key, value := range map[string]string{
+		"PAGE_TOKEN_SECRET":        "test-page-tokens",
+		"INTERNAL_IDENTITY_SECRET": "test-identity",
+	} {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, value)
+		}
+	}
+	os.Exit(m.Run())
+}
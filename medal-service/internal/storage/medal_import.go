@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	medalproto "olympy/medal-service/genproto/medal_service"
+	"olympy/pkg/importer"
+)
+
+var medalTypes = map[string]string{"gold": "Gold", "silver": "Silver", "bronze": "Bronze"}
+
+// ImportMedals validates the medals read from src and inserts the valid
+// ones. Rows have country, type, event_id and athlete_id columns; country may
+// be the country's id, name or NOC code. Medals belong to the edition
+// gamesID, or to their event's when it is 0.
+func (m *Medal) ImportMedals(ctx context.Context, opts importer.Options, gamesID int64, src io.Reader) (*importer.Result, error) {
+	countries, err := importer.LoadCountries(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	events, err := importer.LoadIDs(ctx, m.db, "events")
+	if err != nil {
+		return nil, err
+	}
+	athletes, err := importer.LoadIDs(ctx, m.db, "athletes")
+	if err != nil {
+		return nil, err
+	}
+
+	parse := func(row importer.Row) (*medalproto.Medal, []importer.RowError) {
+		var problems importer.Problems
+		medal := &medalproto.Medal{GamesId: gamesID}
+
+		if ref := row.Get("country", "country_id", "noc_code", "noc"); ref == "" {
+			problems.Add("country", "is required")
+		} else if id, ok := countries.Resolve(ref); ok {
+			medal.CountryId = id
+		} else {
+			problems.Add("country", "unknown country %q", ref)
+		}
+
+		if value := row.Get("type", "medal"); value == "" {
+			problems.Add("type", "is required")
+		} else if t, ok := medalTypes[strings.ToLower(value)]; ok {
+			medal.Type = t
+		} else {
+			problems.Add("type", "must be Gold, Silver or Bronze, got %q", value)
+		}
+
+		if id := problems.ID("event_id", row.Get("event_id", "event")); id > 0 {
+			if !events[id] {
+				problems.Add("event_id", "event %d does not exist", id)
+			}
+			medal.EventId = id
+		}
+		if id := problems.ID("athlete_id", row.Get("athlete_id", "athlete")); id > 0 {
+			if !athletes[id] {
+				problems.Add("athlete_id", "athlete %d does not exist", id)
+			}
+			medal.AthleteId = strconv.FormatInt(id, 10)
+		}
+		return medal, problems
+	}
+
+	return importer.Run(ctx, m.db, opts, src, parse, m.insertMedal)
+}
+
+func (m *Medal) insertMedal(ctx context.Context, tx *sql.Tx, medal *medalproto.Medal) error {
+	_, err := m.queryBuilder.Insert("medals").
+		SetMap(map[string]interface{}{
+			"country_id": medal.CountryId,
+			"type":       medal.Type,
+			"event_id":   medal.EventId,
+			"athlete_id": medal.AthleteId,
+			"games_id":   medalGamesID(medal.GamesId, medal.EventId),
+			"created_at": time.Now(),
+			"updated_at": time.Now(),
+		}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert medal: %v", err)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 	"olympy/medal-service/internal/config"
+	"olympy/medal-service/internal/pkg/webhook"
+	"olympy/pkg/pagetoken"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -15,6 +17,7 @@ import (
 type Medal struct {
 	db           *sql.DB
 	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
 }
 
 func NewMedalService(config *config.Config) (*Medal, error) {
@@ -26,42 +29,81 @@ func NewMedalService(config *config.Config) (*Medal, error) {
 	return &Medal{
 		db:           db,
 		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
 	}, nil
 }
 
+func (m *Medal) Ping(ctx context.Context) error {
+	return m.db.PingContext(ctx)
+}
+
+func (m *Medal) Close() error {
+	return m.db.Close()
+}
+
+// medalColumns are the fields a medal read may select. Medals created
+// before editions existed may have no games_id, which is reported as 0.
+var medalColumns = columns[medalproto.Medal]{
+	{"id", "id", func(m *medalproto.Medal) interface{} { return &m.Id }},
+	{"country_id", "country_id", func(m *medalproto.Medal) interface{} { return &m.CountryId }},
+	{"type", "type", func(m *medalproto.Medal) interface{} { return &m.Type }},
+	{"event_id", "event_id", func(m *medalproto.Medal) interface{} { return &m.EventId }},
+	{"athlete_id", "athlete_id", func(m *medalproto.Medal) interface{} { return &m.AthleteId }},
+	{"games_id", "COALESCE(games_id, 0)", func(m *medalproto.Medal) interface{} { return &m.GamesId }},
+	{"created_at", "created_at", func(m *medalproto.Medal) interface{} { return &m.CreatedAt }},
+	{"updated_at", "updated_at", func(m *medalproto.Medal) interface{} { return &m.UpdatedAt }},
+}
+
+func scanMedal(row squirrel.RowScanner) (*medalproto.Medal, error) {
+	return medalColumns.scan(row)
+}
+
+// medalGamesID stores gamesID, or the edition of the medal's event when it
+// is unset.
+func medalGamesID(gamesID, eventID int64) squirrel.Sqlizer {
+	if gamesID != 0 {
+		return squirrel.Expr("?", gamesID)
+	}
+	return squirrel.Expr("(SELECT games_id FROM events WHERE id = ?)", eventID)
+}
+
 func (m *Medal) AddMedal(ctx context.Context, req *medalproto.Medal) (*medalproto.Medal, error) {
 	data := map[string]interface{}{
 		"country_id": req.CountryId,
 		"type":       req.Type,
 		"event_id":   req.EventId,
 		"athlete_id": req.AthleteId,
+		"games_id":   medalGamesID(req.GamesId, req.EventId),
 		"created_at": time.Now(),
 		"updated_at": time.Now(),
 	}
 
 	query, args, err := m.queryBuilder.Insert("medals").
 		SetMap(data).
-		Suffix("RETURNING id").
+		Suffix("RETURNING " + medalColumns.sql()).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
 	}
 
-	var id int64
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
 
-	if err := m.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
-		return nil, fmt.Errorf("failed to fetch inserted ID: %v", err)
+	medal, err := scanMedal(tx.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch inserted medal: %v", err)
+	}
+	if err := webhook.Enqueue(ctx, tx, webhook.MedalCreated, medal); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	return &medalproto.Medal{
-		Id:        id,
-		CountryId: req.CountryId,
-		Type:      req.Type,
-		EventId:   req.EventId,
-		AthleteId: req.AthleteId,
-		CreatedAt: data["created_at"].(time.Time).String(),
-		UpdatedAt: data["updated_at"].(time.Time).String(),
-	}, nil
+	return medal, nil
 }
 
 func (m *Medal) EditMedal(ctx context.Context, req *medalproto.Medal) (*medalproto.Medal, error) {
@@ -70,6 +112,7 @@ func (m *Medal) EditMedal(ctx context.Context, req *medalproto.Medal) (*medalpro
 		"type":       req.Type,
 		"event_id":   req.EventId,
 		"athlete_id": req.AthleteId,
+		"games_id":   medalGamesID(req.GamesId, req.EventId),
 		"updated_at": time.Now(),
 	}
 
@@ -81,65 +124,80 @@ func (m *Medal) EditMedal(ctx context.Context, req *medalproto.Medal) (*medalpro
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
 	}
 
-	if _, err := m.db.ExecContext(ctx, query, args...); err != nil {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
 	}
 
-	var updatedMedal medalproto.Medal
-	err = m.db.QueryRowContext(ctx, "SELECT id, country_id, type, event_id, athlete_id, created_at, updated_at FROM medals WHERE id = $1", req.Id).
-		Scan(&updatedMedal.Id, &updatedMedal.CountryId, &updatedMedal.Type, &updatedMedal.EventId, &updatedMedal.AthleteId, &updatedMedal.CreatedAt, &updatedMedal.UpdatedAt)
+	updatedMedal, err := scanMedal(tx.QueryRowContext(ctx, "SELECT "+medalColumns.sql()+" FROM medals WHERE id = $1", req.Id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch updated medal: %v", err)
 	}
 
-	return &updatedMedal, nil
+	if err := webhook.Enqueue(ctx, tx, webhook.MedalUpdated, updatedMedal); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	return updatedMedal, nil
 }
 
 func (m *Medal) DeleteMedal(ctx context.Context, req *medalproto.GetSingleRequest) (*medalproto.Message, error) {
 	query, args, err := m.queryBuilder.Delete("medals").
 		Where(squirrel.Eq{"id": req.Id}).
+		Suffix("RETURNING " + medalColumns.sql()).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL query: %v", err)
 	}
 
-	result, err := m.db.ExecContext(ctx, query, args...)
+	tx, err := m.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	deletedMedal, err := scanMedal(tx.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("medal with ID %d not found", req.Id)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
 	}
 
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("medal with ID %d not found", req.Id)
+	if err := webhook.Enqueue(ctx, tx, webhook.MedalDeleted, deletedMedal); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
 	return &medalproto.Message{Message: fmt.Sprintf("Medal with ID %d deleted successfully", req.Id)}, nil
 }
 
 func (m *Medal) GetMedal(ctx context.Context, req *medalproto.GetSingleRequest) (*medalproto.Medal, error) {
-	var medal medalproto.Medal
-	err := m.db.QueryRowContext(ctx, "SELECT id, country_id, type, event_id, athlete_id, created_at, updated_at FROM medals WHERE id = $1", req.Id).
-		Scan(&medal.Id, &medal.CountryId, &medal.Type, &medal.EventId, &medal.AthleteId, &medal.CreatedAt, &medal.UpdatedAt)
+	selected, err := medalColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	medal, err := selected.scan(m.db.QueryRowContext(ctx, "SELECT "+selected.sql()+" FROM medals WHERE id = $1", req.Id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch medal: %v", err)
 	}
 
-	return &medal, nil
+	return medal, nil
 }
 
-func (m *Medal) ListMedals(ctx context.Context, req *medalproto.ListRequest) (*medalproto.ListResponse, error) {
-	var medals []*medalproto.Medal
-	var total int64
-
-	query := squirrel.Select("id", "country_id", "type", "event_id", "athlete_id", "created_at", "updated_at").
-		From("medals").
-		Limit(uint64(req.Limit)).
-		Offset(uint64((req.Page - 1) * req.Limit))
-
+// filterMedals applies the filters of req shared by listing and export.
+func filterMedals(query squirrel.SelectBuilder, req *medalproto.ListRequest) squirrel.SelectBuilder {
 	if req.Country != 0 {
 		query = query.Where(squirrel.Eq{"country_id": req.Country})
 	}
@@ -149,35 +207,90 @@ func (m *Medal) ListMedals(ctx context.Context, req *medalproto.ListRequest) (*m
 	if req.AthleteId != "" {
 		query = query.Where(squirrel.Eq{"athlete_id": req.AthleteId})
 	}
+	if len(req.CountryIds) > 0 {
+		query = query.Where(squirrel.Eq{"country_id": req.CountryIds})
+	}
+	if len(req.EventIds) > 0 {
+		query = query.Where(squirrel.Eq{"event_id": req.EventIds})
+	}
+	if req.GamesId != 0 {
+		query = query.Where(squirrel.Eq{"games_id": req.GamesId})
+	}
+	return query
+}
+
+func (m *Medal) ListMedals(ctx context.Context, req *medalproto.ListRequest) (*medalproto.ListResponse, error) {
+	var medals []*medalproto.Medal
+	var total int64
 
-	rows, err := query.RunWith(m.db).QueryContext(ctx)
+	selected, err := medalColumns.selection(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	query := squirrel.Select(selected.exprs()...).
+		From("medals").
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	// Batch lookups by country or event are not paginated.
+	if (len(req.CountryIds) == 0 && len(req.EventIds) == 0) || req.Limit > 0 {
+		if req.PageToken != "" {
+			var after int64
+			if err := m.pageTokens.Decode(req.PageToken, medalPages(req), &after); err != nil {
+				return nil, err
+			}
+			query = query.Where(squirrel.Gt{"id": after})
+		} else {
+			query = query.Offset(pageOffset(req.Page, req.Limit))
+		}
+		query = query.Limit(pageLimit(req.Limit))
+	}
+
+	rows, err := filterMedals(query, req).RunWith(m.db).QueryContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch medals: %v", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var medal medalproto.Medal
-		err := rows.Scan(&medal.Id, &medal.CountryId, &medal.Type, &medal.EventId, &medal.AthleteId, &medal.CreatedAt, &medal.UpdatedAt)
+		medal, err := selected.scan(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan medal row: %v", err)
 		}
-		medals = append(medals, &medal)
+		medals = append(medals, medal)
+	}
+
+	var nextPageToken string
+	medals, more := trimPage(medals, req.Limit)
+	if more {
+		nextPageToken, err = m.pageTokens.Encode(medalPages(req), medals[len(medals)-1].Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
 	}
 
-	err = m.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM medals").Scan(&total)
+	err = filterMedals(m.queryBuilder.Select("COUNT(*)").From("medals"), req).
+		RunWith(m.db).
+		QueryRowContext(ctx).
+		Scan(&total)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total number of medals: %v", err)
 	}
 
 	return &medalproto.ListResponse{
-		Count:  total,
-		Medals: medals,
+		Count:         total,
+		Medals:        medals,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-func (m *Medal) GetMedalRanking(ctx context.Context, req *medalproto.Empty) (*medalproto.MedalRankingResponse, error) {
-	query := `
+// medalPages identifies the listing a medal page token was issued for.
+func medalPages(req *medalproto.ListRequest) string {
+	return fmt.Sprintf("medals/%d/%d/%q/%v/%v/%d", req.Country, req.EventId, req.AthleteId, req.CountryIds, req.EventIds, req.GamesId)
+}
+
+const medalRankingQuery = `
 		SELECT 
 			c.id AS country_id, 
 			c.name AS country_name,
@@ -190,12 +303,15 @@ func (m *Medal) GetMedalRanking(ctx context.Context, req *medalproto.Empty) (*me
 				COUNT(CASE WHEN m.type = 'Bronze' THEN 1 END) DESC
 			) AS ranking
 		FROM countries c
-		LEFT JOIN medals m ON c.id = m.country_id
+		LEFT JOIN medals m ON c.id = m.country_id AND ($1::bigint = 0 OR m.games_id = $1)
 		GROUP BY c.id, c.name
 		ORDER BY ranking
 	`
 
-	rows, err := m.db.QueryContext(ctx, query)
+// GetMedalRanking ranks every country by the medals won at req.GamesId, or
+// at all editions when it is 0.
+func (m *Medal) GetMedalRanking(ctx context.Context, req *medalproto.RankingRequest) (*medalproto.MedalRankingResponse, error) {
+	rows, err := m.db.QueryContext(ctx, medalRankingQuery, req.GamesId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %v", err)
 	}
@@ -217,5 +333,72 @@ func (m *Medal) GetMedalRanking(ctx context.Context, req *medalproto.Empty) (*me
 		response.CountryMedalCounts = append(response.CountryMedalCounts, &countryMedalCount)
 	}
 
+	ids := make([]int64, len(response.CountryMedalCounts))
+	for i, count := range response.CountryMedalCounts {
+		ids[i] = count.CountryId
+	}
+	names, err := countryNames(ctx, m.db, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range response.CountryMedalCounts {
+		if name, ok := names[count.CountryId]; ok {
+			count.CountryName = name
+		}
+	}
+
 	return &response, nil
 }
+
+// ExportMedals sends every medal matching the filters of req, ignoring
+// paging, as the rows are read from the database.
+func (m *Medal) ExportMedals(ctx context.Context, req *medalproto.ListRequest, send func(*medalproto.Medal) error) error {
+	query := m.queryBuilder.Select(medalColumns.exprs()...).
+		From("medals").
+		OrderBy("id")
+
+	rows, err := filterMedals(query, req).RunWith(m.db).QueryContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch medals: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		medal, err := scanMedal(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan medal row: %v", err)
+		}
+		if err := send(medal); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ExportMedalRanking sends the medal table of req.GamesId one country at a
+// time.
+func (m *Medal) ExportMedalRanking(ctx context.Context, req *medalproto.RankingRequest, send func(*medalproto.CountryMedalCount) error) error {
+	rows, err := m.db.QueryContext(ctx, medalRankingQuery, req.GamesId)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var countryMedalCount medalproto.CountryMedalCount
+		if err := rows.Scan(
+			&countryMedalCount.CountryId,
+			&countryMedalCount.CountryName,
+			&countryMedalCount.Gold,
+			&countryMedalCount.Silver,
+			&countryMedalCount.Bronze,
+			&countryMedalCount.Ranking,
+		); err != nil {
+			return fmt.Errorf("failed to scan row: %v", err)
+		}
+		if err := send(&countryMedalCount); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
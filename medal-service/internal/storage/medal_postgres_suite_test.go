@@ -8,7 +8,9 @@ import (
 
 	medalproto "olympy/medal-service/genproto/medal_service"
 	"olympy/medal-service/internal/config"
+	"olympy/pkg/pagetoken"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -68,6 +70,16 @@ func (s *MedalRepositoryTestSuite) TestMedalCRUD() {
 	s.Require().NotNil(getResp)
 	s.Equal(medal.Type, getResp.Type)
 
+	// Get Medal with a field mask
+	maskedResp, err := s.repo.GetMedal(ctx, &medalproto.GetSingleRequest{Id: medal.Id, Fields: &types.FieldMask{Paths: []string{"type"}}})
+	s.Require().NoError(err)
+	s.Equal(medal.Id, maskedResp.Id)
+	s.Equal(medal.Type, maskedResp.Type)
+	s.Zero(maskedResp.CountryId)
+
+	_, err = s.repo.GetMedal(ctx, &medalproto.GetSingleRequest{Id: medal.Id, Fields: &types.FieldMask{Paths: []string{"colour"}}})
+	s.ErrorIs(err, ErrUnknownField)
+
 	// List Medals
 	listReq := &medalproto.ListRequest{Page: 1, Limit: 10}
 	listResp, err := s.repo.ListMedals(ctx, listReq)
@@ -76,6 +88,19 @@ func (s *MedalRepositoryTestSuite) TestMedalCRUD() {
 	s.NotZero(listResp.Count)
 	s.NotEmpty(listResp.Medals)
 
+	// Page through medals with page tokens
+	firstPage, err := s.repo.ListMedals(ctx, &medalproto.ListRequest{Page: 1, Limit: 1})
+	s.Require().NoError(err)
+	if firstPage.NextPageToken != "" {
+		nextPage, err := s.repo.ListMedals(ctx, &medalproto.ListRequest{Limit: 1, PageToken: firstPage.NextPageToken})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(nextPage.Medals)
+		s.Greater(nextPage.Medals[0].Id, firstPage.Medals[0].Id)
+	}
+
+	_, err = s.repo.ListMedals(ctx, &medalproto.ListRequest{Limit: 1, PageToken: "forged"})
+	s.ErrorIs(err, pagetoken.ErrInvalid)
+
 	// Delete Medal
 	delReq := &medalproto.GetSingleRequest{Id: medal.Id}
 	delResp, err := s.repo.DeleteMedal(ctx, delReq)
@@ -88,7 +113,7 @@ func (s *MedalRepositoryTestSuite) TestMedalRanking() {
 	ctx := context.Background()
 
 	// Medal Ranking
-	rankingResp, err := s.repo.GetMedalRanking(ctx, &medalproto.Empty{})
+	rankingResp, err := s.repo.GetMedalRanking(ctx, &medalproto.RankingRequest{})
 	s.Require().NoError(err)
 	s.Require().NotNil(rankingResp)
 	s.NotEmpty(rankingResp.CountryMedalCounts)
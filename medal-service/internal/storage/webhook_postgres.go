@@ -0,0 +1,371 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"olympy/medal-service/internal/config"
+	"olympy/pkg/pagetoken"
+	"time"
+
+	webhookproto "olympy/medal-service/genproto/webhook_service"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+type Webhook struct {
+	db           *sql.DB
+	queryBuilder squirrel.StatementBuilderType
+	pageTokens   *pagetoken.Signer
+}
+
+func NewWebhookService(config *config.Config) (*Webhook, error) {
+	db, err := ConnectDB(*config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to DB: %v", err)
+	}
+
+	return &Webhook{
+		db:           db,
+		queryBuilder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		pageTokens:   pagetoken.NewSigner(config.Pagination.TokenSecret),
+	}, nil
+}
+
+// DB is used by the webhook dispatcher, which reads the same tables.
+func (w *Webhook) DB() *sql.DB {
+	return w.db
+}
+
+func (w *Webhook) Close() error {
+	return w.db.Close()
+}
+
+func (w *Webhook) AddWebhook(ctx context.Context, req *webhookproto.Webhook) (*webhookproto.Webhook, error) {
+	data := map[string]interface{}{
+		"url":         req.Url,
+		"secret":      req.Secret,
+		"event_types": pq.Array(eventTypes(req.EventTypes)),
+		"description": req.Description,
+		"disabled":    req.Disabled,
+		"created_at":  time.Now(),
+		"updated_at":  time.Now(),
+	}
+
+	query, args, err := w.queryBuilder.Insert("webhooks").
+		SetMap(data).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	var id int64
+
+	if err := w.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return nil, fmt.Errorf("failed to fetch inserted ID: %v", err)
+	}
+
+	return &webhookproto.Webhook{
+		Id:          id,
+		Url:         req.Url,
+		Secret:      req.Secret,
+		EventTypes:  eventTypes(req.EventTypes),
+		Description: req.Description,
+		Disabled:    req.Disabled,
+		CreatedAt:   data["created_at"].(time.Time).UTC().Format(time.RFC3339Nano),
+		UpdatedAt:   data["updated_at"].(time.Time).UTC().Format(time.RFC3339Nano),
+	}, nil
+}
+
+// EditWebhook keeps the current secret when req.Secret is empty.
+func (w *Webhook) EditWebhook(ctx context.Context, req *webhookproto.Webhook) (*webhookproto.Webhook, error) {
+	data := map[string]interface{}{
+		"url":         req.Url,
+		"event_types": pq.Array(eventTypes(req.EventTypes)),
+		"description": req.Description,
+		"disabled":    req.Disabled,
+		"updated_at":  time.Now(),
+	}
+	if req.Secret != "" {
+		data["secret"] = req.Secret
+	}
+
+	query, args, err := w.queryBuilder.Update("webhooks").
+		SetMap(data).
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := w.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("webhook with ID %d not found", req.Id)
+	}
+
+	updatedWebhook, err := w.GetWebhook(ctx, &webhookproto.GetSingleRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	updatedWebhook.Secret = req.Secret
+	return updatedWebhook, nil
+}
+
+func (w *Webhook) DeleteWebhook(ctx context.Context, req *webhookproto.GetSingleRequest) (*webhookproto.Message, error) {
+	query, args, err := w.queryBuilder.Delete("webhooks").
+		Where(squirrel.Eq{"id": req.Id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %v", err)
+	}
+
+	result, err := w.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("webhook with ID %d not found", req.Id)
+	}
+
+	return &webhookproto.Message{Message: fmt.Sprintf("Webhook with ID %d deleted successfully", req.Id)}, nil
+}
+
+// GetWebhook and ListWebhooks never return secrets.
+func (w *Webhook) GetWebhook(ctx context.Context, req *webhookproto.GetSingleRequest) (*webhookproto.Webhook, error) {
+	var webhook webhookproto.Webhook
+	err := w.db.QueryRowContext(ctx, "SELECT id, url, event_types, description, disabled, created_at, updated_at FROM webhooks WHERE id = $1", req.Id).
+		Scan(&webhook.Id, &webhook.Url, pq.Array(&webhook.EventTypes), &webhook.Description, &webhook.Disabled, &webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook: %v", err)
+	}
+
+	return &webhook, nil
+}
+
+// ListWebhooks returns the webhooks in creation order. A page token resumes
+// after the last webhook of the previous page; otherwise the page number is
+// used.
+func (w *Webhook) ListWebhooks(ctx context.Context, req *webhookproto.ListRequest) (*webhookproto.ListResponse, error) {
+	var webhooks []*webhookproto.Webhook
+	var total int64
+
+	query := w.queryBuilder.Select("id", "url", "event_types", "description", "disabled", "created_at", "updated_at").
+		From("webhooks").
+		OrderBy("id").
+		Limit(pageLimit(req.Limit))
+	if req.PageToken != "" {
+		var after int64
+		if err := w.pageTokens.Decode(req.PageToken, "webhooks", &after); err != nil {
+			return nil, err
+		}
+		query = query.Where(squirrel.Gt{"id": after})
+	} else {
+		query = query.Offset(pageOffset(req.Page, req.Limit))
+	}
+
+	rows, err := query.RunWith(w.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var webhook webhookproto.Webhook
+		err := rows.Scan(&webhook.Id, &webhook.Url, pq.Array(&webhook.EventTypes), &webhook.Description, &webhook.Disabled, &webhook.CreatedAt, &webhook.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook row: %v", err)
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	var nextPageToken string
+	webhooks, more := trimPage(webhooks, req.Limit)
+	if more {
+		nextPageToken, err = w.pageTokens.Encode("webhooks", webhooks[len(webhooks)-1].Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	err = w.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM webhooks").Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total number of webhooks: %v", err)
+	}
+
+	return &webhookproto.ListResponse{
+		Count:         total,
+		Webhooks:      webhooks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+var deliveryColumns = []string{
+	"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts",
+	"next_attempt_at", "last_status_code", "last_error", "created_at", "updated_at",
+}
+
+func scanDelivery(row squirrel.RowScanner) (*webhookproto.Delivery, error) {
+	var delivery webhookproto.Delivery
+	err := row.Scan(&delivery.Id, &delivery.WebhookId, &delivery.EventId, &delivery.EventType, &delivery.Payload, &delivery.Status, &delivery.Attempts,
+		&delivery.NextAttemptAt, &delivery.LastStatusCode, &delivery.LastError, &delivery.CreatedAt, &delivery.UpdatedAt)
+	return &delivery, err
+}
+
+// ListDeliveries returns deliveries newest first, paged like ListWebhooks.
+func (w *Webhook) ListDeliveries(ctx context.Context, req *webhookproto.ListDeliveriesRequest) (*webhookproto.ListDeliveriesResponse, error) {
+	var deliveries []*webhookproto.Delivery
+	var total int64
+
+	filters := squirrel.And{}
+	if req.WebhookId != 0 {
+		filters = append(filters, squirrel.Eq{"webhook_id": req.WebhookId})
+	}
+	if req.Status != "" {
+		filters = append(filters, squirrel.Eq{"status": req.Status})
+	}
+	if req.EventType != "" {
+		filters = append(filters, squirrel.Eq{"event_type": req.EventType})
+	}
+
+	query := w.queryBuilder.Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(filters).
+		OrderBy("id DESC").
+		Limit(pageLimit(req.Limit))
+	if req.PageToken != "" {
+		var before int64
+		if err := w.pageTokens.Decode(req.PageToken, deliveryPages(req), &before); err != nil {
+			return nil, err
+		}
+		query = query.Where(squirrel.Lt{"id": before})
+	} else {
+		query = query.Offset(pageOffset(req.Page, req.Limit))
+	}
+
+	rows, err := query.RunWith(w.db).QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan delivery row: %v", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during rows iteration: %v", err)
+	}
+
+	var nextPageToken string
+	deliveries, more := trimPage(deliveries, req.Limit)
+	if more {
+		nextPageToken, err = w.pageTokens.Encode(deliveryPages(req), deliveries[len(deliveries)-1].Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %v", err)
+		}
+	}
+
+	err = w.queryBuilder.Select("COUNT(*)").
+		From("webhook_deliveries").
+		Where(filters).
+		RunWith(w.db).
+		QueryRowContext(ctx).
+		Scan(&total)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total number of deliveries: %v", err)
+	}
+
+	return &webhookproto.ListDeliveriesResponse{
+		Count:         total,
+		Deliveries:    deliveries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// deliveryPages identifies the listing a delivery page token was issued for.
+func deliveryPages(req *webhookproto.ListDeliveriesRequest) string {
+	return fmt.Sprintf("deliveries/%d/%q/%q", req.WebhookId, req.Status, req.EventType)
+}
+
+func (w *Webhook) GetDelivery(ctx context.Context, req *webhookproto.GetSingleRequest) (*webhookproto.Delivery, error) {
+	delivery, err := scanDelivery(w.queryBuilder.Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"id": req.Id}).
+		RunWith(w.db).
+		QueryRowContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delivery: %v", err)
+	}
+
+	rows, err := w.db.QueryContext(ctx, "SELECT attempt, status_code, error, duration_ms, created_at FROM webhook_delivery_attempts WHERE delivery_id = $1 ORDER BY attempt", req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delivery attempts: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attempt webhookproto.DeliveryAttempt
+		if err := rows.Scan(&attempt.Attempt, &attempt.StatusCode, &attempt.Error, &attempt.DurationMs, &attempt.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan delivery attempt row: %v", err)
+		}
+		delivery.AttemptLog = append(delivery.AttemptLog, &attempt)
+	}
+
+	return delivery, rows.Err()
+}
+
+// Redeliver puts a delivery back in the queue with a fresh set of retries,
+// whatever its status. Earlier attempts stay in the log.
+func (w *Webhook) Redeliver(ctx context.Context, req *webhookproto.GetSingleRequest) (*webhookproto.Delivery, error) {
+	result, err := w.db.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+		WHERE id = $1`, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("delivery with ID %d not found", req.Id)
+	}
+
+	return w.GetDelivery(ctx, req)
+}
+
+// eventTypes stores an empty subscription as an empty array rather than NULL.
+func eventTypes(types []string) []string {
+	if types == nil {
+		return []string{}
+	}
+	return types
+}
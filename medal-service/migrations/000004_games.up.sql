@@ -0,0 +1,33 @@
+-- Olympic Games editions. Events, athlete entries and medals belong to one
+-- edition; rows created before editions existed belong to Paris 2024.
+CREATE TABLE games (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    year INT NOT NULL,
+    season VARCHAR(10) NOT NULL CHECK (season IN ('summer', 'winter')),
+    host_city VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'planned' CHECK (status IN ('planned', 'ongoing', 'completed')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (year, season),
+    CHECK (end_date >= start_date)
+);
+
+INSERT INTO games (name, year, season, host_city, start_date, end_date, status) VALUES
+('Paris 2024', 2024, 'summer', 'Paris', '2024-07-26', '2024-08-11', 'completed'),
+('Milano Cortina 2026', 2026, 'winter', 'Milan', '2026-02-06', '2026-02-22', 'completed'),
+('Los Angeles 2028', 2028, 'summer', 'Los Angeles', '2028-07-14', '2028-07-30', 'planned');
+
+ALTER TABLE events ADD COLUMN games_id INT REFERENCES games(id);
+ALTER TABLE athletes ADD COLUMN games_id INT REFERENCES games(id);
+ALTER TABLE medals ADD COLUMN games_id INT REFERENCES games(id);
+
+UPDATE events SET games_id = (SELECT id FROM games WHERE year = 2024 AND season = 'summer');
+UPDATE athletes SET games_id = (SELECT id FROM games WHERE year = 2024 AND season = 'summer');
+UPDATE medals SET games_id = (SELECT id FROM games WHERE year = 2024 AND season = 'summer');
+
+CREATE INDEX events_games_id_idx ON events (games_id);
+CREATE INDEX athletes_games_id_idx ON athletes (games_id);
+CREATE INDEX medals_games_id_idx ON medals (games_id);
@@ -0,0 +1,4 @@
+-- Field of play within the venue, e.g. "Court 1"; the whole venue when empty
+ALTER TABLE events ADD COLUMN location VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE INDEX events_schedule_idx ON events (start_time, end_time);
@@ -0,0 +1,20 @@
+-- Revision of events for calendar feeds, bumped when their times change or
+-- they are cancelled so that calendar apps update their copies.
+ALTER TABLE events ADD COLUMN sequence INT NOT NULL DEFAULT 0;
+
+-- Calendar feeds of users. Only the SHA-256 of the private token is kept;
+-- unset filters are empty or NULL.
+CREATE TABLE calendar_subscriptions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) UNIQUE NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    sport_type VARCHAR(50) NOT NULL DEFAULT '',
+    query VARCHAR(100) NOT NULL DEFAULT '',
+    from_time TIMESTAMPTZ,
+    to_time TIMESTAMPTZ,
+    games_id INT REFERENCES games(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX calendar_subscriptions_user_id_idx ON calendar_subscriptions (user_id, id);
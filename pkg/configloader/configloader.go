@@ -0,0 +1,200 @@
+// Package configloader fills a tagged config struct from, in increasing order
+// of priority: `default` tags, a YAML file, environment variables (a .env file
+// is loaded when present) and KEY_FILE secret files. Fields are then checked
+// against their `validate` rules and every problem is reported at once.
+//
+//	type ServerConfig struct {
+//		Port string `yaml:"port" env:"SERVER_PORT" default:"4444" validate:"required,port"`
+//	}
+//
+// Supported rules: required, port, hostport, url, positive and oneof=a|b.
+// Fields tagged `secret:"true"` are redacted by Redacted.
+package configloader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv names the environment variable pointing at the YAML config file.
+// When it is not set, DefaultFile is used if it exists.
+const (
+	FileEnv     = "CONFIG_FILE"
+	DefaultFile = "config.yaml"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+type field struct {
+	path  string
+	value reflect.Value
+	tag   reflect.StructTag
+}
+
+// Load fills cfg, which must be a pointer to a struct.
+func Load(cfg interface{}) error {
+	root := reflect.ValueOf(cfg)
+	if root.Kind() != reflect.Pointer || root.Elem().Kind() != reflect.Struct {
+		return errors.New("config: Load expects a pointer to a struct")
+	}
+	fields := collect(root.Elem(), "")
+
+	var problems []string
+	for _, f := range fields {
+		if def, ok := f.tag.Lookup("default"); ok {
+			if err := set(f.value, def); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: invalid default %q: %v", f.path, def, err))
+			}
+		}
+	}
+
+	if err := loadFile(cfg); err != nil {
+		return err
+	}
+
+	// The .env file is a development convenience; containers get their
+	// environment from the orchestrator.
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("config: failed to load .env: %v", err)
+	}
+
+	for _, f := range fields {
+		key := f.tag.Get("env")
+		if key == "" {
+			continue
+		}
+		value, ok, err := lookup(key)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", f.path, err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := set(f.value, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s (%s): %v", f.path, key, err))
+		}
+	}
+
+	for _, f := range fields {
+		problems = append(problems, validate(f)...)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+func loadFile(cfg interface{}) error {
+	path, explicit := os.LookupEnv(FileEnv)
+	if !explicit {
+		path = DefaultFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("config: failed to read %s: %v", path, err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("config: failed to parse %s: %v", path, err)
+	}
+	return nil
+}
+
+// lookup reads KEY from the environment, or the contents of the file named
+// by KEY_FILE, which is how Docker and Kubernetes secrets are mounted.
+func lookup(key string) (string, bool, error) {
+	value, ok := os.LookupEnv(key)
+	file, fromFile := os.LookupEnv(key + "_FILE")
+	switch {
+	case ok && fromFile:
+		return "", false, fmt.Errorf("both %s and %s_FILE are set", key, key)
+	case fromFile:
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to read %s_FILE: %v", key, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), true, nil
+	}
+	return value, ok, nil
+}
+
+func collect(v reflect.Value, prefix string) []field {
+	var fields []field
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := strings.Split(sf.Tag.Get("yaml"), ",")[0]
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		fv := v.Field(i)
+		if sf.Type.Kind() == reflect.Struct && sf.Type != durationType {
+			fields = append(fields, collect(fv, name)...)
+			continue
+		}
+		fields = append(fields, field{path: name, value: fv, tag: sf.Tag})
+	}
+	return fields
+}
+
+func set(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("must be a duration such as 15s or 5m, got %q", s)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", s)
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("must be true or false, got %q", s)
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
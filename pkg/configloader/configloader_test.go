@@ -0,0 +1,131 @@
+package configloader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testConfig struct {
+	Server struct {
+		Host string `yaml:"host" env:"CONFIGLOADER_TEST_HOST" default:"localhost"`
+	} `yaml:"server"`
+	Database struct {
+		Password string `yaml:"password" env:"CONFIGLOADER_TEST_PASSWORD" secret:"true" validate:"required"`
+	} `yaml:"database"`
+	Identity struct {
+		Secret string `yaml:"secret" env:"CONFIGLOADER_TEST_SECRET" secret:"true" validate:"required"`
+	} `yaml:"identity"`
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		env  string
+		file string
+		want string
+		err  string
+	}{
+		{name: "default", want: "localhost"},
+		{name: "yaml over default", yaml: "yaml-host", want: "yaml-host"},
+		{name: "env over yaml", yaml: "yaml-host", env: "env-host", want: "env-host"},
+		{name: "secret file over yaml", yaml: "yaml-host", file: "file-host\n", want: "file-host"},
+		{name: "env and secret file", env: "env-host", file: "file-host", err: "both CONFIGLOADER_TEST_HOST and CONFIGLOADER_TEST_HOST_FILE are set"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yaml := "database:\n  password: pw\nidentity:\n  secret: s\n"
+			if tt.yaml != "" {
+				yaml += "server:\n  host: " + tt.yaml + "\n"
+			}
+			t.Setenv(FileEnv, writeFile(t, "config.yaml", yaml))
+			if tt.env != "" {
+				t.Setenv("CONFIGLOADER_TEST_HOST", tt.env)
+			}
+			if tt.file != "" {
+				t.Setenv("CONFIGLOADER_TEST_HOST_FILE", writeFile(t, "host", tt.file))
+			}
+
+			var cfg testConfig
+			err := Load(&cfg)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Load() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if cfg.Server.Host != tt.want {
+				t.Errorf("server.host = %q, want %q", cfg.Server.Host, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRequiredSecrets(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		secret   string
+		problems []string
+	}{
+		{name: "both set", password: "pw", secret: "s"},
+		{name: "password missing", secret: "s", problems: []string{"database.password (CONFIGLOADER_TEST_PASSWORD): is required"}},
+		{name: "both missing", problems: []string{
+			"database.password (CONFIGLOADER_TEST_PASSWORD): is required",
+			"identity.secret (CONFIGLOADER_TEST_SECRET): is required",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(FileEnv, writeFile(t, "config.yaml", "server:\n  host: db\n"))
+			if tt.password != "" {
+				t.Setenv("CONFIGLOADER_TEST_PASSWORD", tt.password)
+			}
+			if tt.secret != "" {
+				t.Setenv("CONFIGLOADER_TEST_SECRET_FILE", writeFile(t, "secret", tt.secret))
+			}
+
+			var cfg testConfig
+			err := Load(&cfg)
+			if len(tt.problems) == 0 {
+				if err != nil {
+					t.Fatalf("Load() error = %v", err)
+				}
+				if cfg.Database.Password != tt.password || cfg.Identity.Secret != tt.secret {
+					t.Errorf("Load() = %+v", cfg)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Load() succeeded without the required secrets")
+			}
+			want := "invalid configuration:\n  - " + strings.Join(tt.problems, "\n  - ")
+			if err.Error() != want {
+				t.Errorf("Load() error =\n%s\nwant\n%s", err, want)
+			}
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Setenv(FileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	var cfg testConfig
+	if err := Load(&cfg); err == nil || !strings.Contains(err.Error(), "failed to read") {
+		t.Errorf("Load() error = %v, want a read failure for an explicit CONFIG_FILE", err)
+	}
+}
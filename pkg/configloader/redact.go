@@ -0,0 +1,41 @@
+package configloader
+
+import (
+	"net/url"
+	"reflect"
+)
+
+const redacted = "[REDACTED]"
+
+// Redacted returns the effective configuration keyed by YAML path, with
+// secrets hidden, so it can be logged at startup. Passwords embedded in URLs
+// are hidden while the rest of the URL is kept.
+func Redacted(cfg interface{}) map[string]interface{} {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	out := make(map[string]interface{})
+	for _, f := range collect(v, "") {
+		value := f.value.Interface()
+		if f.tag.Get("secret") == "true" && !f.value.IsZero() {
+			value = redact(f.value.String())
+		} else if d, ok := value.(interface{ String() string }); ok && f.value.Type() == durationType {
+			value = d.String()
+		}
+		out[f.path] = value
+	}
+	return out
+}
+
+func redact(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.User == nil {
+		return redacted
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	}
+	return u.String()
+}
@@ -0,0 +1,69 @@
+package configloader
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func validate(f field) []string {
+	rules := f.tag.Get("validate")
+	if rules == "" {
+		return nil
+	}
+
+	name := f.path
+	if key := f.tag.Get("env"); key != "" {
+		name = fmt.Sprintf("%s (%s)", f.path, key)
+	}
+
+	var problems []string
+	for _, rule := range strings.Split(rules, ",") {
+		rule, arg, _ := strings.Cut(rule, "=")
+		if rule != "required" && f.value.IsZero() {
+			continue
+		}
+		if err := check(f, rule, arg); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	return problems
+}
+
+func check(f field, rule, arg string) error {
+	s := fmt.Sprint(f.value.Interface())
+	switch rule {
+	case "required":
+		if f.value.IsZero() {
+			return fmt.Errorf("is required")
+		}
+	case "port":
+		if n, err := strconv.Atoi(s); err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("must be a port number, got %q", s)
+		}
+	case "hostport":
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			return fmt.Errorf("must be host:port, got %q", s)
+		}
+	case "url":
+		if u, err := url.Parse(s); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("must be an absolute URL")
+		}
+	case "positive":
+		if f.value.Int() <= 0 {
+			return fmt.Errorf("must be positive, got %s", s)
+		}
+	case "oneof":
+		for _, option := range strings.Split(arg, "|") {
+			if s == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s, got %q", strings.ReplaceAll(arg, "|", ", "), s)
+	default:
+		return fmt.Errorf("unknown validation rule %q", rule)
+	}
+	return nil
+}
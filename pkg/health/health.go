@@ -0,0 +1,113 @@
+package health
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	probeInterval = 10 * time.Second
+	probeTimeout  = 3 * time.Second
+)
+
+// Check reports whether a single dependency (database, cache, broker...) is reachable.
+type Check func(ctx context.Context) error
+
+// Checker backs the standard grpc.health.v1 service with periodic dependency checks.
+// Every dependency is exposed under its own name, while the overall status ("")
+// and the registered service names are SERVING only when all checks pass.
+type Checker struct {
+	server   *health.Server
+	checks   map[string]Check
+	services []string
+
+	mu   sync.Mutex
+	last map[string]healthpb.HealthCheckResponse_ServingStatus
+}
+
+func New(checks map[string]Check, services ...string) *Checker {
+	server := health.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, service := range services {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return &Checker{
+		server:   server,
+		checks:   checks,
+		services: services,
+		last:     make(map[string]healthpb.HealthCheckResponse_ServingStatus),
+	}
+}
+
+// Register exposes the health service on the given gRPC server.
+func (c *Checker) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, c.server)
+}
+
+// Run probes all dependencies until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	c.probe(ctx)
+
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.server.Shutdown()
+			return
+		case <-ticker.C:
+			c.probe(ctx)
+		}
+	}
+}
+
+func (c *Checker) probe(ctx context.Context) {
+	overall := healthpb.HealthCheckResponse_SERVING
+
+	for name, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+		err := check(checkCtx)
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			overall = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		c.set(name, status, err)
+	}
+
+	c.set("", overall, nil)
+	for _, service := range c.services {
+		c.set(service, overall, nil)
+	}
+}
+
+func (c *Checker) set(name string, status healthpb.HealthCheckResponse_ServingStatus, err error) {
+	c.mu.Lock()
+	previous, seen := c.last[name]
+	c.last[name] = status
+	c.mu.Unlock()
+
+	if !seen || previous != status {
+		label := name
+		if label == "" {
+			label = "service"
+		}
+		switch {
+		case err != nil:
+			log.Printf("health: %s is %s: %v", label, status, err)
+		case seen:
+			log.Printf("health: %s is %s", label, status)
+		}
+	}
+	c.server.SetServingStatus(name, status)
+}
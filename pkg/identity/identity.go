@@ -0,0 +1,211 @@
+// Package identity carries the end user a call is made for from the gateway
+// to the services. The gateway signs the identity it authenticated and sends
+// it as gRPC metadata; the services verify it and check that it holds the
+// scope each RPC requires, so they stay protected when the gateway is
+// bypassed.
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Key is the metadata key carrying the signed identity.
+const Key = "x-olympy-identity"
+
+// TTL bounds how long a signed identity is accepted. It only has to outlive
+// the start of the call it was signed for.
+const TTL = time.Minute
+
+// ErrInvalid is returned for identities that were tampered with or expired.
+var ErrInvalid = errors.New("invalid identity")
+
+// Identity is the caller on whose behalf a call is made. UserID is empty for
+// anonymous callers.
+type Identity struct {
+	UserID    string   `json:"sub,omitempty"`
+	Role      string   `json:"role"`
+	Scopes    []string `json:"scopes,omitempty"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+// Has reports whether the identity holds scope. Every identity holds the
+// empty scope.
+func (id Identity) Has(scope string) bool {
+	return scope == "" || slices.Contains(id.Scopes, scope)
+}
+
+type contextKey struct{}
+
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(Identity)
+	return id, ok
+}
+
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{key: []byte(secret)}
+}
+
+// Sign returns id as a token that is valid for TTL.
+func (s *Signer) Sign(id Identity) (string, error) {
+	id.ExpiresAt = time.Now().Add(TTL).Unix()
+	data, err := json.Marshal(id)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(s.sign(data)), nil
+}
+
+// Verify returns the identity in token if it was signed with the same secret
+// and has not expired.
+func (s *Signer) Verify(token string) (Identity, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Identity{}, ErrInvalid
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Identity{}, ErrInvalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(data)) {
+		return Identity{}, ErrInvalid
+	}
+
+	var id Identity
+	if err := json.Unmarshal(data, &id); err != nil {
+		return Identity{}, ErrInvalid
+	}
+	if time.Now().Unix() > id.ExpiresAt {
+		return Identity{}, ErrInvalid
+	}
+	return id, nil
+}
+
+func (s *Signer) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// UnaryClientInterceptor signs the identity in ctx and forwards it to the
+// called service.
+func UnaryClientInterceptor(s *Signer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := s.toOutgoingMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor signs the identity in ctx and forwards it to the
+// called service.
+func StreamClientInterceptor(s *Signer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := s.toOutgoingMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func (s *Signer) toOutgoingMetadata(ctx context.Context) (context.Context, error) {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	token, err := s.Sign(id)
+	if err != nil {
+		return ctx, status.Errorf(codes.Internal, "failed to sign identity: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, Key, token), nil
+}
+
+// Permissions maps the full name of every RPC a server exposes, e.g.
+// "/medal_service.MedalService/AddMedal", to the scope it requires. RPCs
+// that are not listed are denied.
+type Permissions map[string]string
+
+// healthPrefix names the health service, which probes call without an
+// identity.
+const healthPrefix = "/grpc.health.v1.Health/"
+
+// UnaryServerInterceptor verifies the caller's identity and its permission
+// for the called RPC, and stores the identity in the context.
+func UnaryServerInterceptor(s *Signer, permissions Permissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := s.authorize(ctx, permissions, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(s *Signer, permissions Permissions) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.authorize(ss.Context(), permissions, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (s *Signer) authorize(ctx context.Context, permissions Permissions, method string) (context.Context, error) {
+	if strings.HasPrefix(method, healthPrefix) {
+		return ctx, nil
+	}
+	scope, ok := permissions[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not available to callers", method)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(Key)
+	if len(tokens) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	id, err := s.Verify(tokens[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !id.Has(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %q lacks the %s scope", id.Role, scope)
+	}
+	return WithIdentity(ctx, id), nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,152 @@
+package identity
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var admin = Identity{UserID: "7", Role: "admin", Scopes: []string{"medals:write", "users:manage"}}
+
+// signAt signs id with the given expiry, which Sign always sets to TTL from
+// now.
+func signAt(s *Signer, id Identity, expiresAt time.Time) string {
+	id.ExpiresAt = expiresAt.Unix()
+	data, _ := json.Marshal(id)
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(s.sign(data))
+}
+
+func TestVerify(t *testing.T) {
+	signer := NewSigner("secret")
+	token, err := signer.Sign(admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+
+	forged, _ := json.Marshal(Identity{UserID: "7", Role: "admin", Scopes: []string{"everything"}, ExpiresAt: time.Now().Add(TTL).Unix()})
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"signed", token, true},
+		{"signed with another secret", func() string { t, _ := NewSigner("other").Sign(admin); return t }(), false},
+		{"tampered payload", base64.RawURLEncoding.EncodeToString(forged) + "." + signature, false},
+		{"tampered signature", payload + "." + base64.RawURLEncoding.EncodeToString([]byte("not the signature")), false},
+		{"signature not base64", payload + ".!!", false},
+		{"no signature", payload, false},
+		{"empty", "", false},
+		{"expired", signAt(signer, admin, time.Now().Add(-time.Second)), false},
+		{"expiring later", signAt(signer, admin, time.Now().Add(time.Second)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := signer.Verify(tt.token)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalid) {
+					t.Fatalf("Verify() error = %v, want ErrInvalid", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if id.UserID != admin.UserID || id.Role != admin.Role || !id.Has("users:manage") {
+				t.Errorf("Verify() = %+v, want %+v", id, admin)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	signer := NewSigner("secret")
+	permissions := Permissions{
+		"/medal_service.MedalService/ListMedals": "",
+		"/medal_service.MedalService/AddMedal":   "medals:write",
+	}
+	interceptor := UnaryServerInterceptor(signer, permissions)
+
+	sign := func(id Identity) metadata.MD {
+		token, err := signer.Sign(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return metadata.Pairs(Key, token)
+	}
+	viewer := Identity{UserID: "8", Role: "user"}
+
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		code   codes.Code
+	}{
+		{"scope held", "/medal_service.MedalService/AddMedal", sign(admin), codes.OK},
+		{"scope missing", "/medal_service.MedalService/AddMedal", sign(viewer), codes.PermissionDenied},
+		{"no scope required", "/medal_service.MedalService/ListMedals", sign(viewer), codes.OK},
+		{"no identity", "/medal_service.MedalService/ListMedals", nil, codes.Unauthenticated},
+		{"two identities", "/medal_service.MedalService/ListMedals", metadata.Join(sign(admin), sign(viewer)), codes.Unauthenticated},
+		{"forged identity", "/medal_service.MedalService/AddMedal", metadata.Pairs(Key, signAt(NewSigner("other"), admin, time.Now().Add(TTL))), codes.Unauthenticated},
+		{"expired identity", "/medal_service.MedalService/AddMedal", metadata.Pairs(Key, signAt(signer, admin, time.Now().Add(-time.Second))), codes.Unauthenticated},
+		{"unlisted method", "/medal_service.MedalService/DropAll", sign(admin), codes.PermissionDenied},
+		{"health check", "/grpc.health.v1.Health/Check", nil, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if id, ok := FromContext(ctx); tt.md != nil && (!ok || id.Role == "") {
+					t.Error("handler called without the caller's identity")
+				}
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("interceptor() code = %v, want %v (%v)", code, tt.code, err)
+			}
+			if called != (tt.code == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.code == codes.OK)
+			}
+		})
+	}
+}
+
+func TestClientInterceptorSignsIdentity(t *testing.T) {
+	signer := NewSigner("secret")
+	interceptor := UnaryClientInterceptor(signer)
+
+	var sent metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	if err := interceptor(WithIdentity(context.Background(), admin), "/m", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := sent.Get(Key)
+	if len(tokens) != 1 {
+		t.Fatalf("sent %d identities, want 1", len(tokens))
+	}
+	id, err := signer.Verify(tokens[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.UserID != admin.UserID || !id.Has("medals:write") {
+		t.Errorf("sent %+v, want %+v", id, admin)
+	}
+}
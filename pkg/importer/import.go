@@ -0,0 +1,195 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	DefaultChunkSize = 500
+	MaxChunkSize     = 5000
+
+	// maxReportedErrors keeps the report of a badly broken file readable;
+	// the failed count still covers every row.
+	maxReportedErrors = 1000
+)
+
+// ErrInvalidInput wraps errors that make the whole input unreadable.
+var ErrInvalidInput = errors.New("invalid import")
+
+// Options control how an import is applied.
+type Options struct {
+	Format string
+	// DryRun checks every row, including the inserts the database would
+	// refuse, in a transaction that is rolled back.
+	DryRun bool
+	// Chunked applies valid rows in transactions of ChunkSize rows and skips
+	// invalid ones. Otherwise the import is all-or-nothing: a single invalid
+	// row rejects the whole input.
+	Chunked   bool
+	ChunkSize int
+}
+
+// RowError describes why a row was not imported. Field is empty when the
+// problem is not tied to a single column.
+type RowError struct {
+	Row     int
+	Field   string
+	Message string
+}
+
+// Result summarises an import.
+type Result struct {
+	Total    int
+	Valid    int
+	Imported int
+	Failed   int
+	Errors   []RowError
+}
+
+// Item is a parsed row ready to be inserted.
+type Item[T any] struct {
+	Line  int
+	Value T
+}
+
+// ParseFunc converts a row into a value, returning the problems found.
+type ParseFunc[T any] func(Row) (T, []RowError)
+
+// InsertFunc inserts a single value inside tx.
+type InsertFunc[T any] func(ctx context.Context, tx *sql.Tx, value T) error
+
+// Run reads src, validates every row with parse and inserts the valid rows
+// into db. With opts.DryRun the inserts are rolled back.
+func Run[T any](ctx context.Context, db *sql.DB, opts Options, src io.Reader, parse ParseFunc[T], insert InsertFunc[T]) (*Result, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultChunkSize
+	}
+	if opts.ChunkSize > MaxChunkSize {
+		opts.ChunkSize = MaxChunkSize
+	}
+
+	result := &Result{}
+	var items []Item[T]
+	err := Read(opts.Format, src, func(row Row) error {
+		result.Total++
+		if row.Err != nil {
+			result.addErrors(RowError{Row: row.Line, Message: row.Err.Error()})
+			return nil
+		}
+		value, problems := parse(row)
+		if len(problems) > 0 {
+			for i := range problems {
+				problems[i].Row = row.Line
+			}
+			result.addErrors(problems...)
+			return nil
+		}
+		items = append(items, Item[T]{Line: row.Line, Value: value})
+		return ctx.Err()
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	result.Valid = len(items)
+
+	if len(items) == 0 || (!opts.DryRun && !opts.Chunked && result.Failed > 0) {
+		return result, nil
+	}
+
+	if opts.DryRun {
+		if err := check(ctx, db, result, items, insert); err != nil {
+			return nil, err
+		}
+	} else if opts.Chunked {
+		for start := 0; start < len(items); start += opts.ChunkSize {
+			end := start + opts.ChunkSize
+			if end > len(items) {
+				end = len(items)
+			}
+			applyChunk(ctx, db, result, items[start:end], insert)
+		}
+	} else if failed, err := apply(ctx, db, items, insert); err != nil {
+		result.addErrors(RowError{Row: failed.Line, Message: err.Error()})
+	} else {
+		result.Imported = len(items)
+	}
+
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+	return result, nil
+}
+
+// applyChunk inserts a chunk in one transaction. When that fails the chunk is
+// retried row by row, so that only the offending rows are skipped.
+func applyChunk[T any](ctx context.Context, db *sql.DB, result *Result, chunk []Item[T], insert InsertFunc[T]) {
+	if _, err := apply(ctx, db, chunk, insert); err == nil {
+		result.Imported += len(chunk)
+		return
+	}
+	for _, item := range chunk {
+		if _, err := apply(ctx, db, []Item[T]{item}, insert); err != nil {
+			result.addErrors(RowError{Row: item.Line, Message: err.Error()})
+			continue
+		}
+		result.Imported++
+	}
+}
+
+// check inserts items in a transaction that is always rolled back, and
+// reports the rows that fail. Each row runs under a savepoint, so a failed
+// row is undone while the rows after it still see the ones before.
+func check[T any](ctx context.Context, db *sql.DB, result *Result, items []Item[T], insert InsertFunc[T]) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, item := range items {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT import_row"); err != nil {
+			return fmt.Errorf("failed to create savepoint: %v", err)
+		}
+		if err := insert(ctx, tx, item.Value); err != nil {
+			result.addErrors(RowError{Row: item.Line, Message: err.Error()})
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_row"); err != nil {
+				return fmt.Errorf("failed to roll back row: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// apply inserts items in a single transaction and returns the item that
+// made it fail.
+func apply[T any](ctx context.Context, db *sql.DB, items []Item[T], insert InsertFunc[T]) (Item[T], error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return Item[T]{}, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	for _, item := range items {
+		if err := insert(ctx, tx, item.Value); err != nil {
+			tx.Rollback()
+			return item, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return Item[T]{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return Item[T]{}, nil
+}
+
+func (r *Result) addErrors(errs ...RowError) {
+	r.Failed++
+	for _, e := range errs {
+		if len(r.Errors) < maxReportedErrors {
+			r.Errors = append(r.Errors, e)
+		}
+	}
+}
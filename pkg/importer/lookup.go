@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Countries resolves the country column of an import, which may hold the
+// country's id, its name or its NOC code (e.g. "JPN").
+type Countries struct {
+	ids   map[int64]bool
+	names map[string]int64
+	nocs  map[string]int64
+}
+
+// LoadCountries reads every country from db. The table is small enough to
+// be held in memory for the duration of an import.
+func LoadCountries(ctx context.Context, db *sql.DB) (*Countries, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, name, COALESCE(noc_code, '') FROM countries")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load countries: %v", err)
+	}
+	defer rows.Close()
+
+	c := &Countries{
+		ids:   make(map[int64]bool),
+		names: make(map[string]int64),
+		nocs:  make(map[string]int64),
+	}
+	for rows.Next() {
+		var (
+			id        int64
+			name, noc string
+		)
+		if err := rows.Scan(&id, &name, &noc); err != nil {
+			return nil, fmt.Errorf("failed to scan country row: %v", err)
+		}
+		c.ids[id] = true
+		c.names[strings.ToLower(name)] = id
+		if noc != "" {
+			c.nocs[strings.ToUpper(noc)] = id
+		}
+	}
+	return c, rows.Err()
+}
+
+// Resolve returns the id of the country referenced by ref.
+func (c *Countries) Resolve(ref string) (int64, bool) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		return id, c.ids[id]
+	}
+	if id, ok := c.nocs[strings.ToUpper(ref)]; ok {
+		return id, true
+	}
+	id, ok := c.names[strings.ToLower(ref)]
+	return id, ok
+}
+
+// HasName reports whether a country with the given name exists.
+func (c *Countries) HasName(name string) bool {
+	_, ok := c.names[strings.ToLower(strings.TrimSpace(name))]
+	return ok
+}
+
+// HasNOC reports whether a country with the given NOC code exists.
+func (c *Countries) HasNOC(code string) bool {
+	_, ok := c.nocs[strings.ToUpper(strings.TrimSpace(code))]
+	return ok
+}
+
+// LoadIDs returns the ids present in table, so that references to other
+// entities can be checked while validating, including in dry runs.
+func LoadIDs(ctx context.Context, db *sql.DB, table string) (map[int64]bool, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id FROM "+table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %v", table, err)
+	}
+	defer rows.Close()
+
+	ids := make(map[int64]bool)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan %s row: %v", table, err)
+		}
+		ids[id] = true
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,161 @@
+// Package importer reads bulk imports sent as CSV or NDJSON over a
+// client-streaming RPC, validates them row by row and applies the valid rows
+// either atomically or in chunks.
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+
+	// MaxRows caps the size of a single import.
+	MaxRows = 50000
+)
+
+// Row is one input record keyed by lower-cased column name. Line is the
+// 1-based line in the input, counting the CSV header.
+type Row struct {
+	Line   int
+	Fields map[string]string
+	// Err is set when the row itself could not be decoded.
+	Err error
+}
+
+// Get returns the trimmed value of the first of the given columns present in
+// the row, so that aliases such as "country" and "country_id" can be accepted.
+func (r Row) Get(columns ...string) string {
+	for _, c := range columns {
+		if v, ok := r.Fields[c]; ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// StreamReader turns the data chunks of a client stream into an io.Reader.
+// first is the data carried by the first message, which also holds the
+// options; recv returns the data of the following messages and io.EOF once
+// the client has closed the stream.
+func StreamReader(first []byte, recv func() ([]byte, error)) io.Reader {
+	return &streamReader{buf: first, recv: recv}
+}
+
+type streamReader struct {
+	buf  []byte
+	recv func() ([]byte, error)
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.buf) == 0 {
+		data, err := s.recv()
+		if err != nil {
+			return 0, err
+		}
+		s.buf = data
+	}
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+// Read calls fn for every row of r. Errors that make the rest of the input
+// unreadable, such as a malformed CSV header, are returned as is.
+func Read(format string, r io.Reader, fn func(Row) error) error {
+	switch strings.ToLower(format) {
+	case FormatCSV, "":
+		return readCSV(r, fn)
+	case FormatNDJSON, "jsonl":
+		return readNDJSON(r, fn)
+	default:
+		return fmt.Errorf("unsupported format %q, expected csv or ndjson", format)
+	}
+}
+
+func readCSV(r io.Reader, fn func(Row) error) error {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read CSV header: %v", err)
+	}
+	for i, h := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+	}
+
+	rows := 0
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+		if rows++; rows > MaxRows {
+			return fmt.Errorf("import is limited to %d rows", MaxRows)
+		}
+
+		fields := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) {
+				fields[header[i]] = value
+			}
+		}
+		if err := fn(Row{Line: line, Fields: fields}); err != nil {
+			return err
+		}
+	}
+}
+
+func readNDJSON(r io.Reader, fn func(Row) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	line, rows := 0, 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if rows++; rows > MaxRows {
+			return fmt.Errorf("import is limited to %d rows", MaxRows)
+		}
+
+		var object map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&object); err != nil {
+			// A broken line is reported against that row only.
+			if err := fn(Row{Line: line, Err: fmt.Errorf("invalid JSON: %v", err)}); err != nil {
+				return err
+			}
+			continue
+		}
+		fields := make(map[string]string, len(object))
+		for k, v := range object {
+			if v != nil {
+				fields[strings.ToLower(k)] = fmt.Sprint(v)
+			}
+		}
+		if err := fn(Row{Line: line, Fields: fields}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
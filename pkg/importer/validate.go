@@ -0,0 +1,39 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Problems collects the validation errors of a row.
+type Problems []RowError
+
+func (p *Problems) Add(field, format string, args ...interface{}) {
+	*p = append(*p, RowError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Text checks that value is set and at most maxLen characters long.
+func (p *Problems) Text(field, value string, maxLen int) string {
+	switch {
+	case value == "":
+		p.Add(field, "is required")
+	case utf8.RuneCountInString(value) > maxLen:
+		p.Add(field, "must be at most %d characters", maxLen)
+	}
+	return value
+}
+
+// ID checks that value is a positive integer.
+func (p *Problems) ID(field, value string) int64 {
+	if value == "" {
+		p.Add(field, "is required")
+		return 0
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id <= 0 {
+		p.Add(field, "must be a positive integer, got %q", value)
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,144 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const DefaultShutdownTimeout = 15 * time.Second
+
+type component struct {
+	name string
+	run  func(ctx context.Context) error
+	stop func(ctx context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Runner starts the long-running parts of a service (servers, consumers, workers)
+// and shuts them down when SIGINT/SIGTERM is received or one of them fails.
+//
+// On shutdown, components are stopped one by one in reverse registration order:
+// the component's context is cancelled, its stop function (if any) is called and
+// the runner waits for it to return. Once every component has stopped, closers
+// (database pools, broker connections...) are called in registration order.
+// The whole sequence is bounded by the shutdown timeout.
+type Runner struct {
+	timeout    time.Duration
+	components []component
+	closers    []closer
+}
+
+func New(timeout time.Duration) *Runner {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return &Runner{timeout: timeout}
+}
+
+// Go registers a component. run must block until ctx is cancelled or stop is called.
+// stop may be nil for components that only rely on ctx cancellation.
+func (r *Runner) Go(name string, run func(ctx context.Context) error, stop func(ctx context.Context) error) {
+	r.components = append(r.components, component{name: name, run: run, stop: stop})
+}
+
+// Close registers a resource to release after every component has stopped.
+func (r *Runner) Close(name string, close func() error) {
+	r.closers = append(r.closers, closer{name: name, close: close})
+}
+
+// Run blocks until a shutdown signal is received or a component fails, then
+// performs the graceful shutdown sequence.
+func (r *Runner) Run(ctx context.Context) error {
+	ctx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	failed := make(chan error, len(r.components))
+	cancels := make([]context.CancelFunc, len(r.components))
+	done := make([]chan struct{}, len(r.components))
+
+	for i, c := range r.components {
+		runCtx, cancel := context.WithCancel(context.Background())
+		cancels[i] = cancel
+		done[i] = make(chan struct{})
+
+		go func(c component, runCtx context.Context, done chan struct{}) {
+			defer close(done)
+			if err := c.run(runCtx); err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, context.Canceled) {
+				failed <- fmt.Errorf("%s: %w", c.name, err)
+			}
+		}(c, runCtx, done[i])
+	}
+
+	var errs []error
+	select {
+	case <-ctx.Done():
+		log.Println("lifecycle: shutdown signal received")
+	case err := <-failed:
+		log.Printf("lifecycle: %v", err)
+		errs = append(errs, err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	for i := len(r.components) - 1; i >= 0; i-- {
+		c := r.components[i]
+		log.Printf("lifecycle: stopping %s", c.name)
+
+		cancels[i]()
+		if c.stop != nil {
+			if err := c.stop(shutdownCtx); err != nil {
+				errs = append(errs, fmt.Errorf("stop %s: %w", c.name, err))
+			}
+		}
+
+		select {
+		case <-done[i]:
+		case <-shutdownCtx.Done():
+			errs = append(errs, fmt.Errorf("stop %s: %w", c.name, shutdownCtx.Err()))
+		}
+	}
+
+	for _, c := range r.closers {
+		log.Printf("lifecycle: closing %s", c.name)
+		if err := c.close(); err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", c.name, err))
+		}
+	}
+
+	log.Println("lifecycle: shutdown complete")
+	return errors.Join(errs...)
+}
+
+// GracefulStop stops a gRPC server, letting in-flight RPCs finish. If ctx expires
+// first, the remaining connections are closed forcefully.
+func GracefulStop(server *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return ctx.Err()
+		}
+	}
+}
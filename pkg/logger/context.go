@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RequestIDHeader carries the request ID on HTTP requests and responses.
+	RequestIDHeader = "X-Request-ID"
+
+	// Keys used in gRPC metadata and AMQP message headers.
+	RequestIDKey = "x-request-id"
+	UserIDKey    = "x-user-id"
+)
+
+type contextKey int
+
+const (
+	requestIDContextKey contextKey = iota
+	userIDContextKey
+)
+
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, id)
+}
+
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
+
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDContextKey, id)
+}
+
+func UserID(ctx context.Context) string {
+	id, _ := ctx.Value(userIDContextKey).(string)
+	return id
+}
+
+// FromContext returns the global logger annotated with the request and user
+// IDs carried by ctx.
+func FromContext(ctx context.Context) *zap.Logger {
+	return zap.L().With(Fields(ctx)...)
+}
+
+func Fields(ctx context.Context) []zap.Field {
+	var fields []zap.Field
+	if id := RequestID(ctx); id != "" {
+		fields = append(fields, zap.String("request_id", id))
+	}
+	if id := UserID(ctx); id != "" {
+		fields = append(fields, zap.String("user_id", id))
+	}
+	return fields
+}
@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor restores the request and user IDs sent by the
+// caller and logs every call with its latency and status code.
+func UnaryServerInterceptor(l *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx = fromIncomingMetadata(ctx)
+
+		resp, err := handler(ctx, req)
+
+		fields := append(Fields(ctx),
+			zap.String("rpc", info.FullMethod),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("status", status.Code(err).String()),
+		)
+		if err != nil {
+			l.Warn("rpc failed", append(fields, zap.Error(err))...)
+		} else {
+			l.Info("rpc", fields...)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor; the call is logged once the stream has finished.
+func StreamServerInterceptor(l *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := fromIncomingMetadata(ss.Context())
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		fields := append(Fields(ctx),
+			zap.String("rpc", info.FullMethod),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("status", status.Code(err).String()),
+		)
+		if err != nil {
+			l.Warn("rpc failed", append(fields, zap.Error(err))...)
+		} else {
+			l.Info("rpc", fields...)
+		}
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientInterceptor forwards the request and user IDs in ctx to the
+// called service.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(toOutgoingMetadata(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor forwards the request and user IDs in ctx to the
+// called service.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(toOutgoingMetadata(ctx), desc, cc, method, opts...)
+	}
+}
+
+func fromIncomingMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if v := md.Get(RequestIDKey); len(v) > 0 {
+		ctx = WithRequestID(ctx, v[0])
+	}
+	if v := md.Get(UserIDKey); len(v) > 0 {
+		ctx = WithUserID(ctx, v[0])
+	}
+	return ctx
+}
+
+func toOutgoingMetadata(ctx context.Context) context.Context {
+	var pairs []string
+	if id := RequestID(ctx); id != "" {
+		pairs = append(pairs, RequestIDKey, id)
+	}
+	if id := UserID(ctx); id != "" {
+		pairs = append(pairs, UserIDKey, id)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
@@ -7,24 +7,42 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+func outputs(file string) []string {
+	if file == "" {
+		return []string{"stdout"}
+	}
+	return []string{"stdout", file}
+}
+
 func productionConfig(file string) zap.Config {
 	configZap := zap.NewProductionConfig()
-	configZap.OutputPaths = []string{"stdout", file}
+	configZap.OutputPaths = outputs(file)
 	configZap.DisableStacktrace = true
+	configZap.EncoderConfig.TimeKey = "time"
+	configZap.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 	return configZap
 }
 
 func DevelopmentConfig(file string) zap.Config {
 	configZap := zap.NewDevelopmentConfig()
-	configZap.OutputPaths = []string{"stdout", file}
+	configZap.OutputPaths = outputs(file)
 	configZap.ErrorOutputPaths = []string{"stderr"}
 	return configZap
 }
 
+// New builds a logger that writes JSON to stdout and, if file_name is set,
+// to that file as well. The "development" environment uses the console
+// encoder instead.
 func New(level, environment string, file_name string) (*zap.Logger, error) {
-	file := filepath.Join("./" + file_name)
+	var file string
+	if file_name != "" {
+		file = filepath.Join("./" + file_name)
+	}
 
 	configZap := productionConfig(file)
+	if environment == "development" {
+		configZap = DevelopmentConfig(file)
+	}
 
 	switch level {
 	case "debug":
@@ -47,6 +65,13 @@ func New(level, environment string, file_name string) (*zap.Logger, error) {
 	return configZap.Build()
 }
 
+// SetDefault makes l the global zap logger and redirects the standard
+// library logger to it, so log.Printf calls are written as JSON too.
+func SetDefault(l *zap.Logger) {
+	zap.ReplaceGlobals(l)
+	zap.RedirectStdLog(l)
+}
+
 func Error(err error) zapcore.Field {
 	return zap.Error(err)
 }
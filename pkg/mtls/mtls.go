@@ -0,0 +1,173 @@
+// Package mtls secures the gRPC connections between the services with
+// mutual TLS. Every service holds a certificate issued by a shared CA whose
+// common name is the service's name, which identifies it as a caller.
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// Files locates a PEM certificate, its key, and the CA that issued the
+// certificates of the peers.
+type Files struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+// Store holds the certificate and CA pool read from Files. They are read
+// again whenever one of the files changes, so that rotated certificates are
+// used for new connections without a restart.
+type Store struct {
+	files Files
+
+	mu       sync.Mutex
+	modTimes [3]time.Time
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+}
+
+func NewStore(files Files) (*Store, error) {
+	s := &Store{files: files}
+	modTimes, err := s.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.load(modTimes); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) stat() ([3]time.Time, error) {
+	var modTimes [3]time.Time
+	for i, name := range []string{s.files.Cert, s.files.Key, s.files.CA} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return modTimes, fmt.Errorf("mtls: %v", err)
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (s *Store) load(modTimes [3]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(s.files.Cert, s.files.Key)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to load certificate: %v", err)
+	}
+	ca, err := os.ReadFile(s.files.CA)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to read CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return fmt.Errorf("mtls: no certificates found in %s", s.files.CA)
+	}
+
+	s.cert, s.pool, s.modTimes = &cert, pool, modTimes
+	return nil
+}
+
+// current returns the certificate and CA pool, reloading them first if a
+// file changed. A failed reload, e.g. while the files are being replaced,
+// keeps the previous ones.
+func (s *Store) current() (*tls.Certificate, *x509.CertPool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	modTimes, err := s.stat()
+	if err == nil && modTimes != s.modTimes {
+		err = s.load(modTimes)
+		if err == nil {
+			log.Printf("mtls: reloaded certificates from %s", s.files.Cert)
+		}
+	}
+	if err != nil {
+		log.Printf("mtls: keeping previous certificates: %v", err)
+	}
+	return s.cert, s.pool
+}
+
+// Identity is the name of the service a certificate was issued to.
+func Identity(cert *x509.Certificate) string {
+	return cert.Subject.CommonName
+}
+
+// ServerCredentials require clients to present a certificate from the CA
+// that was issued to one of allowed.
+func ServerCredentials(s *Store, allowed []string) credentials.TransportCredentials {
+	return credentials.NewTLS(ServerConfig(s, allowed, "h2"))
+}
+
+// ServerConfig is the TLS configuration of ServerCredentials, for servers
+// other than gRPC, which offers protocols through ALPN.
+func ServerConfig(s *Store, allowed []string, protocols ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := s.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   protocols,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    pool,
+				VerifyConnection: func(cs tls.ConnectionState) error {
+					identity := Identity(cs.PeerCertificates[0])
+					if !slices.Contains(allowed, identity) {
+						return fmt.Errorf("mtls: client %q is not allowed", identity)
+					}
+					return nil
+				},
+			}, nil
+		},
+	}
+}
+
+// ClientCredentials present the certificate of s and require servers to
+// present a certificate from the CA for the host being dialed.
+func ClientCredentials(s *Store) credentials.TransportCredentials {
+	return credentials.NewTLS(ClientConfig(s))
+}
+
+// ClientConfig is the TLS configuration of ClientCredentials, for clients
+// other than gRPC.
+func ClientConfig(s *Store) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := s.current()
+			return cert, nil
+		},
+		// The CA may be rotated as well, so the chain is verified against
+		// the current pool below rather than a fixed RootCAs.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("mtls: server presented no certificate")
+			}
+			_, pool := s.current()
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         pool,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}
@@ -0,0 +1,92 @@
+// Package pagetoken encodes keyset positions as opaque page tokens. Tokens
+// are signed, so that clients cannot forge positions, and bound to the query
+// they were issued for.
+package pagetoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalid is returned for tokens that were tampered with or issued for a
+// different query.
+var ErrInvalid = errors.New("invalid page token")
+
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{key: []byte(secret)}
+}
+
+type payload struct {
+	Query []byte            `json:"q"`
+	Key   []json.RawMessage `json:"k"`
+}
+
+// Encode returns the token that resumes query after the row whose sort key
+// is key.
+func (s *Signer) Encode(query string, key ...interface{}) (string, error) {
+	p := payload{Query: fingerprint(query), Key: make([]json.RawMessage, len(key))}
+	for i, k := range key {
+		data, err := json.Marshal(k)
+		if err != nil {
+			return "", err
+		}
+		p.Key[i] = data
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(s.sign(data)), nil
+}
+
+// Decode verifies that token was issued for query and stores its sort key in
+// key, which must point to values of the types given to Encode.
+func (s *Signer) Decode(token, query string, key ...interface{}) error {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalid
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(data)) {
+		return ErrInvalid
+	}
+
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return ErrInvalid
+	}
+	if !hmac.Equal(p.Query, fingerprint(query)) || len(p.Key) != len(key) {
+		return ErrInvalid
+	}
+	for i, k := range key {
+		if err := json.Unmarshal(p.Key[i], k); err != nil {
+			return ErrInvalid
+		}
+	}
+	return nil
+}
+
+func (s *Signer) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// fingerprint identifies query without spelling out its filters.
+func fingerprint(query string) []byte {
+	sum := sha256.Sum256([]byte(query))
+	return sum[:8]
+}
@@ -0,0 +1,102 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const query = "games:country=FR"
+
+func TestRoundTrip(t *testing.T) {
+	signer := NewSigner("secret")
+	start := time.Date(2024, 7, 26, 0, 0, 0, 0, time.UTC)
+	token, err := signer.Encode(query, start, int64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		date time.Time
+		id   int64
+	)
+	if err := signer.Decode(token, query, &date, &id); err != nil {
+		t.Fatal(err)
+	}
+	if !date.Equal(start) || id != 42 {
+		t.Errorf("Decode() = (%v, %d), want (%v, 42)", date, id, start)
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	signer := NewSigner("secret")
+	token, err := signer.Encode(query, "fr", int64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+	other, _ := NewSigner("other").Encode(query, "fr", int64(42))
+
+	// A client rewriting the position keeps the signature of the original.
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"q":"AAAAAAAAAAA=","k":["fr",1]}`))
+	data, _ := base64.RawURLEncoding.DecodeString(encoded)
+	flipped := append([]byte(nil), data...)
+	flipped[len(flipped)-2] ^= 1
+
+	tests := []struct {
+		name  string
+		token string
+		query string
+	}{
+		{"issued for another query", token, "games:country=US"},
+		{"signed with another secret", other, query},
+		{"tampered payload", base64.RawURLEncoding.EncodeToString(flipped) + "." + signature, query},
+		{"forged payload", forged + "." + signature, query},
+		{"tampered signature", encoded + "." + base64.RawURLEncoding.EncodeToString([]byte("not the signature")), query},
+		{"signature not base64", encoded + ".!!", query},
+		{"no signature", encoded, query},
+		{"empty", "", query},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				locale string
+				id     int64
+			)
+			if err := signer.Decode(tt.token, tt.query, &locale, &id); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Decode() error = %v, want ErrInvalid", err)
+			}
+		})
+	}
+}
+
+func TestDecodeRejectsWrongKey(t *testing.T) {
+	signer := NewSigner("secret")
+	token, err := signer.Encode(query, "fr", int64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		locale string
+		id     int64
+		extra  int64
+	)
+	tests := []struct {
+		name string
+		key  []interface{}
+	}{
+		{"too few values", []interface{}{&locale}},
+		{"too many values", []interface{}{&locale, &id, &extra}},
+		{"wrong types", []interface{}{&id, &locale}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := signer.Decode(token, query, tt.key...); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Decode() error = %v, want ErrInvalid", err)
+			}
+		})
+	}
+}
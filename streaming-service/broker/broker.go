@@ -0,0 +1,83 @@
+// Package broker fans live commentary out to the subscribers of an event.
+package broker
+
+import (
+	"sync"
+
+	pb "olympy/streaming-service/genproto/stream_service"
+)
+
+// bufferSize is how far a subscriber may fall behind before it is dropped.
+const bufferSize = 64
+
+type Broker struct {
+	mu   sync.Mutex
+	subs map[string]map[chan *pb.Commentary]struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func New() *Broker {
+	return &Broker{
+		subs: make(map[string]map[chan *pb.Commentary]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Close tells subscribers to stop, so that their long-lived streams do not
+// hold up a graceful shutdown.
+func (b *Broker) Close() {
+	b.closeOnce.Do(func() { close(b.done) })
+}
+
+// Done is closed by Close.
+func (b *Broker) Done() <-chan struct{} {
+	return b.done
+}
+
+// Subscribe returns a channel receiving the commentary published for
+// eventID. The channel is closed when the subscriber falls too far behind;
+// it can then resume from the last commentary it received. cancel must be
+// called once the subscriber is done.
+func (b *Broker) Subscribe(eventID string) (<-chan *pb.Commentary, func()) {
+	ch := make(chan *pb.Commentary, bufferSize)
+
+	b.mu.Lock()
+	if b.subs[eventID] == nil {
+		b.subs[eventID] = make(map[chan *pb.Commentary]struct{})
+	}
+	b.subs[eventID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(eventID, ch)
+	}
+}
+
+func (b *Broker) Publish(c *pb.Commentary) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs[c.EventId] {
+		select {
+		case ch <- c:
+		default:
+			b.remove(c.EventId, ch)
+		}
+	}
+}
+
+// remove must be called with b.mu held. It is safe to call twice.
+func (b *Broker) remove(eventID string, ch chan *pb.Commentary) {
+	if _, ok := b.subs[eventID][ch]; !ok {
+		return
+	}
+	delete(b.subs[eventID], ch)
+	if len(b.subs[eventID]) == 0 {
+		delete(b.subs, eventID)
+	}
+	close(ch)
+}
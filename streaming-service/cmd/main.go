@@ -1,33 +1,101 @@
 package main
 
 import (
-	"olympy/streaming-service/storage"
-	"olympy/streaming-service/websocket"
-	"fmt"
+	"context"
 	"log"
 	"net/http"
+	"olympy/pkg/configloader"
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/lifecycle"
+	"olympy/pkg/logger"
+	"olympy/pkg/mtls"
+	"olympy/streaming-service/broker"
+	"olympy/streaming-service/config"
+	"olympy/streaming-service/storage"
+	"olympy/streaming-service/websocket"
+	"time"
 
 	grpcServer "olympy/streaming-service/grpc"
+
+	"go.uber.org/zap"
 )
 
 func main() {
+	configs, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	zapLogger, err := logger.New(configs.Log.Level, configs.Log.Environment, configs.Log.File)
+	if err != nil {
+		log.Fatalf("failed to build logger: %v", err)
+	}
+	defer zapLogger.Sync()
+	logger.SetDefault(zapLogger)
+	zapLogger.Info("effective config", zap.Any("config", configloader.Redacted(configs)))
+
 	// Initialize MongoDB connection
-	mongoClient, err := storage.NewMongoClient("mongodb://mongo:27017")
-	fmt.Println("mongoooooooo")
+	mongoClient, err := storage.NewMongoClient(configs.Mongo.URI)
 	if err != nil {
 		log.Fatalf("failed to connect to MongoDB: %v", err)
 	}
 
+	checker := health.New(map[string]health.Check{
+		"mongo": mongoClient.Ping,
+	}, "streaming_service.StreamingService")
+
+	certs, err := mtls.NewStore(mtls.Files{Cert: configs.TLS.CertFile, Key: configs.TLS.KeyFile, CA: configs.TLS.CAFile})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	signer := identity.NewSigner(configs.Identity.Secret)
+	commentary := broker.New()
+	server := grpcServer.NewStreamServiceServer(mongoClient, commentary, mtls.ServerCredentials(certs, configs.TLS.AllowedClients), signer, checker, zapLogger)
+	// Only the gateway may proxy WebSocket connections, so clients must
+	// present its certificate.
+	wsServer := &http.Server{
+		Addr:      ":" + configs.Server.WebSocketPort,
+		Handler:   websocket.Handler(signer),
+		TLSConfig: mtls.ServerConfig(certs, configs.TLS.AllowedWebSocketClients, "http/1.1"),
+	}
+
+	runner := lifecycle.New(configs.Server.ShutdownTimeout)
+
 	// Start WebSocket server
-	go func() {
-		if err := http.ListenAndServe(":8080", http.HandlerFunc(websocket.HandleConnections)); err != nil {
-			log.Fatalf("failed to start WebSocket server: %v", err)
-		}
-	}()
+	runner.Go("websocket server", func(context.Context) error {
+		// The certificates come from TLSConfig.
+		return wsServer.ListenAndServeTLS("", "")
+	}, func(ctx context.Context) error {
+		err := wsServer.Shutdown(ctx)
+		websocket.CloseAll()
+		return err
+	})
 
 	// Start gRPC server
-	log.Println("Starting gRPC server on port 8777...")
-	if err := grpcServer.StartGRPCServer(mongoClient, ":8777"); err != nil {
-		log.Fatalf("failed to start gRPC server: %v", err)
+	log.Printf("Starting gRPC server on port %s...", configs.Server.GRPCPort)
+	runner.Go("grpc server", func(context.Context) error {
+		return grpcServer.StartGRPCServer(server, ":"+configs.Server.GRPCPort)
+	}, func(ctx context.Context) error {
+		// End live subscriptions first; they would otherwise keep the
+		// graceful stop waiting until the timeout.
+		commentary.Close()
+		return lifecycle.GracefulStop(server)(ctx)
+	})
+
+	runner.Go("health checker", func(ctx context.Context) error {
+		checker.Run(ctx)
+		return nil
+	}, nil)
+
+	runner.Close("mongo", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return mongoClient.Close(ctx)
+	})
+
+	if err := runner.Run(context.Background()); err != nil {
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"time"
+
+	"olympy/pkg/configloader"
+)
+
+type Config struct {
+	Server   ServerConfig   `yaml:"server"`
+	Mongo    MongoConfig    `yaml:"mongo"`
+	Log      LogConfig      `yaml:"log"`
+	TLS      TLSConfig      `yaml:"tls"`
+	Identity IdentityConfig `yaml:"identity"`
+}
+
+type ServerConfig struct {
+	GRPCPort        string        `yaml:"grpc_port" env:"GRPC_PORT" default:"8777" validate:"required,port"`
+	WebSocketPort   string        `yaml:"websocket_port" env:"WS_PORT" default:"8080" validate:"required,port"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" default:"15s" validate:"positive"`
+}
+
+type MongoConfig struct {
+	URI string `yaml:"uri" env:"MONGO_URI" default:"mongodb://mongo:27017" validate:"required,url" secret:"true"`
+}
+
+type LogConfig struct {
+	Level       string `yaml:"level" env:"LOG_LEVEL" default:"info" validate:"oneof=debug|info|warn|error"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT" default:"production" validate:"oneof=production|development"`
+	File        string `yaml:"file" env:"LOG_FILE"`
+}
+
+// TLSConfig locates the certificates for mutual TLS with the other services
+// and names the services allowed to call this one, over gRPC and over
+// WebSocket.
+type TLSConfig struct {
+	CertFile                string   `yaml:"cert_file" env:"TLS_CERT_FILE" default:"certs/streaming-service.pem" validate:"required"`
+	KeyFile                 string   `yaml:"key_file" env:"TLS_KEY_FILE" default:"certs/streaming-service-key.pem" validate:"required"`
+	CAFile                  string   `yaml:"ca_file" env:"TLS_CA_FILE" default:"certs/ca.pem" validate:"required"`
+	AllowedClients          []string `yaml:"allowed_clients" env:"TLS_ALLOWED_CLIENTS" default:"api-gateway,event-service,health-probe,olympyctl" validate:"required"`
+	AllowedWebSocketClients []string `yaml:"allowed_websocket_clients" env:"TLS_ALLOWED_WEBSOCKET_CLIENTS" default:"api-gateway" validate:"required"`
+}
+
+// IdentityConfig holds the key that the gateway signs caller identities with;
+// it must match the gateway's.
+type IdentityConfig struct {
+	Secret string `yaml:"secret" env:"INTERNAL_IDENTITY_SECRET" validate:"required" secret:"true"`
+}
+
+func (c *Config) Load() error {
+	return configloader.Load(c)
+}
+
+func New() (*Config, error) {
+	config := &Config{}
+	if err := config.Load(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -27,11 +27,12 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type StreamEventRequest struct {
-	EventId              string   `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id"`
-	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	EventId              string        `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id"`
+	Text                 string        `protobuf:"bytes,2,opt,name=text,proto3" json:"text"`
+	StatusChange         *StatusChange `protobuf:"bytes,3,opt,name=status_change,json=statusChange,proto3" json:"status_change"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StreamEventRequest) Reset()         { *m = StreamEventRequest{} }
@@ -81,6 +82,76 @@ func (m *StreamEventRequest) GetText() string {
 	return ""
 }
 
+func (m *StreamEventRequest) GetStatusChange() *StatusChange {
+	if m != nil {
+		return m.StatusChange
+	}
+	return nil
+}
+
+type StatusChange struct {
+	FromStatus           string   `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status"`
+	ToStatus             string   `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusChange) Reset()         { *m = StatusChange{} }
+func (m *StatusChange) String() string { return proto.CompactTextString(m) }
+func (*StatusChange) ProtoMessage()    {}
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_759800fa5674cc87, []int{1}
+}
+func (m *StatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusChange.Merge(m, src)
+}
+func (m *StatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusChange proto.InternalMessageInfo
+
+func (m *StatusChange) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
+	}
+	return ""
+}
+
+func (m *StatusChange) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
+	}
+	return ""
+}
+
+func (m *StatusChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type StreamEventResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -92,7 +163,7 @@ func (m *StreamEventResponse) Reset()         { *m = StreamEventResponse{} }
 func (m *StreamEventResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamEventResponse) ProtoMessage()    {}
 func (*StreamEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_759800fa5674cc87, []int{1}
+	return fileDescriptor_759800fa5674cc87, []int{2}
 }
 func (m *StreamEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,9 +199,146 @@ func (m *StreamEventResponse) GetMessage() string {
 	return ""
 }
 
+type SubscribeEventRequest struct {
+	EventId              string   `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id"`
+	LastId               string   `protobuf:"bytes,2,opt,name=last_id,json=lastId,proto3" json:"last_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeEventRequest) Reset()         { *m = SubscribeEventRequest{} }
+func (m *SubscribeEventRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeEventRequest) ProtoMessage()    {}
+func (*SubscribeEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_759800fa5674cc87, []int{3}
+}
+func (m *SubscribeEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeEventRequest.Merge(m, src)
+}
+func (m *SubscribeEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeEventRequest proto.InternalMessageInfo
+
+func (m *SubscribeEventRequest) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *SubscribeEventRequest) GetLastId() string {
+	if m != nil {
+		return m.LastId
+	}
+	return ""
+}
+
+type Commentary struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	EventId              string        `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id"`
+	Text                 string        `protobuf:"bytes,3,opt,name=text,proto3" json:"text"`
+	Timestamp            string        `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp"`
+	StatusChange         *StatusChange `protobuf:"bytes,5,opt,name=status_change,json=statusChange,proto3" json:"status_change"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Commentary) Reset()         { *m = Commentary{} }
+func (m *Commentary) String() string { return proto.CompactTextString(m) }
+func (*Commentary) ProtoMessage()    {}
+func (*Commentary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_759800fa5674cc87, []int{4}
+}
+func (m *Commentary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commentary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commentary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Commentary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commentary.Merge(m, src)
+}
+func (m *Commentary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commentary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commentary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commentary proto.InternalMessageInfo
+
+func (m *Commentary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Commentary) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+func (m *Commentary) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Commentary) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *Commentary) GetStatusChange() *StatusChange {
+	if m != nil {
+		return m.StatusChange
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StreamEventRequest)(nil), "streaming_service.StreamEventRequest")
+	proto.RegisterType((*StatusChange)(nil), "streaming_service.StatusChange")
 	proto.RegisterType((*StreamEventResponse)(nil), "streaming_service.StreamEventResponse")
+	proto.RegisterType((*SubscribeEventRequest)(nil), "streaming_service.SubscribeEventRequest")
+	proto.RegisterType((*Commentary)(nil), "streaming_service.Commentary")
 }
 
 func init() {
@@ -138,20 +346,31 @@ func init() {
 }
 
 var fileDescriptor_759800fa5674cc87 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x2e, 0x29, 0x4a,
-	0x4d, 0xcc, 0x8d, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x87, 0x70, 0x33, 0xf3, 0xd2,
-	0x61, 0x22, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x82, 0x18, 0x12, 0x4a, 0xce, 0x5c, 0x42,
-	0xc1, 0x60, 0x41, 0xd7, 0xb2, 0xd4, 0xbc, 0x92, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x21,
-	0x49, 0x2e, 0x8e, 0x54, 0x10, 0x3f, 0x3e, 0x33, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88,
-	0x1d, 0xcc, 0xf7, 0x4c, 0x11, 0x12, 0xe2, 0x62, 0x29, 0x49, 0xad, 0x28, 0x91, 0x60, 0x02, 0x0b,
-	0x83, 0xd9, 0x4a, 0xfa, 0x5c, 0xc2, 0x28, 0x86, 0x14, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x49,
-	0x70, 0xb1, 0xe7, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0xc2, 0x0c, 0x81, 0x72, 0x8d, 0x0a, 0xb8,
-	0x04, 0x82, 0x61, 0x4e, 0x09, 0x86, 0xb8, 0x44, 0x28, 0x86, 0x8b, 0x1b, 0xc9, 0x10, 0x21, 0x55,
-	0x3d, 0x4c, 0x5f, 0x60, 0xba, 0x54, 0x4a, 0x8d, 0x90, 0x32, 0x88, 0x5b, 0x9c, 0x04, 0x4e, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x19, 0x8f, 0xe5, 0x18, 0x92,
-	0xd8, 0xc0, 0x61, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x38, 0x33, 0x65, 0x80, 0x3d, 0x01,
-	0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xdd, 0x4e, 0xe2, 0x40,
+	0x14, 0xde, 0x29, 0x2c, 0xd0, 0x03, 0x4b, 0xd8, 0xd9, 0xec, 0x6e, 0x97, 0xd5, 0x42, 0x9a, 0x48,
+	0xb8, 0x02, 0x83, 0x6f, 0x20, 0x7a, 0x41, 0xbc, 0x6b, 0x2f, 0x35, 0x69, 0x06, 0x7a, 0xc4, 0x26,
+	0xb6, 0x83, 0x9d, 0x81, 0xe8, 0x23, 0xf8, 0x06, 0xbe, 0x85, 0xaf, 0xe1, 0x8d, 0x89, 0x8f, 0x60,
+	0xf0, 0x45, 0x0c, 0xd3, 0x56, 0x8a, 0x34, 0xd1, 0x78, 0xd7, 0xef, 0xe7, 0x4c, 0xbe, 0xf9, 0x4e,
+	0x07, 0x3a, 0x42, 0x46, 0xc8, 0x02, 0x57, 0x60, 0xb4, 0xf0, 0x27, 0xd8, 0x8f, 0xa1, 0x1f, 0x4e,
+	0x53, 0xa6, 0x37, 0x8b, 0xb8, 0xe4, 0xf4, 0xe7, 0x96, 0x60, 0xdd, 0x12, 0xa0, 0x8e, 0x62, 0x8f,
+	0x17, 0x18, 0x4a, 0x1b, 0xaf, 0xe6, 0x28, 0x24, 0xfd, 0x07, 0x15, 0x5c, 0x61, 0xd7, 0xf7, 0x0c,
+	0xd2, 0x26, 0x5d, 0xdd, 0x2e, 0x2b, 0x3c, 0xf2, 0x28, 0x85, 0xa2, 0xc4, 0x6b, 0x69, 0x68, 0x8a,
+	0x56, 0xdf, 0xf4, 0x08, 0x7e, 0x08, 0xc9, 0xe4, 0x5c, 0xb8, 0x93, 0x0b, 0x16, 0x4e, 0xd1, 0x28,
+	0xb4, 0x49, 0xb7, 0x3a, 0x68, 0xf5, 0xb6, 0x93, 0x38, 0xca, 0x37, 0x54, 0x36, 0xbb, 0x26, 0x32,
+	0xc8, 0xf2, 0xa0, 0x96, 0x55, 0x69, 0x0b, 0xaa, 0xe7, 0x11, 0x0f, 0xdc, 0xd8, 0x94, 0xe4, 0x80,
+	0x15, 0x15, 0xdb, 0xe8, 0x7f, 0xd0, 0x25, 0x4f, 0xe5, 0x38, 0x4f, 0x45, 0xf2, 0x44, 0xfc, 0x03,
+	0xa5, 0x08, 0x99, 0xe0, 0xa1, 0x0a, 0xa3, 0xdb, 0x09, 0xb2, 0xfa, 0xf0, 0x6b, 0xe3, 0xc2, 0x62,
+	0xc6, 0x43, 0x81, 0xd4, 0x80, 0x72, 0x80, 0x42, 0xb0, 0x29, 0xa6, 0x17, 0x4e, 0xa0, 0x75, 0x02,
+	0xbf, 0x9d, 0xf9, 0x58, 0x4c, 0x22, 0x7f, 0x8c, 0x9f, 0x2d, 0xe9, 0x2f, 0x94, 0x2f, 0x99, 0x50,
+	0x4a, 0x9c, 0xab, 0xb4, 0x82, 0x23, 0xcf, 0xba, 0x27, 0x00, 0x43, 0x1e, 0x04, 0x18, 0x4a, 0x16,
+	0xdd, 0xd0, 0x3a, 0x68, 0x6f, 0xc3, 0x9a, 0xef, 0x6d, 0x1c, 0xa9, 0xe5, 0xf7, 0x5e, 0xc8, 0xf4,
+	0xbe, 0x03, 0xba, 0xf4, 0x03, 0x14, 0x92, 0x05, 0x33, 0xa3, 0xa8, 0x84, 0x35, 0xb1, 0xbd, 0x95,
+	0xef, 0x5f, 0xd8, 0xca, 0xe0, 0x91, 0x40, 0xc3, 0x49, 0x07, 0x9c, 0xd8, 0x4f, 0xcf, 0xa0, 0x9a,
+	0x29, 0x91, 0xee, 0xe5, 0x1e, 0xf9, 0xfe, 0xaf, 0x6a, 0x76, 0x3e, 0xb2, 0x25, 0xbb, 0x38, 0x85,
+	0xfa, 0x66, 0xe3, 0xb4, 0x9b, 0x37, 0x99, 0xb7, 0x94, 0xe6, 0x6e, 0x8e, 0x73, 0x5d, 0xf8, 0x3e,
+	0x39, 0x6c, 0x3c, 0x2c, 0x4d, 0xf2, 0xb4, 0x34, 0xc9, 0xf3, 0xd2, 0x24, 0x77, 0x2f, 0xe6, 0xb7,
+	0x71, 0x49, 0xbd, 0x8e, 0x83, 0xd7, 0x01, 0x00, 0x0e, 0xed, 0x36, 0x16, 0x47, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -167,6 +386,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type StreamingServiceClient interface {
 	StreamEvent(ctx context.Context, in *StreamEventRequest, opts ...grpc.CallOption) (*StreamEventResponse, error)
+	// Sends the commentary stored after last_id, then live commentary until
+	// the client cancels.
+	SubscribeEvent(ctx context.Context, in *SubscribeEventRequest, opts ...grpc.CallOption) (StreamingService_SubscribeEventClient, error)
 }
 
 type streamingServiceClient struct {
@@ -186,9 +408,44 @@ func (c *streamingServiceClient) StreamEvent(ctx context.Context, in *StreamEven
 	return out, nil
 }
 
+func (c *streamingServiceClient) SubscribeEvent(ctx context.Context, in *SubscribeEventRequest, opts ...grpc.CallOption) (StreamingService_SubscribeEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreamingService_serviceDesc.Streams[0], "/streaming_service.StreamingService/SubscribeEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingServiceSubscribeEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamingService_SubscribeEventClient interface {
+	Recv() (*Commentary, error)
+	grpc.ClientStream
+}
+
+type streamingServiceSubscribeEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingServiceSubscribeEventClient) Recv() (*Commentary, error) {
+	m := new(Commentary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StreamingServiceServer is the server API for StreamingService service.
 type StreamingServiceServer interface {
 	StreamEvent(context.Context, *StreamEventRequest) (*StreamEventResponse, error)
+	// Sends the commentary stored after last_id, then live commentary until
+	// the client cancels.
+	SubscribeEvent(*SubscribeEventRequest, StreamingService_SubscribeEventServer) error
 }
 
 // UnimplementedStreamingServiceServer can be embedded to have forward compatible implementations.
@@ -198,6 +455,9 @@ type UnimplementedStreamingServiceServer struct {
 func (*UnimplementedStreamingServiceServer) StreamEvent(ctx context.Context, req *StreamEventRequest) (*StreamEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamEvent not implemented")
 }
+func (*UnimplementedStreamingServiceServer) SubscribeEvent(req *SubscribeEventRequest, srv StreamingService_SubscribeEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvent not implemented")
+}
 
 func RegisterStreamingServiceServer(s *grpc.Server, srv StreamingServiceServer) {
 	s.RegisterService(&_StreamingService_serviceDesc, srv)
@@ -221,6 +481,27 @@ func _StreamingService_StreamEvent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingService_SubscribeEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServiceServer).SubscribeEvent(m, &streamingServiceSubscribeEventServer{stream})
+}
+
+type StreamingService_SubscribeEventServer interface {
+	Send(*Commentary) error
+	grpc.ServerStream
+}
+
+type streamingServiceSubscribeEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingServiceSubscribeEventServer) Send(m *Commentary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _StreamingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "streaming_service.StreamingService",
 	HandlerType: (*StreamingServiceServer)(nil),
@@ -230,7 +511,13 @@ var _StreamingService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _StreamingService_StreamEvent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvent",
+			Handler:       _StreamingService_SubscribeEvent_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "stream_service/streaming_service.proto",
 }
 
@@ -258,6 +545,18 @@ func (m *StreamEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StatusChange != nil {
+		{
+			size, err := m.StatusChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreamingService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Text) > 0 {
 		i -= len(m.Text)
 		copy(dAtA[i:], m.Text)
@@ -275,6 +574,54 @@ func (m *StreamEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToStatus) > 0 {
+		i -= len(m.ToStatus)
+		copy(dAtA[i:], m.ToStatus)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.ToStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromStatus) > 0 {
+		i -= len(m.FromStatus)
+		copy(dAtA[i:], m.FromStatus)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.FromStatus)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StreamEventResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -309,47 +656,235 @@ func (m *StreamEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStreamingService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStreamingService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SubscribeEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StreamEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SubscribeEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EventId)
-	if l > 0 {
-		n += 1 + l + sovStreamingService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovStreamingService(uint64(l))
+	if len(m.LastId) > 0 {
+		i -= len(m.LastId)
+		copy(dAtA[i:], m.LastId)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.LastId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.EventId) > 0 {
+		i -= len(m.EventId)
+		copy(dAtA[i:], m.EventId)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.EventId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Message)
+func (m *Commentary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commentary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commentary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StatusChange != nil {
+		{
+			size, err := m.StatusChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreamingService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventId) > 0 {
+		i -= len(m.EventId)
+		copy(dAtA[i:], m.EventId)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.EventId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintStreamingService(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreamingService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreamingService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventId)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.StatusChange != nil {
+		l = m.StatusChange.Size()
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromStatus)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.ToStatus)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventId)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.LastId)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Commentary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.EventId)
 	if l > 0 {
 		n += 1 + l + sovStreamingService(uint64(l))
 	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
+	if m.StatusChange != nil {
+		l = m.StatusChange.Size()
+		n += 1 + l + sovStreamingService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -455,6 +990,42 @@ func (m *StreamEventRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusChange == nil {
+				m.StatusChange = &StatusChange{}
+			}
+			if err := m.StatusChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStreamingService(dAtA[iNdEx:])
@@ -477,7 +1048,7 @@ func (m *StreamEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamEventResponse) Unmarshal(dAtA []byte) error {
+func (m *StatusChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -500,15 +1071,15 @@ func (m *StreamEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -536,7 +1107,484 @@ func (m *StreamEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.FromStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreamingService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreamingService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreamingService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreamingService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreamingService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commentary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreamingService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commentary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commentary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreamingService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreamingService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusChange == nil {
+				m.StatusChange = &StatusChange{}
+			}
+			if err := m.StatusChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
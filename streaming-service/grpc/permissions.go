@@ -0,0 +1,9 @@
+package grpc
+
+import "olympy/pkg/identity"
+
+// permissions are the scopes the caller's identity needs for each RPC.
+var permissions = identity.Permissions{
+	"/streaming_service.StreamingService/StreamEvent":    "stream:publish",
+	"/streaming_service.StreamingService/SubscribeEvent": "stream:subscribe",
+}
@@ -7,50 +7,151 @@ import (
 	"net"
 	"time"
 
+	"olympy/pkg/health"
+	"olympy/pkg/identity"
+	"olympy/pkg/logger"
+	"olympy/streaming-service/broker"
 	pb "olympy/streaming-service/genproto/stream_service"
 	"olympy/streaming-service/storage"
-	pb "COMPETITIONS/olympy/streaming-service"
-	"COMPETITIONS/olympy/streaming-service/storage"
-	"COMPETITIONS/olympy/streaming-service/websocket"
+	"olympy/streaming-service/websocket"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// maxReplay caps the history sent to a resumed subscription.
+const maxReplay = 1000
+
 type StreamServiceServer struct {
 	pb.UnimplementedStreamingServiceServer
 	mongoClient *storage.MongoClient
+	broker      *broker.Broker
 }
 
-func NewStreamServiceServer(mongoClient *storage.MongoClient) *grpc.Server {
-	grpcServer := grpc.NewServer()
-	pb.RegisterStreamingServiceServer(grpcServer, &StreamServiceServer{mongoClient: mongoClient})
+func NewStreamServiceServer(mongoClient *storage.MongoClient, broker *broker.Broker, creds credentials.TransportCredentials, signer *identity.Signer, checker *health.Checker, zapLogger *zap.Logger) *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(logger.UnaryServerInterceptor(zapLogger), identity.UnaryServerInterceptor(signer, permissions)),
+		grpc.ChainStreamInterceptor(logger.StreamServerInterceptor(zapLogger), identity.StreamServerInterceptor(signer, permissions)),
+	)
+	pb.RegisterStreamingServiceServer(grpcServer, &StreamServiceServer{mongoClient: mongoClient, broker: broker})
+	checker.Register(grpcServer)
 	return grpcServer
 }
 
-func StartGRPCServer(mongoClient *storage.MongoClient, address string) error {
+func StartGRPCServer(grpcServer *grpc.Server, address string) error {
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
 		return err
 	}
-	grpcServer := NewStreamServiceServer(mongoClient)
 	log.Printf("gRPC server listening on %s", address)
 	return grpcServer.Serve(lis)
 }
 
 func (s *StreamServiceServer) StreamEvent(ctx context.Context, req *pb.StreamEventRequest) (*pb.StreamEventResponse, error) {
+	// Mongo stores milliseconds; truncating keeps the published timestamp
+	// equal to the stored one.
+	now := time.Now().Truncate(time.Millisecond)
 	event := bson.D{
-		{"_id", uuid.NewString()},
-		{"event_id", req.GetEventId()},
-		{"text", req.GetText()},
-		{"timestamp", time.Now()},
+		{Key: "_id", Value: uuid.NewString()},
+		{Key: "event_id", Value: req.GetEventId()},
+		{Key: "text", Value: req.GetText()},
+		{Key: "timestamp", Value: now},
+	}
+	if change := req.GetStatusChange(); change != nil {
+		event = append(event, bson.E{Key: "status_change", Value: storage.StatusChange{
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			Reason:     change.Reason,
+		}})
 	}
 	if err := s.mongoClient.InsertEvent(ctx, event); err != nil {
 		return nil, fmt.Errorf("failed to save event to MongoDB: %v", err)
 	}
 
-	websocket.Broadcast(event)
+	commentary := &pb.Commentary{
+		Id:           event[0].Value.(string),
+		EventId:      req.GetEventId(),
+		Text:         req.GetText(),
+		Timestamp:    now.UTC().Format(time.RFC3339Nano),
+		StatusChange: req.GetStatusChange(),
+	}
+	websocket.Broadcast(req.GetEventId(), commentary)
+	s.broker.Publish(commentary)
 
 	return &pb.StreamEventResponse{Message: "Event streamed successfully"}, nil
 }
+
+func (s *StreamServiceServer) SubscribeEvent(req *pb.SubscribeEventRequest, stream pb.StreamingService_SubscribeEventServer) error {
+	if req.GetEventId() == "" {
+		return status.Error(codes.InvalidArgument, "event_id is required")
+	}
+
+	// Subscribing before reading the history ensures nothing published in
+	// between is missed; messages seen in both are sent once.
+	live, cancel := s.broker.Subscribe(req.GetEventId())
+	defer cancel()
+
+	var history []storage.Commentary
+	if req.GetLastId() != "" {
+		var err error
+		history, err = s.mongoClient.CommentaryAfter(stream.Context(), req.GetEventId(), req.GetLastId(), maxReplay)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Headers tell the client that the subscription is established before
+	// any commentary arrives.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	replayed := make(map[string]bool)
+	for _, c := range history {
+		replayed[c.ID] = true
+		commentary := &pb.Commentary{
+			Id:        c.ID,
+			EventId:   c.EventID,
+			Text:      c.Text,
+			Timestamp: c.Timestamp.UTC().Format(time.RFC3339Nano),
+		}
+		if c.StatusChange != nil {
+			commentary.StatusChange = &pb.StatusChange{
+				FromStatus: c.StatusChange.FromStatus,
+				ToStatus:   c.StatusChange.ToStatus,
+				Reason:     c.StatusChange.Reason,
+			}
+		}
+		err := stream.Send(commentary)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.broker.Done():
+			return status.Error(codes.Unavailable, "server shutting down")
+		case c, ok := <-live:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind, resume from the last received commentary")
+			}
+			if replayed[c.Id] {
+				delete(replayed, c.Id)
+				continue
+			}
+			if err := stream.Send(c); err != nil {
+				return err
+			}
+		}
+	}
+}
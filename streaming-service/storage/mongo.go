@@ -2,11 +2,15 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 type MongoClient struct {
@@ -24,12 +28,86 @@ func NewMongoClient(uri string) (*MongoClient, error) {
 		return nil, fmt.Errorf("error pinging MongoDB: %v", err)
 	}
 
-	fmt.Println("Successfully connected to MongoDB")
-	return &MongoClient{client: client}, nil
+	log.Println("Successfully connected to MongoDB")
+	m := &MongoClient{client: client}
+
+	// Serves the history queries of resumed subscriptions.
+	_, err = m.events().Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating MongoDB indexes: %v", err)
+	}
+	return m, nil
+}
+
+func (m *MongoClient) events() *mongo.Collection {
+	return m.client.Database("streaming").Collection("events")
+}
+
+func (m *MongoClient) Ping(ctx context.Context) error {
+	return m.client.Ping(ctx, readpref.Primary())
+}
+
+func (m *MongoClient) Close(ctx context.Context) error {
+	return m.client.Disconnect(ctx)
 }
 
 func (m *MongoClient) InsertEvent(ctx context.Context, event bson.D) error {
-	collection := m.client.Database("streaming").Collection("events")
-	_, err := collection.InsertOne(ctx, event)
+	_, err := m.events().InsertOne(ctx, event)
 	return err
 }
+
+// Commentary is a stored commentary message.
+type Commentary struct {
+	ID        string    `bson:"_id"`
+	EventID   string    `bson:"event_id"`
+	Text      string    `bson:"text"`
+	Timestamp time.Time `bson:"timestamp"`
+	// StatusChange is set on the messages event-service publishes when an
+	// event changes status.
+	StatusChange *StatusChange `bson:"status_change,omitempty"`
+}
+
+type StatusChange struct {
+	FromStatus string `bson:"from_status"`
+	ToStatus   string `bson:"to_status"`
+	Reason     string `bson:"reason"`
+}
+
+// CommentaryAfter returns up to limit messages of eventID stored after the
+// message lastID, oldest first. Messages are ordered by timestamp, then by
+// ID for messages stored in the same millisecond. It returns nothing when
+// lastID is unknown.
+func (m *MongoClient) CommentaryAfter(ctx context.Context, eventID, lastID string, limit int64) ([]Commentary, error) {
+	var last Commentary
+	err := m.events().FindOne(ctx, bson.M{"_id": lastID, "event_id": eventID}).Decode(&last)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding commentary %s: %v", lastID, err)
+	}
+
+	filter := bson.M{
+		"event_id": eventID,
+		"$or": bson.A{
+			bson.M{"timestamp": bson.M{"$gt": last.Timestamp}},
+			bson.M{"timestamp": last.Timestamp, "_id": bson.M{"$gt": last.ID}},
+		},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := m.events().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error querying commentary: %v", err)
+	}
+
+	var commentary []Commentary
+	if err := cursor.All(ctx, &commentary); err != nil {
+		return nil, fmt.Errorf("error reading commentary: %v", err)
+	}
+	return commentary, nil
+}
@@ -3,63 +3,131 @@ package websocket
 import (
 	"log"
 	"net/http"
+	"olympy/pkg/identity"
+	"sync"
+	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gorilla/websocket"
 )
 
 var (
 	upgrader = websocket.Upgrader{}
-	clients  = make(map[string]*websocket.Conn)
+
+	mu      sync.Mutex
+	clients = make(map[string]*client)
 )
 
-var jwtSecret = []byte("nodirbek")
+const closeGracePeriod = time.Second
 
-func HandleConnections(w http.ResponseWriter, r *http.Request) {
-	tokenStr := r.URL.Query().Get("token")
-	if tokenStr == "" {
-		http.Error(w, "token is required", http.StatusUnauthorized)
-		return
-	}
+// client receives the commentary of the events it subscribed to with
+// event_id query parameters, or of all events when it named none.
+type client struct {
+	conn   *websocket.Conn
+	events map[string]bool
+}
 
-	claims := &jwt.StandardClaims{}
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecret, nil
-	})
-	if err != nil || !token.Valid {
-		http.Error(w, "invalid token", http.StatusUnauthorized)
-		return
-	}
+func (c *client) wants(eventID string) bool {
+	return len(c.events) == 0 || c.events[eventID]
+}
 
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		log.Printf("failed to upgrade connection: %v", err)
-		return
-	}
+// Scopes the caller's identity needs to follow the named events, or every
+// event when none is named.
+const (
+	scopeSubscribe    = "stream:subscribe"
+	scopeSubscribeAll = "stream:subscribe_all"
+)
 
-	clients[conn.RemoteAddr().String()] = conn
+// Handler accepts the connections the gateway proxies, which carry the
+// caller's identity signed by signer in the x-olympy-identity header. The
+// gateway authorizes each event; the scopes are checked again here so that
+// the server stays protected when the gateway is bypassed.
+func Handler(signer *identity.Signer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tokens := r.Header.Values(identity.Key)
+		if len(tokens) != 1 {
+			http.Error(w, "missing caller identity", http.StatusUnauthorized)
+			return
+		}
+		id, err := signer.Verify(tokens[0])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		scope := scopeSubscribe
+		if len(r.URL.Query()["event_id"]) == 0 {
+			scope = scopeSubscribeAll
+		}
+		if !id.Has(scope) {
+			http.Error(w, "role "+id.Role+" lacks the "+scope+" scope", http.StatusForbidden)
+			return
+		}
 
-	defer func() {
-		conn.Close()
-		delete(clients, conn.RemoteAddr().String())
-	}()
+		events := make(map[string]bool)
+		for _, id := range r.URL.Query()["event_id"] {
+			events[id] = true
+		}
 
-	for {
-		_, _, err := conn.ReadMessage()
+		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
-			log.Printf("error reading message: %v", err)
-			break
+			log.Printf("failed to upgrade connection: %v", err)
+			return
+		}
+
+		addr := conn.RemoteAddr().String()
+		mu.Lock()
+		clients[addr] = &client{conn: conn, events: events}
+		mu.Unlock()
+
+		defer func() {
+			conn.Close()
+			mu.Lock()
+			delete(clients, addr)
+			mu.Unlock()
+		}()
+
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					log.Printf("error reading message: %v", err)
+				}
+				break
+			}
 		}
 	}
 }
 
-func Broadcast(event interface{}) {
-	for _, client := range clients {
-		err := client.WriteJSON(event)
+// Broadcast sends event to the clients subscribed to eventID.
+func Broadcast(eventID string, event interface{}) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for addr, c := range clients {
+		if !c.wants(eventID) {
+			continue
+		}
+		err := c.conn.WriteJSON(event)
 		if err != nil {
 			log.Printf("failed to send message to client: %v", err)
-			client.Close()
-			delete(clients, client.RemoteAddr().String())
+			c.conn.Close()
+			delete(clients, addr)
+		}
+	}
+}
+
+// CloseAll sends a going-away close frame to every connected client and
+// closes the connections. It is called after the HTTP server stops accepting
+// new upgrades, since hijacked connections are not tracked by http.Server.
+func CloseAll() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	for addr, c := range clients {
+		if err := c.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(closeGracePeriod)); err != nil {
+			log.Printf("failed to send close frame to client: %v", err)
 		}
+		c.conn.Close()
+		delete(clients, addr)
 	}
 }